@@ -0,0 +1,31 @@
+package iso639_3
+
+import "context"
+
+// AllLanguages returns all distinct languages sorted by ISO 639-3 code.
+// The slice is a copy, so it's safe to modify
+func AllLanguages() []Language {
+	ret := make([]Language, len(db().byPart3))
+	copy(ret, db().byPart3)
+	return ret
+}
+
+// Stream sends all distinct languages sorted by ISO 639-3 code to the returned channel one by one.
+// The channel is closed when all languages are sent or ctx is cancelled, whichever happens first
+func Stream(ctx context.Context) <-chan Language {
+	ch := make(chan Language)
+	go func() {
+		defer close(ch)
+		for _, l := range db().byPart3 {
+			if ctx.Err() != nil {
+				return
+			}
+			select {
+			case ch <- l:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
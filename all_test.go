@@ -0,0 +1,58 @@
+package iso639_3
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAllLanguages(t *testing.T) {
+	langs := AllLanguages()
+	if len(langs) != len(db().part3) {
+		t.Errorf("AllLanguages() returned %v languages, expected %v", len(langs), len(db().part3))
+	}
+	for i := 1; i < len(langs); i++ {
+		if langs[i].Part3 <= langs[i-1].Part3 {
+			t.Errorf("AllLanguages() has %v after %v, expected sorted distinct codes", langs[i].Part3, langs[i-1].Part3)
+		}
+	}
+
+	langs[0].Name = "modified"
+	if AllLanguages()[0].Name == "modified" {
+		t.Error("AllLanguages() returned shared slice, expected a copy")
+	}
+}
+
+func TestStream(t *testing.T) {
+	count := 0
+	prev := ""
+	for l := range Stream(context.Background()) {
+		if l.Part3 <= prev {
+			t.Errorf("Stream() sent %v after %v, expected sorted order", l.Part3, prev)
+		}
+		prev = l.Part3
+		count++
+	}
+
+	if count != len(db().part3) {
+		t.Errorf("Stream() sent %v languages, expected %v", count, len(db().part3))
+	}
+}
+
+func TestStreamCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := Stream(ctx)
+
+	first := <-ch
+	if first.Part3 != "aaa" {
+		t.Errorf("Stream() sent %v first, expected aaa", first)
+	}
+	cancel()
+
+	count := 0
+	for range ch {
+		count++ // at most one send may race with cancellation
+	}
+	if count > 1 {
+		t.Errorf("Stream() sent %v languages after cancellation", count)
+	}
+}
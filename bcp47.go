@@ -0,0 +1,112 @@
+package iso639_3
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// languageSubtag extracts language code from a tag like "en-US" or "eng_US" by cutting off everything after first "-" or "_"
+func languageSubtag(tag string) string {
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		return tag[:i]
+	}
+	return tag
+}
+
+// primarySubtag extracts primary language subtag from BCP 47 language tag, e.g. "zh" from "zh-Hant-TW".
+// Both "-" and "_" are accepted as subtag separators. Subtag is lowercased since BCP 47 tags are case-insensitive
+func primarySubtag(tag string) string {
+	return strings.ToLower(languageSubtag(tag))
+}
+
+// FromBCP47 looks up language for primary language subtag of given BCP 47 tag, ignoring script, region and variant subtags,
+// e.g. Chinese for "zh-Hant-TW" and English for "en_GB". Unlike FromAnyCode the tag is case-insensitive, so "EN-us" resolves too.
+// Returns nil if primary subtag is not a known ISO639-1, ISO639-2 or ISO639-3 code
+func FromBCP47(tag string) *Language {
+	return FromAnyCode(primarySubtag(strings.TrimSpace(tag)))
+}
+
+// MatchesTag checks whether primary language subtag of given BCP 47 tag resolves to this language,
+// either directly or through a macrolanguage: both "zh" and "zho" match Mandarin Chinese (cmn), and "cmn" matches Chinese (zho).
+// Languages of the same macrolanguage don't match each other
+func (l Language) MatchesTag(tag string) bool {
+	t := FromAnyCode(primarySubtag(tag))
+	if t == nil {
+		return false
+	}
+
+	return t.Part3 == l.Part3 || l.MacrolanguageCode == t.Part3 || t.MacrolanguageCode == l.Part3
+}
+
+// SameLanguageTag checks whether primary language subtags of given BCP 47 tags resolve to the same language,
+// e.g. "en-US" and "eng", or "de" and "ger". Macrolanguages and their members are considered different languages,
+// see SameMacrolanguageTag. Returns false if either tag can't be resolved
+func SameLanguageTag(a, b string) bool {
+	la, lb := FromAnyCode(primarySubtag(a)), FromAnyCode(primarySubtag(b))
+	return la != nil && lb != nil && la.Part3 == lb.Part3
+}
+
+// SameMacrolanguageTag is like SameLanguageTag, but also matches languages sharing a macrolanguage:
+// macrolanguage with its members (see MatchesTag), e.g. "zh" and "cmn", and members of the same macrolanguage
+// with each other, e.g. "cmn" and "yue"
+func SameMacrolanguageTag(a, b string) bool {
+	la, lb := FromAnyCode(primarySubtag(a)), FromAnyCode(primarySubtag(b))
+	if la == nil || lb == nil {
+		return false
+	}
+	return la.MatchesTag(b) || la.MacrolanguageCode != "" && la.MacrolanguageCode == lb.MacrolanguageCode
+}
+
+// IsValidBCP47Primary checks whether given string is a valid BCP 47 primary language subtag backed by ISO 639, e.g. "en" or "cmn".
+// Case is ignored. Following BCP 47 rules, two-letter ISO639-1 code must be used when available,
+// so "deu" and bibliographic "ger" are invalid while "de" is valid. Private use range "qaa".."qtz" is accepted.
+// Only the primary subtag is checked: full tags like "en-US", reserved (4 letters) and registered (5-8 letters) subtags,
+// as well as ISO 639-2 collective codes, are considered invalid
+func IsValidBCP47Primary(tag string) bool {
+	for i := 0; i < len(tag); i++ {
+		c := tag[i] | 0x20 // ASCII lowercase
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+	code := strings.ToLower(tag)
+
+	switch len(code) {
+	case 2:
+		return FromPart1Code(code) != nil
+	case 3:
+		if code >= "qaa" && code <= "qtz" {
+			return true
+		}
+		l := FromPart3Code(code)
+		return l != nil && l.Part1 == ""
+	}
+	return false
+}
+
+// Tag converts language to golang.org/x/text language tag built from ISO639-1 code if available, ISO639-3 code otherwise.
+// The code is used as is, without canonicalization, e.g. "cmn" is not replaced with "zh".
+// Returns error if x/text doesn't recognize the code
+func (l Language) Tag() (language.Tag, error) {
+	code := l.Part1
+	if code == "" {
+		code = l.Part3
+	}
+	tag, err := language.Raw.Parse(code)
+	if err != nil {
+		return language.Und, fmt.Errorf("iso639_3: can't convert %q to language tag: %w", code, err)
+	}
+	return tag, nil
+}
+
+// FromTag looks up language for base language of golang.org/x/text language tag, e.g. English for en-US.
+// Guessed base languages are not used, so undetermined tags like "und-Cyrl" resolve to nil. Returns nil if not found
+func FromTag(tag language.Tag) *Language {
+	base, confidence := tag.Base()
+	if confidence != language.Exact {
+		return nil
+	}
+	return FromAnyCode(base.String())
+}
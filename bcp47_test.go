@@ -0,0 +1,181 @@
+package iso639_3
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestFromBCP47(t *testing.T) {
+	tests := map[string]string{
+		"en-US":      "eng",
+		"zh-Hant":    "zho",
+		"zh-Hant-TW": "zho",
+		"pt-BR":      "por",
+		"en_GB":      "eng",
+		"EN-us":      "eng",
+		"cmn-Hans":   "cmn",
+		" de ":       "deu",
+		"xx-US":      "",
+		"-US":        "",
+		"":           "",
+	}
+	for tag, expected := range tests {
+		actual := FromBCP47(tag)
+		if expected == "" && actual != nil || expected != "" && (actual == nil || actual.Part3 != expected) {
+			t.Errorf("FromBCP47(%q) = %v, expected %q", tag, actual, expected)
+		}
+	}
+}
+
+func TestLanguage_MatchesTag(t *testing.T) {
+	tests := []struct {
+		part3    string
+		tag      string
+		expected bool
+	}{
+		{"cmn", "zh", true},
+		{"cmn", "zh-Hans-CN", true},
+		{"cmn", "cmn", true},
+		{"zho", "cmn", true},
+		{"zho", "zh_TW", true},
+		{"yue", "cmn", false}, // siblings within macrolanguage
+		{"rus", "ru-RU", true},
+		{"rus", "RU", true},
+		{"rus", "uk", false},
+		{"rus", "", false},
+		{"rus", "xx-XX", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3+"/"+tt.tag, func(t *testing.T) {
+			actual := FromPart3Code(tt.part3).MatchesTag(tt.tag)
+
+			if actual != tt.expected {
+				t.Errorf("MatchesTag() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSameLanguageTag(t *testing.T) {
+	tests := []struct {
+		a, b          string
+		expected      bool
+		expectedMacro bool
+	}{
+		{"en", "eng", true, true},
+		{"en-US", "eng-GB", true, true},
+		{"de", "ger", true, true},
+		{"zh", "cmn", false, true},
+		{"cmn", "zh-Hans", false, true},
+		{"cmn", "yue", false, true}, // siblings within macrolanguage
+		{"cmn-Hans-CN", "yue-HK", false, true},
+		{"arz", "cmn", false, false}, // members of different macrolanguages
+		{"en", "de", false, false},
+		{"xx", "xx", false, false},
+		{"", "", false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if actual := SameLanguageTag(tt.a, tt.b); actual != tt.expected {
+				t.Errorf("SameLanguageTag(%q, %q) = %v, expected %v", tt.a, tt.b, actual, tt.expected)
+			}
+			if actual := SameMacrolanguageTag(tt.a, tt.b); actual != tt.expectedMacro {
+				t.Errorf("SameMacrolanguageTag(%q, %q) = %v, expected %v", tt.a, tt.b, actual, tt.expectedMacro)
+			}
+		})
+	}
+}
+
+func TestIsValidBCP47Primary(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected bool
+	}{
+		{"en", true},
+		{"EN", true},
+		{"cmn", true},
+		{"und", true},
+		{"qab", true},  // private use
+		{"eng", false}, // must be "en"
+		{"ger", false}, // bibliographic code
+		{"xx", false},
+		{"en-US", false},
+		{"e1", false},
+		{"@n", false},
+		{"abcd", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			if actual := IsValidBCP47Primary(tt.tag); actual != tt.expected {
+				t.Errorf("IsValidBCP47Primary() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguage_Tag(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"eng", "en"},
+		{"rus", "ru"},
+		{"cmn", "cmn"}, // not canonicalized to zh
+		{"yue", "yue"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual, err := FromPart3Code(tt.code).Tag()
+			if err != nil || actual.String() != tt.expected {
+				t.Errorf("Tag() = %v, %v, expected %v", actual, err, tt.expected)
+			}
+		})
+	}
+
+	if actual, err := (Language{}).Tag(); err == nil {
+		t.Errorf("Tag() = %v, expected error", actual)
+	}
+	if actual, err := (Language{Part3: "e1g"}).Tag(); err == nil {
+		t.Errorf("Tag() = %v, expected error", actual)
+	}
+}
+
+func TestFromTag(t *testing.T) {
+	tests := map[string]string{
+		"en-US":      "eng",
+		"zh-Hant-TW": "zho",
+		"cmn":        "cmn",
+		"de-CH":      "deu",
+		"und-Cyrl":   "",
+		"und":        "",
+	}
+	for tag, expected := range tests {
+		t.Run(tag, func(t *testing.T) {
+			actual := FromTag(language.MustParse(tag))
+			if expected == "" {
+				if actual != nil {
+					t.Errorf("FromTag() = %v, expected nil", actual)
+				}
+			} else if actual == nil || actual.Part3 != expected {
+				t.Errorf("FromTag() = %v, expected %v", actual, expected)
+			}
+		})
+	}
+}
+
+func TestTagRoundTrip(t *testing.T) {
+	for _, l := range AllLanguages() {
+		if l.Part3 == "und" {
+			continue // undetermined tags are not resolved by FromTag
+		}
+		tag, err := l.Tag()
+		if err != nil {
+			t.Fatalf("Tag() for %v error = %v", l, err)
+		}
+		if actual := FromTag(tag); actual == nil || actual.Part3 != l.Part3 {
+			t.Errorf("FromTag(%v) = %v, expected %v", tag, actual, l)
+		}
+	}
+}
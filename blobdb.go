@@ -0,0 +1,26 @@
+//go:build iso639_blob
+// +build iso639_blob
+
+package iso639_3
+
+import "sync"
+
+var (
+	blobTablesOnce sync.Once
+	blobTables     *tables
+)
+
+// db returns lookup tables of the embedded dataset, decoding them from packed languagesBlob on first use.
+// LanguagesPart3, LanguagesPart2 and LanguagesPart1 aren't declared with this build tag, since assigning them on first lookup
+// would race with concurrent readers, so code using them fails to compile instead of getting nil maps, see LanguageTables
+func db() *tables {
+	blobTablesOnce.Do(func() {
+		langs, err := decodeLanguages(languagesBlob)
+		if err != nil {
+			panic(err) // embedded data is written by the generator, so it's a build error
+		}
+		part3, part2, part1 := lookupTables(langs)
+		blobTables = newTables(langs, part3, part2, part1)
+	})
+	return blobTables
+}
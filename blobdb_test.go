@@ -0,0 +1,13 @@
+//go:build iso639_blob
+// +build iso639_blob
+
+package iso639_3
+
+import "testing"
+
+func TestBlobLookupTables(t *testing.T) {
+	part3, part2, part1 := LanguageTables()
+	if len(part3) != len(db().byPart3) || len(part2) == 0 || len(part1) == 0 {
+		t.Errorf("LanguageTables() have %v, %v and %v entries, expected them filled", len(part3), len(part2), len(part1))
+	}
+}
@@ -0,0 +1,32 @@
+package iso639_3
+
+import "sort"
+
+// CatalogEntry describes a language for language pickers
+type CatalogEntry struct {
+	Code    string // ISO639-1 code
+	Name    string // English reference name
+	Autonym string // name of the language in itself, empty if unknown
+}
+
+// Autonym returns name of the language in itself (e.g. "Deutsch" for German) according to Unicode CLDR.
+// Only languages having ISO639-1 code are covered. Returns empty string if unknown
+func (l Language) Autonym() string {
+	return languageAutonyms[l.Part3]
+}
+
+// Part1Catalog returns code, English name and autonym of every language having ISO639-1 code, sorted by English name,
+// then by ISO639-1 code, so the order is the same on every call
+func Part1Catalog() []CatalogEntry {
+	ret := make([]CatalogEntry, 0, len(db().part1))
+	for code, l := range db().part1 {
+		ret = append(ret, CatalogEntry{code, l.Name, l.Autonym()})
+	}
+	sort.SliceStable(ret, func(i, j int) bool {
+		if ret[i].Name != ret[j].Name {
+			return ret[i].Name < ret[j].Name
+		}
+		return ret[i].Code < ret[j].Code
+	})
+	return ret
+}
@@ -0,0 +1,53 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLanguage_Autonym(t *testing.T) {
+	tests := []struct {
+		part3    string
+		expected string
+	}{
+		{"deu", "Deutsch"},
+		{"rus", "русский"},
+		{"cmn", ""}, // no part 1 code
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			if actual := FromPart3Code(tt.part3).Autonym(); actual != tt.expected {
+				t.Errorf("Autonym() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPart1Catalog(t *testing.T) {
+	actual := Part1Catalog()
+
+	if len(actual) != len(db().part1) {
+		t.Errorf("Part1Catalog() has %v entries, expected %v", len(actual), len(db().part1))
+	}
+
+	var german CatalogEntry
+	for i, e := range actual {
+		if i > 0 && (actual[i-1].Name > e.Name || actual[i-1].Name == e.Name && actual[i-1].Code >= e.Code) {
+			t.Errorf("Part1Catalog() is not sorted by name, then by code at %v", e)
+		}
+		if e.Code == "de" {
+			german = e
+		}
+	}
+
+	expected := CatalogEntry{"de", "German", "Deutsch"}
+	if german != expected {
+		t.Errorf("Part1Catalog() German entry = %v, expected %v", german, expected)
+	}
+
+	for i := 0; i < 10; i++ {
+		if again := Part1Catalog(); !reflect.DeepEqual(again, actual) {
+			t.Fatalf("Part1Catalog() order differs between calls")
+		}
+	}
+}
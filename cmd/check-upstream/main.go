@@ -0,0 +1,125 @@
+// Command check-upstream compares the embedded dataset against current ISO 639-3 code tables published by SIL
+// and prints added, removed and changed languages, so maintainers know when to regenerate lookup tables.
+// Exit status is 0 if there is no drift, 1 if there is, 2 on error
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	iso639_3 "github.com/barbashov/iso639-3"
+)
+
+const (
+	defaultInput = "https://iso639-3.sil.org/sites/iso639-3/files/downloads/iso-639-3.tab"
+	httpTimeout  = 60 * time.Second
+)
+
+// fetchFunc opens input file by path or URL
+type fetchFunc func(uri string) (io.ReadCloser, error)
+
+// fetch opens local file, or downloads it if uri has a scheme
+func fetch(uri string) (io.ReadCloser, error) {
+	parsedUrl, err := url.Parse(uri)
+	if err != nil || parsedUrl.Scheme == "" {
+		return os.Open(uri)
+	}
+
+	httpClient := &http.Client{
+		Timeout: httpTimeout,
+	}
+	r, err := httpClient.Get(uri)
+	if err != nil {
+		return nil, err
+	}
+	if r.StatusCode != http.StatusOK {
+		r.Body.Close()
+		return nil, fmt.Errorf("unexpected response status %s", r.Status)
+	}
+	return r.Body, nil
+}
+
+func main() {
+	inputFile := flag.String("i", defaultInput,
+		fmt.Sprintf("Path or URL to code tables file in tab-separated iso639-3.sil.org format (default %s)", defaultInput))
+	flag.Parse()
+
+	drift, err := run(os.Stdout, fetch, *inputFile)
+	if err != nil {
+		log.Printf("Error checking '%s': %v", *inputFile, err)
+		os.Exit(2)
+	}
+	if drift {
+		os.Exit(1)
+	}
+}
+
+// run compares the embedded dataset against code tables file fetched from uri and writes differences to w,
+// one language per line: "+" for added, "-" for removed and "~" for changed ones. Reports whether there are any
+func run(w io.Writer, fetch fetchFunc, uri string) (bool, error) {
+	r, err := fetch(uri)
+	if err != nil {
+		return false, err
+	}
+	defer r.Close()
+
+	upstream, err := iso639_3.LoadLanguages(r)
+	if err != nil {
+		return false, err
+	}
+
+	embedded := iso639_3.AllLanguages()
+	for i := range embedded {
+		embedded[i].MacrolanguageCode = "" // not in code tables file
+	}
+
+	diff := iso639_3.DiffLanguages(embedded, upstream)
+	for _, l := range diff.Added {
+		fmt.Fprintf(w, "+ %s\n", l)
+	}
+	for _, l := range diff.Removed {
+		fmt.Fprintf(w, "- %s\n", l)
+	}
+	for _, c := range diff.Changed {
+		fmt.Fprintf(w, "~ %s:", c.New.Part3)
+		for _, f := range changedFields(c.Old, c.New) {
+			fmt.Fprintf(w, " %s %q -> %q;", f.name, f.old, f.new)
+		}
+		fmt.Fprintln(w)
+	}
+	if diff.Empty() {
+		fmt.Fprintln(w, "No drift")
+	}
+	return !diff.Empty(), nil
+}
+
+type fieldChange struct {
+	name, old, new string
+}
+
+// changedFields returns fields differing between two versions of a language
+func changedFields(old, new iso639_3.Language) []fieldChange {
+	fields := []fieldChange{
+		{"Part2B", old.Part2B, new.Part2B},
+		{"Part2T", old.Part2T, new.Part2T},
+		{"Part1", old.Part1, new.Part1},
+		{"Scope", string(old.Scope), string(new.Scope)},
+		{"LanguageType", string(old.LanguageType), string(new.LanguageType)},
+		{"Name", old.Name, new.Name},
+		{"Comment", old.Comment, new.Comment},
+	}
+
+	var ret []fieldChange
+	for _, f := range fields {
+		if f.old != f.new {
+			ret = append(ret, f)
+		}
+	}
+	return ret
+}
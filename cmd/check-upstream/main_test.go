@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	iso639_3 "github.com/barbashov/iso639-3"
+)
+
+// codeTables writes languages in iso-639-3.tab format
+func codeTables(langs []iso639_3.Language) string {
+	buf := strings.Builder{}
+	buf.WriteString("Id\tPart2B\tPart2T\tPart1\tScope\tLanguage_Type\tRef_Name\tComment\r\n")
+	for _, l := range langs {
+		buf.WriteString(strings.Join([]string{l.Part3, l.Part2B, l.Part2T, l.Part1,
+			string(l.Scope), string(l.LanguageType), l.Name, l.Comment}, "\t") + "\r\n")
+	}
+	return buf.String()
+}
+
+func fixtureFetch(fixture string) fetchFunc {
+	return func(string) (io.ReadCloser, error) {
+		return ioutil.NopCloser(strings.NewReader(fixture)), nil
+	}
+}
+
+func TestRun(t *testing.T) {
+	var upstream []iso639_3.Language
+	for _, l := range iso639_3.AllLanguages() {
+		switch l.Part3 {
+		case "aab":
+			continue
+		case "rus":
+			l.Name = "Russian Federation"
+			l.Comment = "renamed"
+		}
+		upstream = append(upstream, l)
+	}
+	upstream = append(upstream, iso639_3.Language{Part3: "zzy", Scope: 'I', LanguageType: 'L', Name: "Newly Added"})
+
+	out := bytes.Buffer{}
+	drift, err := run(&out, fixtureFetch(codeTables(upstream)), "fixture")
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+	if !drift {
+		t.Error("run() = false, expected drift")
+	}
+
+	expected := "+ Newly Added (zzy)\n" +
+		"- Alumu-Tesu (aab)\n" +
+		"~ rus: Name \"Russian\" -> \"Russian Federation\"; Comment \"\" -> \"renamed\";\n"
+	if out.String() != expected {
+		t.Errorf("run() wrote %q, expected %q", out.String(), expected)
+	}
+}
+
+func TestRunNoDrift(t *testing.T) {
+	out := bytes.Buffer{}
+	drift, err := run(&out, fixtureFetch(codeTables(iso639_3.AllLanguages())), "fixture")
+	if err != nil || drift {
+		t.Errorf("run() = %v, %v, expected no drift", drift, err)
+	}
+	if out.String() != "No drift\n" {
+		t.Errorf("run() wrote %q, expected %q", out.String(), "No drift\n")
+	}
+}
+
+func TestRunFetchError(t *testing.T) {
+	fetchErr := errors.New("network is down")
+	failing := func(string) (io.ReadCloser, error) { return nil, fetchErr }
+
+	if _, err := run(ioutil.Discard, failing, "fixture"); err != fetchErr {
+		t.Errorf("run() error = %v, expected %v", err, fetchErr)
+	}
+}
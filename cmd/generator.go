@@ -3,7 +3,11 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
 	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"go/format"
@@ -13,78 +17,466 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
 	"reflect"
+	"sort"
+	"strings"
 	"time"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
 )
 
 const (
-	defaultInput       = "https://iso639-3.sil.org/sites/iso639-3/files/downloads/iso-639-3.tab"
-	httpTimeout        = 60 * time.Second
-	inputFileSeparator = '\t'
+	defaultInput               = "https://iso639-3.sil.org/sites/iso639-3/files/downloads/iso-639-3.tab"
+	defaultMacrolanguagesInput = "https://iso639-3.sil.org/sites/iso639-3/files/downloads/iso-639-3-macrolanguages.tab"
+	defaultNameIndexInput      = "https://iso639-3.sil.org/sites/iso639-3/files/downloads/iso-639-3_Name_Index.tab"
+	defaultRetirementsInput    = "https://iso639-3.sil.org/sites/iso639-3/files/downloads/iso-639-3_Retirements.tab"
+	httpTimeout                = 60 * time.Second
+	inputFileSeparator         = '\t'
+
+	sourceFilePrefix = `//go:build !iso639_blob
+// +build !iso639_blob
+
+package iso639_3
+
+`
+
+	blobSourceFilePrefix = `//go:build iso639_blob
+// +build iso639_blob
+
+package iso639_3
+
+import _ "embed"
+
+`
+
+	blobTablesFormat = `// languagesBlob holds all languages packed by the generator with -blob-data flag, sorted by ISO 639-3 code
+//
+//go:embed %s
+var languagesBlob string
+
+`
+
+	// blobMagic starts packed languages, must be kept in sync with its copy in tables.go
+	blobMagic = "L639"
+
+	datasetVersionFormat = `// datasetVersion identifies data the lookup tables were generated from
+var datasetVersion = "%s"
+
+`
+
+	// number of hex digits of input data checksum used as dataset version
+	datasetVersionLength = 12
+
+	mphFilePrefix = `//go:build iso639_mph
+// +build iso639_mph
+
+package iso639_3
 
-	sourceFilePrefix = `package iso639_3
+`
+
+	mphSeedsPrefix = `// part3MPHSeeds holds minimal perfect hash seed for each bucket of ISO 639-3 codes
+var part3MPHSeeds = [...]uint32{
+`
 
+	mphIndicesPrefix = `// part3MPHIndices maps minimal perfect hash slots to indices of languages sorted by ISO 639-3 code
+var part3MPHIndices = [...]uint16{
 `
 
-	part3Prefix = `// LanguagesPart3 lookup table. Keys are ISO 639-3 codes
+	mphBucketSize = 4       // average number of keys per bucket
+	mphMaxSeed    = 1 << 24 // give up searching for bucket seed after that
+
+	part3Prefix = `// LanguagesPart3 lookup table. Keys are ISO 639-3 codes.
+// Not declared with iso639_blob build tag, use LanguageTables to get it regardless of build tags
 var LanguagesPart3 = map[string]Language{
 `
 
-	part2Prefix = `// LanguagesPart2 lookup table. Keys are ISO 639-2 codes
+	part2Prefix = `// LanguagesPart2 lookup table. Keys are ISO 639-2 codes.
+// Not declared with iso639_blob build tag, use LanguageTables to get it regardless of build tags
 var LanguagesPart2 = map[string]Language{
 `
 
-	part1Prefix = `// LanguagesPart1 lookup table. Keys are ISO 639-1 codes
+	part2BPrefix = `// LanguagesPart2B lookup table. Keys are ISO 639-2 bibliographic codes.
+// Generated only because some codes collide with terminology codes of other languages, see LanguagesPart2
+var LanguagesPart2B = map[string]Language{
+`
+
+	part2TPrefix = `// LanguagesPart2T lookup table. Keys are ISO 639-2 terminology codes.
+// Generated only because some codes collide with bibliographic codes of other languages, see LanguagesPart2
+var LanguagesPart2T = map[string]Language{
+`
+
+	part1Prefix = `// LanguagesPart1 lookup table. Keys are ISO 639-1 codes.
+// Not declared with iso639_blob build tag, use LanguageTables to get it regardless of build tags
 var LanguagesPart1 = map[string]Language{
+`
+
+	macrolanguagesPrefix = `// macrolanguageMembers lookup table. Keys are ISO 639-3 macrolanguage codes, values are ISO 639-3 codes of their members
+var macrolanguageMembers = map[string][]string{
+`
+
+	altNamesPrefix = `// languageAltNames lookup table. Keys are ISO 639-3 codes, values are alternative names from the name index,
+// sorted and without exact (case-sensitive) duplicates. Empty unless generated with the name index
+var languageAltNames = map[string][]string{
+`
+
+	retiredCodesPrefix = `// retiredCodes lookup table. Keys are retired ISO 639-3 codes
+var retiredCodes = map[string]RetiredCode{
+`
+
+	autonymsPrefix = `// languageAutonyms lookup table. Keys are ISO 639-3 codes, values are names of languages in themselves from Unicode CLDR
+var languageAutonyms = map[string]string{
 `
 
 	lookupSuffix = `}
 `
+
+	namesFilePrefix = `package iso639_3
+
+// languageByFoldedName lookup table. Keys are reference names folded with foldName, values are ISO 639-3 codes.
+// If several languages share folded name, macrolanguage is preferred, then living language, then the one with lowest code
+// (see preferredByName). Alternative names from the name index, if generated with it, are included unless they clash with reference names.
+// The index trades size for speed of case-insensitive lookups: it adds roughly 300 KB to binaries using it
+var languageByFoldedName = map[string]string{
+`
+
+	likelyFilePrefix = `// Data in this file is derived from Unicode CLDR likely subtags (https://cldr.unicode.org),
+// copyright Unicode, Inc., distributed under the Unicode License, as provided by golang.org/x/text/language
+
+package iso639_3
+
+`
+
+	likelyRegionsPrefix = `// likelyLanguageByRegion lookup table. Keys are region codes, values are ISO 639-3 codes of languages most likely used there
+var likelyLanguageByRegion = map[string]string{
+`
+
+	likelyScriptsPrefix = `// likelyLanguageByScript lookup table. Keys are ISO 15924 script codes, values are ISO 639-3 codes of languages most likely written in them
+var likelyLanguageByScript = map[string]string{
+`
+
+	protoSchemaPrefix = `// Code generated by cmd/generator.go -proto-schema. DO NOT EDIT.
+
+syntax = "proto3";
+
+package iso639_3;
+
+option go_package = "github.com/barbashov/iso639-3/langpb";
+
+// LanguageList holds all ISO 639-3 languages, as written by the generator with -proto flag
+message LanguageList {
+  repeated Language languages = 1;
+}
+
+// Language holds language info - all ISO 639 codes along with name and some additional info
+message Language {
+`
+
+	protoSchemaSuffix = `}
+`
+
+	// macrolanguage mappings file marks members still in use with this status, retired ones are skipped
+	activeMemberStatus = "A"
 )
 
 var (
 	languageStructFields = []struct {
 		name      string
+		column    string // input file header name, empty for fields not read from input file
 		fieldType reflect.Kind
+		values    []string // allowed values of enum fields
+		names     []string // names of allowed values of enum fields, as encoded to JSON
 	}{
-		{"Part3", reflect.String},
-		{"Part2B", reflect.String},
-		{"Part2T", reflect.String},
-		{"Part1", reflect.String},
-		{"Scope", reflect.Uint8}, // no rune kind :(
-		{"LanguageType", reflect.Uint8},
-		{"Name", reflect.String},
-		{"Comment", reflect.String},
+		{"Part3", "Id", reflect.String, nil, nil},
+		{"Part2B", "Part2B", reflect.String, nil, nil},
+		{"Part2T", "Part2T", reflect.String, nil, nil},
+		{"Part1", "Part1", reflect.String, nil, nil},
+		{"Scope", "Scope", reflect.Uint8, []string{"I", "M", "S"}, // no rune kind :(
+			[]string{"Individual", "Macrolanguage", "Special"}},
+		{"LanguageType", "Language_Type", reflect.Uint8, []string{"L", "H", "A", "E", "C", "S"},
+			[]string{"Living", "Historical", "Ancient", "Extinct", "Constructed", "Special"}},
+		{"Name", "Ref_Name", reflect.String, nil, nil},
+		{"Comment", "Comment", reflect.String, nil, nil},
+		{"MacrolanguageCode", "", reflect.String, nil, nil}, // filled from macrolanguage mappings, see addMacrolanguageCodes
 	}
+
+	macrolanguageColumns = []string{"M_Id", "I_Id", "I_Status"}
+	nameIndexColumns     = []string{"Id", "Print_Name", "Inverted_Name"}
+	retirementColumns    = []string{"Id", "Ref_Name", "Ret_Reason", "Change_To", "Ret_Remedy", "Effective"}
 )
 
+// languageColumns returns input file header names of languageStructFields read from input file
+func languageColumns() []string {
+	ret := make([]string, 0, len(languageStructFields))
+	for _, field := range languageStructFields {
+		if field.column != "" {
+			ret = append(ret, field.column)
+		}
+	}
+	return ret
+}
+
+// addMacrolanguageCodes appends MacrolanguageCode value to each language record: ISO 639-3 code of macrolanguage
+// the language is an active member of, or empty string
+func addMacrolanguageCodes(records [][]string, macroRecords [][]string) ([][]string, error) {
+	macros := map[string]string{}
+	for _, record := range macroRecords {
+		if len(record) < 3 {
+			return nil, fmt.Errorf("malformed macrolanguage record: %v", record)
+		}
+		if record[2] == activeMemberStatus {
+			macros[record[1]] = record[0]
+		}
+	}
+
+	ret := make([][]string, len(records))
+	for i, record := range records {
+		ret[i] = append(record[:len(record):len(record)], macros[record[0]])
+	}
+	return ret, nil
+}
+
 func main() {
 	inputFile := flag.String("i", defaultInput,
 		fmt.Sprintf("Path or URL to input file in tab-separated iso639-3.sil.org format (default %s)", defaultInput))
+	macrolanguagesFile := flag.String("m", defaultMacrolanguagesInput,
+		fmt.Sprintf("Path or URL to macrolanguage mappings file in tab-separated iso639-3.sil.org format, empty to skip (default %s)", defaultMacrolanguagesInput))
+	nameIndexFile := flag.String("n", "",
+		fmt.Sprintf("Path or URL to name index file in tab-separated iso639-3.sil.org format, e.g. %s (default - don't generate alternative names)", defaultNameIndexInput))
+	retirementsFile := flag.String("r", "",
+		fmt.Sprintf("Path or URL to retirements file in tab-separated iso639-3.sil.org format, e.g. %s (default - don't generate retired codes)", defaultRetirementsInput))
 	outfile := flag.String("o", "", "Output file (default - standard output)")
+	schemaFile := flag.String("schema", "", "Output file for JSON Schema of Language type (default - don't generate)")
+	mphFile := flag.String("mph", "", "Output file for minimal perfect hash of ISO 639-3 codes, used with iso639_mph build tag (default - don't generate)")
+	namesFile := flag.String("names", "", "Output file for index of languages by case-folded reference name (default - don't generate)")
+	likelyFile := flag.String("likely", "", "Output file for languages likely used in regions and scripts from Unicode CLDR (default - don't generate)")
+	protoFile := flag.String("proto", "", "Output file for all languages serialized as LanguageList Protocol Buffers message (default - don't generate)")
+	protoSchemaFile := flag.String("proto-schema", "", "Output file for Protocol Buffers schema of LanguageList message (default - don't generate)")
+	blobFile := flag.String("blob", "", "Output file for lookup tables loaded from -blob-data file on first use, used with iso639_blob build tag instead of -o output (default - don't generate)")
+	blobDataFile := flag.String("blob-data", "", "Output file for all languages packed in binary format, embedded by -blob output (default - don't generate)")
 	flag.Parse()
 
-	rd := getInput(*inputFile)
-	tsvReader := csv.NewReader(rd)
-	tsvReader.Comma = inputFileSeparator
+	if (*blobFile == "") != (*blobDataFile == "") {
+		log.Fatalf("-blob and -blob-data flags must be set together")
+	}
+
+	if *schemaFile != "" {
+		f, err := os.Create(*schemaFile)
+		if err != nil {
+			log.Fatalf("Can't create schema file '%s': %v", *schemaFile, err)
+		}
+		err = outputSchema(f)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing schema file '%s': %v", *schemaFile, err)
+		}
+	}
+
+	if *protoSchemaFile != "" {
+		f, err := os.Create(*protoSchemaFile)
+		if err != nil {
+			log.Fatalf("Can't create Protocol Buffers schema file '%s': %v", *protoSchemaFile, err)
+		}
+		err = outputProtoSchema(f)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing Protocol Buffers schema file '%s': %v", *protoSchemaFile, err)
+		}
+	}
 
-	langInput, err := tsvReader.ReadAll()
+	langInput, err := selectColumns(readInput(*inputFile), languageColumns())
 	if err != nil {
 		log.Fatalf("Error reading input file '%s': %v", *inputFile, err)
 	}
 
-	langInput = langInput[1:] // skip header
+	var macroInput [][]string
+	if *macrolanguagesFile != "" {
+		macroInput, err = selectColumns(readInput(*macrolanguagesFile), macrolanguageColumns)
+		if err != nil {
+			log.Fatalf("Error reading input file '%s': %v", *macrolanguagesFile, err)
+		}
+	}
+
+	langInput, err = addMacrolanguageCodes(langInput, macroInput)
+	if err != nil {
+		log.Fatalf("Error reading input file '%s': %v", *macrolanguagesFile, err)
+	}
+
+	var nameInput [][]string
+	if *nameIndexFile != "" {
+		nameInput, err = selectColumns(readInput(*nameIndexFile), nameIndexColumns)
+		if err != nil {
+			log.Fatalf("Error reading input file '%s': %v", *nameIndexFile, err)
+		}
+	}
+
+	var retiredInput [][]string
+	if *retirementsFile != "" {
+		retiredInput, err = selectColumns(readInput(*retirementsFile), retirementColumns)
+		if err != nil {
+			log.Fatalf("Error reading input file '%s': %v", *retirementsFile, err)
+		}
+	}
 
 	wr := os.Stdout
 	if *outfile != "" {
-		var err error
 		wr, err = os.Create(*outfile)
 		if err != nil {
 			log.Fatalf("Can't create output file '%s': %v", *outfile, err)
 		}
 	}
 
-	outputLookup(wr, langInput)
+	outputLookup(wr, langInput, macroInput, nameInput, retiredInput, "")
+
+	if *blobFile != "" {
+		f, err := os.Create(*blobDataFile)
+		if err != nil {
+			log.Fatalf("Can't create packed languages file '%s': %v", *blobDataFile, err)
+		}
+		err = outputBlob(f, langInput)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing packed languages file '%s': %v", *blobDataFile, err)
+		}
+
+		f, err = os.Create(*blobFile)
+		if err != nil {
+			log.Fatalf("Can't create output file '%s': %v", *blobFile, err)
+		}
+		outputLookup(f, langInput, macroInput, nameInput, retiredInput, filepath.Base(*blobDataFile))
+		err = f.Close()
+		if err != nil {
+			log.Fatalf("Error writing output file '%s': %v", *blobFile, err)
+		}
+	}
+
+	if *mphFile != "" {
+		f, err := os.Create(*mphFile)
+		if err != nil {
+			log.Fatalf("Can't create minimal perfect hash file '%s': %v", *mphFile, err)
+		}
+		err = outputMPH(f, langInput)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing minimal perfect hash file '%s': %v", *mphFile, err)
+		}
+	}
+
+	if *namesFile != "" {
+		f, err := os.Create(*namesFile)
+		if err != nil {
+			log.Fatalf("Can't create names file '%s': %v", *namesFile, err)
+		}
+		err = outputFoldedNames(f, langInput, nameInput)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing names file '%s': %v", *namesFile, err)
+		}
+	}
+
+	if *likelyFile != "" {
+		f, err := os.Create(*likelyFile)
+		if err != nil {
+			log.Fatalf("Can't create likely languages file '%s': %v", *likelyFile, err)
+		}
+		err = outputLikely(f, langInput)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing likely languages file '%s': %v", *likelyFile, err)
+		}
+	}
+
+	if *protoFile != "" {
+		f, err := os.Create(*protoFile)
+		if err != nil {
+			log.Fatalf("Can't create Protocol Buffers file '%s': %v", *protoFile, err)
+		}
+		err = outputProto(f, langInput)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			log.Fatalf("Error writing Protocol Buffers file '%s': %v", *protoFile, err)
+		}
+	}
+}
+
+// readInput reads tab-separated file and returns all its records including header
+func readInput(uri string) [][]string {
+	tsvReader := csv.NewReader(getInput(uri))
+	tsvReader.Comma = inputFileSeparator
+
+	records, err := tsvReader.ReadAll()
+	if err != nil {
+		log.Fatalf("Error reading input file '%s': %v", uri, err)
+	}
+	return records
+}
+
+// selectColumns locates required columns using header (first record)
+// and returns records without header with values ordered as columns.
+// Unknown columns are ignored with a warning, so schema additions don't break generation
+func selectColumns(records [][]string, columns []string) ([][]string, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("no header found")
+	}
+
+	header := records[0]
+	indices := make([]int, len(columns))
+	for i, column := range columns {
+		indices[i] = -1
+		for j, name := range header {
+			if name == column {
+				indices[i] = j
+				break
+			}
+		}
+		if indices[i] < 0 {
+			return nil, fmt.Errorf("required column '%s' not found in header %v", column, header)
+		}
+	}
+
+	for _, name := range header {
+		known := false
+		for _, column := range columns {
+			if name == column {
+				known = true
+				break
+			}
+		}
+		if !known {
+			log.Printf("Warning: ignoring unknown column '%s'", name)
+		}
+	}
+
+	ret := make([][]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		selected := make([]string, len(indices))
+		for i, index := range indices {
+			if index >= len(record) {
+				return nil, fmt.Errorf("malformed record: %v", record)
+			}
+			selected[i] = record[index]
+		}
+		ret = append(ret, selected)
+	}
+
+	return ret, nil
 }
 
 func getInput(uri string) io.Reader {
@@ -159,86 +551,876 @@ func outputStruct(w io.Writer, key string, record []string) error {
 	return err
 }
 
-func outputLookup(w io.Writer, records [][]string) {
-	buf := bytes.Buffer{}
+// outputRetiredCodes writes RetiredCode literals of retirements file records sorted by retired code, omitting empty fields
+func outputRetiredCodes(w io.Writer, records [][]string) error {
+	sorted := make([][]string, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i][0] < sorted[j][0] })
 
-	_, err := fmt.Fprint(&buf, sourceFilePrefix)
-	if err != nil {
-		log.Fatalf("Error generating: %v", err)
-	}
+	fields := []string{"Code", "Name", "Reason", "ChangeTo", "Remedy", "Effective"}
+	for _, record := range sorted {
+		if len(record) != len(fields) || record[0] == "" || len(record[2]) != 1 {
+			return fmt.Errorf("malformed retirement record: %v", record)
+		}
 
-	/* Part 3 lookup */
+		values := make([]string, 0, len(fields))
+		for i, value := range record {
+			switch {
+			case value == "":
+				continue
+			case fields[i] == "Reason":
+				values = append(values, fmt.Sprintf("%s: '%s'", fields[i], value))
+			default:
+				values = append(values, fmt.Sprintf("%s: %q", fields[i], value))
+			}
+		}
 
-	_, err = fmt.Fprint(&buf, part3Prefix)
-	if err != nil {
-		log.Fatalf("Error generating: %v", err)
+		_, err := fmt.Fprintf(w, "%q: {%s},\n", record[0], strings.Join(values, ", "))
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
+func outputMacrolanguages(w io.Writer, records [][]string) error {
+	members := map[string][]string{}
 	for _, record := range records {
-		key := record[0]
-		err = outputStruct(&buf, key, record)
+		if len(record) < 3 {
+			return fmt.Errorf("malformed macrolanguage record: %v", record)
+		}
+		if record[2] != activeMemberStatus {
+			continue
+		}
+		members[record[0]] = append(members[record[0]], record[1])
+	}
+
+	macros := make([]string, 0, len(members))
+	for macro := range members {
+		macros = append(macros, macro)
+	}
+	sort.Strings(macros)
+
+	for _, macro := range macros {
+		codes := members[macro]
+		sort.Strings(codes)
+
+		_, err := fmt.Fprintf(w, `"%s": {"%s"},`+"\n", macro, strings.Join(codes, `", "`))
 		if err != nil {
-			log.Fatalf("Error generating: %v", err)
+			return err
 		}
 	}
 
-	_, err = fmt.Fprint(&buf, lookupSuffix)
-	if err != nil {
-		log.Fatalf("Error generating: %v", err)
+	return nil
+}
+
+// outputSchema writes JSON Schema describing JSON encoding of Language type
+func outputSchema(w io.Writer) error {
+	properties := map[string]interface{}{}
+	required := make([]string, 0, len(languageStructFields))
+	for _, field := range languageStructFields {
+		switch field.fieldType {
+		case reflect.String:
+			properties[field.name] = map[string]interface{}{"type": "string"}
+		case reflect.Uint8:
+			// runes are encoded by name, see Language.MarshalJSON
+			properties[field.name] = map[string]interface{}{
+				"type":        "string",
+				"enum":        field.names,
+				"description": fmt.Sprintf("Name of one of: %s", strings.Join(field.values, ", ")),
+			}
+		default:
+			return fmt.Errorf("unknown field kind: %v", field)
+		}
+		required = append(required, field.name)
 	}
 
-	/* Part 2 lookup */
+	schema := map[string]interface{}{
+		"$schema":    "https://json-schema.org/draft/2020-12/schema",
+		"title":      "Language",
+		"type":       "object",
+		"properties": properties,
+		"required":   required,
+	}
 
-	_, err = fmt.Fprint(&buf, part2Prefix)
+	bs, err := json.MarshalIndent(schema, "", "  ")
 	if err != nil {
-		log.Fatalf("Error generating: %v", err)
+		return err
 	}
+	_, err = fmt.Fprintln(w, string(bs))
+	return err
+}
 
-	for _, record := range records {
-		key2b := record[1]
-		key2t := record[2]
-		if key2b == "" {
-			continue
+// likelyLanguages returns ISO 639-3 codes of languages CLDR considers most likely used in each region or written in each script,
+// keyed by region or script code. Codes x/text doesn't know are skipped, as are scripts
+// for which it falls back to the default "und" maximization (English) instead of having CLDR data,
+// and languages not in known ISO 639-3 codes (e.g. collective "zhx")
+func likelyLanguages(known map[string]bool) (map[string]string, map[string]string) {
+	regions := map[string]string{}
+	scripts := map[string]string{}
+	for a := 'A'; a <= 'Z'; a++ {
+		for b := 'A'; b <= 'Z'; b++ {
+			code := string([]rune{a, b})
+			region, err := language.ParseRegion(code)
+			if err != nil || !region.IsCountry() || region.String() != code {
+				continue
+			}
+			tag, _ := language.Compose(region)
+			if base, confidence := tag.Base(); confidence != language.No && known[base.ISO3()] {
+				regions[code] = base.ISO3()
+			}
+		}
+
+		for b := 'a'; b <= 'z'; b++ {
+			for c := 'a'; c <= 'z'; c++ {
+				for d := 'a'; d <= 'z'; d++ {
+					code := string([]rune{a, b, c, d})
+					script, err := language.ParseScript(code)
+					if err != nil || script.String() != code {
+						continue
+					}
+					tag, _ := language.Compose(script)
+					base, confidence := tag.Base()
+					if confidence != language.No && known[base.ISO3()] && (base.String() != "en" || code == "Latn") {
+						scripts[code] = base.ISO3()
+					}
+				}
+			}
 		}
+	}
+	return regions, scripts
+}
+
+// outputLikely writes likely languages of regions and scripts, see likelyLanguages
+func outputLikely(w io.Writer, records [][]string) error {
+	known := map[string]bool{}
+	for _, record := range records {
+		known[record[0]] = true
+	}
+
+	buf := bytes.Buffer{}
+	_, err := fmt.Fprint(&buf, likelyFilePrefix)
+	if err != nil {
+		return err
+	}
 
-		err = outputStruct(&buf, key2b, record)
+	regions, scripts := likelyLanguages(known)
+	for i, lookup := range []map[string]string{regions, scripts} {
+		_, err = fmt.Fprint(&buf, []string{likelyRegionsPrefix, likelyScriptsPrefix}[i])
 		if err != nil {
-			log.Fatalf("Error generating: %v", err)
+			return err
 		}
 
-		// there are no conflicts between part2b and part2t identifiers so we're allowed to do that
-		if key2b != key2t {
-			err = outputStruct(&buf, key2t, record)
+		keys := make([]string, 0, len(lookup))
+		for key := range lookup {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			_, err = fmt.Fprintf(&buf, "%q: %q,\n", key, lookup[key])
 			if err != nil {
-				log.Fatalf("Error generating: %v", err)
+				return err
 			}
 		}
+
+		_, err = fmt.Fprint(&buf, lookupSuffix)
+		if err != nil {
+			return err
+		}
 	}
 
-	_, err = fmt.Fprint(&buf, lookupSuffix)
+	src, err := format.Source(buf.Bytes())
 	if err != nil {
-		log.Fatalf("Error generating: %v", err)
+		return err
 	}
+	_, err = w.Write(src)
+	return err
+}
 
-	/* Part 1 lookup */
+// protoFieldName converts Language field name to snake case used in Protocol Buffers, e.g. "LanguageType" to "language_type"
+func protoFieldName(name string) string {
+	var sb strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && unicode.IsLower(rune(name[i-1])) {
+				sb.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
 
-	_, err = fmt.Fprint(&buf, part1Prefix)
+// outputProtoSchema writes Protocol Buffers schema of LanguageList and Language messages.
+// Fields are numbered in order of languageStructFields, so new fields must only be appended there.
+// Rune fields are encoded as one-symbol strings
+func outputProtoSchema(w io.Writer) error {
+	_, err := fmt.Fprint(w, protoSchemaPrefix)
 	if err != nil {
-		log.Fatalf("Error generating: %v", err)
+		return err
 	}
 
-	for _, record := range records {
-		key := record[3]
-		if key == "" {
-			continue
+	for i, field := range languageStructFields {
+		switch field.fieldType {
+		case reflect.String:
+		case reflect.Uint8:
+			_, err = fmt.Fprintf(w, "  // one of: %s\n", strings.Join(field.values, ", "))
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown field kind: %v", field)
 		}
 
-		err = outputStruct(&buf, key, record)
+		_, err = fmt.Fprintf(w, "  string %s = %d;\n", protoFieldName(field.name), i+1)
 		if err != nil {
-			log.Fatalf("Error generating: %v", err)
+			return err
 		}
 	}
 
+	_, err = fmt.Fprint(w, protoSchemaSuffix)
+	return err
+}
+
+// appendProtoString appends length-delimited field in Protocol Buffers wire format
+func appendProtoString(buf []byte, number int, value []byte) []byte {
+	varint := make([]byte, binary.MaxVarintLen64)
+	buf = append(buf, varint[:binary.PutUvarint(varint, uint64(number)<<3|2)]...)
+	buf = append(buf, varint[:binary.PutUvarint(varint, uint64(len(value)))]...)
+	return append(buf, value...)
+}
+
+// outputProto writes all languages as LanguageList message in Protocol Buffers wire format, see outputProtoSchema.
+// Empty fields are omitted as proto3 requires
+func outputProto(w io.Writer, records [][]string) error {
+	var list []byte
+	var lang []byte
+	for _, record := range records {
+		if len(record) != len(languageStructFields) {
+			return fmt.Errorf("malformed record: %v", record)
+		}
+
+		lang = lang[:0]
+		for i, value := range record {
+			if value != "" {
+				lang = appendProtoString(lang, i+1, []byte(value))
+			}
+		}
+		list = appendProtoString(list, 1, lang)
+	}
+
+	_, err := w.Write(list)
+	return err
+}
+
+// mphHash is 32-bit FNV-1a hash mixed with seed. Must be kept in sync with its copy in mph.go
+func mphHash(s string, seed uint32) uint32 {
+	h := uint32(2166136261) ^ seed
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
+
+// buildMPH builds minimal perfect hash of keys using hash and displace method: keys are distributed into buckets
+// by unseeded hash, then for each bucket, largest first, a seed placing all its keys into free slots is searched.
+// Returns seed of each bucket and key index of each slot
+func buildMPH(keys []string) ([]uint32, []uint16, error) {
+	n := uint32(len(keys))
+	if n == 0 || n > 1<<16 {
+		return nil, nil, fmt.Errorf("can't build minimal perfect hash of %d keys", n)
+	}
+
+	buckets := make([][]int, (n+mphBucketSize-1)/mphBucketSize)
+	for i, key := range keys {
+		b := mphHash(key, 0) % uint32(len(buckets))
+		buckets[b] = append(buckets[b], i)
+	}
+
+	order := make([]int, len(buckets))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return len(buckets[order[i]]) > len(buckets[order[j]]) })
+
+	seeds := make([]uint32, len(buckets))
+	indices := make([]uint16, n)
+	taken := make([]bool, n)
+	for _, b := range order {
+		if len(buckets[b]) == 0 {
+			break
+		}
+
+		slots := make([]uint32, 0, len(buckets[b]))
+		for seed := uint32(1); len(slots) < len(buckets[b]); seed++ {
+			if seed > mphMaxSeed {
+				return nil, nil, fmt.Errorf("can't find seed for bucket %d", b)
+			}
+
+			slots = slots[:0]
+			for _, i := range buckets[b] {
+				slot := mphHash(keys[i], seed) % n
+				free := !taken[slot]
+				for _, s := range slots {
+					free = free && s != slot
+				}
+				if !free {
+					break
+				}
+				slots = append(slots, slot)
+			}
+			seeds[b] = seed
+		}
+
+		for j, slot := range slots {
+			taken[slot] = true
+			indices[slot] = uint16(buckets[b][j])
+		}
+	}
+
+	return seeds, indices, nil
+}
+
+// outputMPH writes minimal perfect hash of ISO 639-3 codes, indexing them in sorted order
+func outputMPH(w io.Writer, records [][]string) error {
+	keys := make([]string, len(records))
+	for i, record := range records {
+		keys[i] = record[0]
+	}
+	sort.Strings(keys)
+
+	seeds, indices, err := buildMPH(keys)
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.Buffer{}
+	buf.WriteString(mphFilePrefix)
+
+	buf.WriteString(mphSeedsPrefix)
+	for i, seed := range seeds {
+		fmt.Fprintf(&buf, "%d,", seed)
+		if i%16 == 15 {
+			buf.WriteString("\n")
+		}
+	}
+	buf.WriteString("\n" + lookupSuffix + "\n")
+
+	buf.WriteString(mphIndicesPrefix)
+	for i, index := range indices {
+		fmt.Fprintf(&buf, "%d,", index)
+		if i%16 == 15 {
+			buf.WriteString("\n")
+		}
+	}
+	buf.WriteString("\n" + lookupSuffix)
+
+	outBytes, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(outBytes)
+	return err
+}
+
+// datasetVersion computes checksum of all input records, so it changes whenever input data changes
+func datasetVersion(inputs ...[][]string) string {
+	h := sha256.New()
+	for _, records := range inputs {
+		for _, record := range records {
+			for _, value := range record {
+				h.Write([]byte(value))
+				h.Write([]byte{inputFileSeparator})
+			}
+			h.Write([]byte{'\n'})
+		}
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))[:datasetVersionLength]
+}
+
+// altNames collects alternative names of each language from name index records, skipping reference names.
+// Names are sorted and deduplicated, only exact (case-sensitive) duplicates are removed
+func altNames(records [][]string, nameRecords [][]string) map[string][]string {
+	refNames := map[string]string{}
+	for _, record := range records {
+		refNames[record[0]] = record[6]
+	}
+
+	unique := map[string]map[string]bool{}
+	for _, record := range nameRecords {
+		code := record[0]
+		for _, name := range record[1:] {
+			if name == "" || name == refNames[code] {
+				continue
+			}
+			if unique[code] == nil {
+				unique[code] = map[string]bool{}
+			}
+			unique[code][name] = true
+		}
+	}
+
+	ret := make(map[string][]string, len(unique))
+	for code, names := range unique {
+		for name := range names {
+			ret[code] = append(ret[code], name)
+		}
+		sort.Strings(ret[code])
+	}
+	return ret
+}
+
+func outputAltNames(w io.Writer, names map[string][]string) error {
+	codes := make([]string, 0, len(names))
+	for code := range names {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	for _, code := range codes {
+		_, err := fmt.Fprintf(w, "%q: {", code)
+		if err != nil {
+			return err
+		}
+
+		for i, name := range names[code] {
+			if i > 0 {
+				_, err = fmt.Fprint(w, ", ")
+				if err != nil {
+					return err
+				}
+			}
+			_, err = fmt.Fprintf(w, "%q", name)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = fmt.Fprintln(w, "},")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// autonym returns name of language with given ISO 639-1 code in itself according to CLDR data bundled with golang.org/x/text,
+// or empty string if CLDR doesn't have it
+func autonym(part1 string) string {
+	tag, err := language.Parse(part1)
+	if err != nil {
+		return ""
+	}
+	return display.Self.Name(tag)
+}
+
+// foldedNames maps reference names folded the same way as foldName in the library does (whitespace normalization
+// and full Unicode case folding) to ISO 639-3 codes. Of languages sharing folded name, macrolanguage wins,
+// then living language, then the one with lowest code. Alternatives of names packing several ones separated by ";"
+// are indexed too, unless another language has such reference name (see nameAlternatives in the library).
+// Finally alternative names from name index (see altNames) are indexed, unless some reference name folds the same way
+func foldedNames(records [][]string, alt map[string][]string) map[string]string {
+	fold := cases.Fold()
+	foldName := func(name string) string {
+		return fold.String(strings.Join(strings.Fields(name), " "))
+	}
+
+	ret := map[string]string{}
+	byCode := map[string][]string{}
+	for _, record := range records {
+		code := record[0]
+		name := foldName(record[6])
+		if prev, ok := ret[name]; ok && !preferredRecord(record, byCode[prev]) {
+			continue
+		}
+		ret[name] = code
+		byCode[code] = record
+	}
+
+	for _, record := range records {
+		if !strings.Contains(record[6], ";") {
+			continue
+		}
+		for _, alt := range strings.Split(record[6], ";") {
+			name := foldName(alt)
+			if name == "" {
+				continue
+			}
+			if prev, ok := ret[name]; ok && (foldName(byCode[prev][6]) == name || !preferredRecord(record, byCode[prev])) {
+				continue
+			}
+			ret[name] = record[0]
+			byCode[record[0]] = record
+		}
+	}
+
+	altCodes := map[string]string{}
+	for _, record := range records {
+		for _, alt := range alt[record[0]] {
+			name := foldName(alt)
+			if _, ok := ret[name]; ok && altCodes[name] == "" {
+				continue
+			}
+			if prev, ok := altCodes[name]; ok && !preferredRecord(record, byCode[prev]) {
+				continue
+			}
+			ret[name], altCodes[name] = record[0], record[0]
+			byCode[record[0]] = record
+		}
+	}
+	return ret
+}
+
+// preferredRecord reports whether language record a should be preferred over b when they share name,
+// the same way as preferredByName in the library does
+func preferredRecord(a, b []string) bool {
+	aMacro, bMacro := a[4] == "M", b[4] == "M"
+	if aMacro != bMacro {
+		return aMacro
+	}
+	aLiving, bLiving := a[5] == "L", b[5] == "L"
+	if aLiving != bLiving {
+		return aLiving
+	}
+	return a[0] < b[0]
+}
+
+// outputFoldedNames writes index of languages by folded reference and alternative names, see foldedNames.
+// Name index records may be nil
+func outputFoldedNames(w io.Writer, records [][]string, nameRecords [][]string) error {
+	buf := bytes.Buffer{}
+	_, err := fmt.Fprint(&buf, namesFilePrefix)
+	if err != nil {
+		return err
+	}
+
+	names := foldedNames(records, altNames(records, nameRecords))
+	keys := make([]string, 0, len(names))
+	for name := range names {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	for _, name := range keys {
+		_, err = fmt.Fprintf(&buf, "%q: %q,\n", name, names[name])
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(&buf, lookupSuffix)
+	if err != nil {
+		return err
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// outputAutonyms writes autonyms of languages having ISO 639-1 code. Other languages are skipped
+// since CLDR covers them poorly and maps some of them to their macrolanguages
+func outputAutonyms(w io.Writer, records [][]string) error {
+	for _, record := range records {
+		if record[3] == "" {
+			continue
+		}
+
+		name := autonym(record[3])
+		if name == "" {
+			continue
+		}
+
+		_, err := fmt.Fprintf(w, "%q: %q,\n", record[0], name)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// part2Collisions returns sorted ISO 639-2 codes assigned to more than one language,
+// e.g. bibliographic code of one language being terminology code of another
+func part2Collisions(records [][]string) []string {
+	owners := map[string]string{}
+	collided := map[string]bool{}
+	for _, record := range records {
+		for _, code := range record[1:3] {
+			if code == "" {
+				continue
+			}
+			if owner, ok := owners[code]; ok && owner != record[0] {
+				collided[code] = true
+			}
+			owners[code] = record[0]
+		}
+	}
+
+	ret := make([]string, 0, len(collided))
+	for code := range collided {
+		ret = append(ret, code)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// outputPart2 writes ISO 639-2 lookup tables. Bibliographic and terminology codes share LanguagesPart2 table,
+// which holds as long as part2Collisions finds nothing. Otherwise colliding codes are left out of LanguagesPart2
+// with a warning, and separate LanguagesPart2B and LanguagesPart2T tables are written, so no language is lost
+func outputPart2(w io.Writer, records [][]string) error {
+	collisions := part2Collisions(records)
+	collided := map[string]bool{}
+	for _, code := range collisions {
+		collided[code] = true
+		log.Printf("Warning: ISO 639-2 code '%s' is assigned to more than one language, left out of LanguagesPart2", code)
+	}
+
+	_, err := fmt.Fprint(w, part2Prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		key2b := record[1]
+		key2t := record[2]
+		if key2b == "" {
+			continue
+		}
+
+		if !collided[key2b] {
+			err = outputStruct(w, key2b, record)
+			if err != nil {
+				return err
+			}
+		}
+
+		if key2b != key2t && !collided[key2t] {
+			err = outputStruct(w, key2t, record)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = fmt.Fprint(w, lookupSuffix)
+	if err != nil || len(collisions) == 0 {
+		return err
+	}
+
+	for i, prefix := range []string{part2BPrefix, part2TPrefix} {
+		_, err = fmt.Fprint(w, prefix)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if key := record[1+i]; key != "" {
+				err = outputStruct(w, key, record)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		_, err = fmt.Fprint(w, lookupSuffix)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// outputCodeLookups writes LanguagesPart3, LanguagesPart2 and LanguagesPart1 lookup tables
+func outputCodeLookups(w io.Writer, records [][]string) error {
+	/* Part 3 lookup */
+
+	_, err := fmt.Fprint(w, part3Prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		key := record[0]
+		err = outputStruct(w, key, record)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(w, lookupSuffix)
+	if err != nil {
+		return err
+	}
+
+	/* Part 2 lookup */
+
+	err = outputPart2(w, records)
+	if err != nil {
+		return err
+	}
+
+	/* Part 1 lookup */
+
+	_, err = fmt.Fprint(w, part1Prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, record := range records {
+		key := record[3]
+		if key == "" {
+			continue
+		}
+
+		err = outputStruct(w, key, record)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprint(w, lookupSuffix)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// appendUvarint appends v encoded as unsigned varint
+func appendUvarint(buf []byte, v uint64) []byte {
+	varint := make([]byte, binary.MaxVarintLen64)
+	return append(buf, varint[:binary.PutUvarint(varint, v)]...)
+}
+
+// outputBlob writes all languages packed in binary format sorted by ISO 639-3 code: blobMagic, number of fields
+// and number of languages as uvarints, then each field of each language in order of languageStructFields
+// as uvarint length followed by the bytes. The format has no place for colliding ISO 639-2 codes, see outputPart2
+func outputBlob(w io.Writer, records [][]string) error {
+	if collisions := part2Collisions(records); len(collisions) > 0 {
+		return fmt.Errorf("can't pack languages with colliding ISO 639-2 codes: %v", collisions)
+	}
+
+	sorted := make([][]string, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i][0] < sorted[j][0] })
+
+	buf := []byte(blobMagic)
+	buf = appendUvarint(buf, uint64(len(languageStructFields)))
+	buf = appendUvarint(buf, uint64(len(sorted)))
+	for _, record := range sorted {
+		if len(record) != len(languageStructFields) {
+			return fmt.Errorf("malformed record: %v", record)
+		}
+		for _, value := range record {
+			buf = appendUvarint(buf, uint64(len(value)))
+			buf = append(buf, value...)
+		}
+	}
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// outputLookup writes lookup tables. If blobData is set, LanguagesPart3, LanguagesPart2 and LanguagesPart1 tables
+// are loaded from blobData file written by outputBlob instead, and the output is used with iso639_blob build tag
+func outputLookup(w io.Writer, records [][]string, macroRecords [][]string, nameRecords [][]string, retiredRecords [][]string,
+	blobData string) {
+	buf := bytes.Buffer{}
+
+	prefix := sourceFilePrefix
+	if blobData != "" {
+		prefix = blobSourceFilePrefix
+	}
+	_, err := fmt.Fprint(&buf, prefix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	_, err = fmt.Fprintf(&buf, datasetVersionFormat, datasetVersion(records, macroRecords, nameRecords, retiredRecords))
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	if blobData != "" {
+		_, err = fmt.Fprintf(&buf, blobTablesFormat, blobData)
+	} else {
+		err = outputCodeLookups(&buf, records)
+	}
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	/* Macrolanguages lookup */
+
+	_, err = fmt.Fprint(&buf, macrolanguagesPrefix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	err = outputMacrolanguages(&buf, macroRecords)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	_, err = fmt.Fprint(&buf, lookupSuffix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	/* Alternative names lookup */
+
+	_, err = fmt.Fprint(&buf, altNamesPrefix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	err = outputAltNames(&buf, altNames(records, nameRecords))
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	_, err = fmt.Fprint(&buf, lookupSuffix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	/* Retired codes lookup */
+
+	_, err = fmt.Fprint(&buf, retiredCodesPrefix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	err = outputRetiredCodes(&buf, retiredRecords)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	_, err = fmt.Fprint(&buf, lookupSuffix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	/* Autonyms lookup */
+
+	_, err = fmt.Fprint(&buf, autonymsPrefix)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
+	err = outputAutonyms(&buf, records)
+	if err != nil {
+		log.Fatalf("Error generating: %v", err)
+	}
+
 	_, err = fmt.Fprint(&buf, lookupSuffix)
 	if err != nil {
 		log.Fatalf("Error generating: %v", err)
@@ -0,0 +1,411 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/barbashov/iso639-3/langpb"
+)
+
+func TestSelectColumns(t *testing.T) {
+	tests := []struct {
+		name     string
+		records  [][]string
+		expected [][]string
+		wantErr  bool
+	}{
+		{
+			name: "regular",
+			records: [][]string{
+				{"Id", "Part2B", "Part2T", "Part1", "Scope", "Language_Type", "Ref_Name", "Comment"},
+				{"rus", "rus", "rus", "ru", "I", "L", "Russian", ""},
+			},
+			expected: [][]string{
+				{"rus", "rus", "rus", "ru", "I", "L", "Russian", ""},
+			},
+		},
+		{
+			name: "extra trailing column",
+			records: [][]string{
+				{"Id", "Part2B", "Part2T", "Part1", "Scope", "Language_Type", "Ref_Name", "Comment", "Status"},
+				{"rus", "rus", "rus", "ru", "I", "L", "Russian", "", "Active"},
+			},
+			expected: [][]string{
+				{"rus", "rus", "rus", "ru", "I", "L", "Russian", ""},
+			},
+		},
+		{
+			name: "reordered columns",
+			records: [][]string{
+				{"Ref_Name", "Id", "Part2B", "Part2T", "Part1", "Scope", "Language_Type", "Comment"},
+				{"German", "deu", "ger", "deu", "de", "I", "L", ""},
+			},
+			expected: [][]string{
+				{"deu", "ger", "deu", "de", "I", "L", "German", ""},
+			},
+		},
+		{
+			name: "missing required column",
+			records: [][]string{
+				{"Id", "Part2B", "Part2T", "Part1", "Scope", "Language_Type", "Comment"},
+				{"rus", "rus", "rus", "ru", "I", "L", ""},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := selectColumns(tt.records, languageColumns())
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("selectColumns() = %v, expected error", actual)
+				}
+			} else if err != nil || !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("selectColumns() = %v, %v, expected %v", actual, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAltNames(t *testing.T) {
+	records := [][]string{
+		{"nld", "dut", "nld", "nl", "I", "L", "Dutch", ""},
+		{"rus", "rus", "rus", "ru", "I", "L", "Russian", ""},
+	}
+	nameRecords := [][]string{
+		{"nld", "Dutch", "Dutch"},
+		{"nld", "Flemish", "Flemish"},
+		{"nld", "Flemish", "Flemish"}, // duplicate row
+		{"nld", "flemish", ""},        // differs in case only
+		{"nld", "Belgian Dutch", "Dutch, Belgian"},
+		{"rus", "Russian", "Russian"},
+	}
+	expected := map[string][]string{
+		"nld": {"Belgian Dutch", "Dutch, Belgian", "Flemish", "flemish"},
+	}
+
+	actual := altNames(records, nameRecords)
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("altNames() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestDatasetVersion(t *testing.T) {
+	records := [][]string{{"rus", "rus", "rus", "ru", "I", "L", "Russian", ""}}
+	changed := [][]string{{"rus", "rus", "rus", "ru", "I", "L", "Russian", "changed"}}
+
+	v := datasetVersion(records, nil)
+	if len(v) != datasetVersionLength {
+		t.Errorf("datasetVersion() = %v, expected %v symbols", v, datasetVersionLength)
+	}
+	if datasetVersion(records, nil) != v {
+		t.Errorf("datasetVersion() is not stable")
+	}
+	if datasetVersion(changed, nil) == v || datasetVersion(nil, records) == v {
+		t.Errorf("datasetVersion() = %v for different inputs", v)
+	}
+}
+
+func TestOutputSchema(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := outputSchema(&buf); err != nil {
+		t.Fatalf("outputSchema() error = %v", err)
+	}
+
+	var schema struct {
+		Type       string
+		Properties map[string]struct {
+			Type string
+			Enum []string
+		}
+		Required []string
+	}
+	if err := json.Unmarshal(buf.Bytes(), &schema); err != nil {
+		t.Fatalf("outputSchema() produced invalid JSON: %v", err)
+	}
+
+	if schema.Type != "object" || len(schema.Properties) != len(languageStructFields) || len(schema.Required) != len(languageStructFields) {
+		t.Errorf("outputSchema() = %s, expected object with %v properties", buf.String(), len(languageStructFields))
+	}
+	if p := schema.Properties["Part3"]; p.Type != "string" {
+		t.Errorf("outputSchema() Part3 = %v, expected string", p)
+	}
+	if p := schema.Properties["Scope"]; p.Type != "string" || !reflect.DeepEqual(p.Enum, []string{"Individual", "Macrolanguage", "Special"}) {
+		t.Errorf("outputSchema() Scope = %v, expected string enum of scopes", p)
+	}
+}
+
+func TestAutonym(t *testing.T) {
+	tests := []struct {
+		part1    string
+		expected string
+	}{
+		{"ru", "русский"},
+		{"de", "Deutsch"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part1, func(t *testing.T) {
+			if actual := autonym(tt.part1); actual != tt.expected {
+				t.Errorf("autonym() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildMPH(t *testing.T) {
+	keys := []string{"aaa", "deu", "eng", "fra", "rus", "zho", "zul", "cmn", "yue", "und"}
+
+	seeds, indices, err := buildMPH(keys)
+	if err != nil {
+		t.Fatalf("buildMPH() error = %v", err)
+	}
+	if len(indices) != len(keys) {
+		t.Fatalf("buildMPH() produced %v slots, expected %v", len(indices), len(keys))
+	}
+
+	seen := map[uint32]bool{}
+	for i, key := range keys {
+		seed := seeds[mphHash(key, 0)%uint32(len(seeds))]
+		slot := mphHash(key, seed) % uint32(len(indices))
+		if seen[slot] {
+			t.Errorf("buildMPH() maps %v to taken slot %v", key, slot)
+		}
+		seen[slot] = true
+		if int(indices[slot]) != i {
+			t.Errorf("buildMPH() maps %v to index %v, expected %v", key, indices[slot], i)
+		}
+	}
+}
+
+func TestOutputProto(t *testing.T) {
+	records := [][]string{
+		{"cmn", "", "", "", "I", "L", "Mandarin Chinese", "", "zho"},
+		{"zxx", "zxx", "zxx", "", "S", "S", "No linguistic content", "no content", ""},
+	}
+
+	buf := bytes.Buffer{}
+	if err := outputProto(&buf, records); err != nil {
+		t.Fatalf("outputProto() error = %v", err)
+	}
+
+	var list langpb.LanguageList
+	if err := proto.Unmarshal(buf.Bytes(), &list); err != nil {
+		t.Fatalf("outputProto() produced invalid message: %v", err)
+	}
+
+	if len(list.Languages) != len(records) {
+		t.Fatalf("outputProto() wrote %d languages, expected %d", len(list.Languages), len(records))
+	}
+	for i, l := range list.Languages {
+		actual := []string{l.Part3, l.Part2B, l.Part2T, l.Part1, l.Scope, l.LanguageType, l.Name, l.Comment, l.MacrolanguageCode}
+		if !reflect.DeepEqual(actual, records[i]) {
+			t.Errorf("outputProto() language %d = %v, expected %v", i, actual, records[i])
+		}
+	}
+}
+
+func TestOutputProtoSchema(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := outputProtoSchema(&buf); err != nil {
+		t.Fatalf("outputProtoSchema() error = %v", err)
+	}
+
+	expected, err := ioutil.ReadFile("../langpb/language.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != string(expected) {
+		t.Errorf("outputProtoSchema() = %s, expected langpb/language.proto to be regenerated", buf.String())
+	}
+}
+
+func TestProtoFieldName(t *testing.T) {
+	tests := map[string]string{
+		"Part3":        "part3",
+		"Part2B":       "part2b",
+		"LanguageType": "language_type",
+		"Name":         "name",
+	}
+	for name, expected := range tests {
+		if actual := protoFieldName(name); actual != expected {
+			t.Errorf("protoFieldName(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+}
+
+func TestAddMacrolanguageCodes(t *testing.T) {
+	records := [][]string{
+		{"cmn", "", "", "", "I", "L", "Mandarin Chinese", ""},
+		{"cjy", "", "", "", "I", "L", "Jinyu Chinese", ""},
+		{"rus", "rus", "rus", "ru", "I", "L", "Russian", ""},
+	}
+	macroRecords := [][]string{
+		{"zho", "cmn", "A"},
+		{"zho", "cjy", "R"},
+	}
+
+	actual, err := addMacrolanguageCodes(records, macroRecords)
+	if err != nil {
+		t.Fatalf("addMacrolanguageCodes() error = %v", err)
+	}
+
+	expected := []string{"zho", "", ""}
+	for i, record := range actual {
+		if len(record) != len(languageStructFields) || record[len(record)-1] != expected[i] {
+			t.Errorf("addMacrolanguageCodes() record %d = %v, expected macrolanguage %q", i, record, expected[i])
+		}
+	}
+	if len(records[0]) != len(languageStructFields)-1 {
+		t.Errorf("addMacrolanguageCodes() modified input records")
+	}
+}
+
+func TestOutputPart2(t *testing.T) {
+	tests := []struct {
+		name               string
+		records            [][]string
+		expectedCollisions []string
+		expected           []string
+		unexpected         []string
+	}{
+		{
+			name: "no collisions",
+			records: [][]string{
+				{"deu", "ger", "deu", "de", "I", "L", "German", "", ""},
+				{"rus", "rus", "rus", "ru", "I", "L", "Russian", "", ""},
+			},
+			expected:   []string{`"ger": {Part3: "deu"`, `"deu": {Part3: "deu"`, `"rus": {Part3: "rus"`},
+			unexpected: []string{"LanguagesPart2B", "LanguagesPart2T"},
+		},
+		{
+			name: "collision",
+			records: [][]string{
+				{"deu", "ger", "deu", "de", "I", "L", "German", "", ""},
+				{"xyz", "deu", "xyz", "", "I", "L", "Synthetic", "", ""},
+			},
+			expectedCollisions: []string{"deu"},
+			expected: []string{
+				"var LanguagesPart2 = map[string]Language{\n\"ger\": {Part3: \"deu\"",
+				"var LanguagesPart2B = map[string]Language{\n\"ger\": {Part3: \"deu\"",
+				"\"deu\": {Part3: \"xyz\"",
+				"var LanguagesPart2T = map[string]Language{\n\"deu\": {Part3: \"deu\"",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := part2Collisions(tt.records); !reflect.DeepEqual(actual, append([]string{}, tt.expectedCollisions...)) {
+				t.Errorf("part2Collisions() = %v, expected %v", actual, tt.expectedCollisions)
+			}
+
+			buf := bytes.Buffer{}
+			if err := outputPart2(&buf, tt.records); err != nil {
+				t.Fatalf("outputPart2() error = %v", err)
+			}
+			for _, s := range tt.expected {
+				if !strings.Contains(buf.String(), s) {
+					t.Errorf("outputPart2() = %s, expected to contain %s", buf.String(), s)
+				}
+			}
+			for _, s := range tt.unexpected {
+				if strings.Contains(buf.String(), s) {
+					t.Errorf("outputPart2() = %s, expected not to contain %s", buf.String(), s)
+				}
+			}
+			if len(tt.expectedCollisions) > 0 && strings.Count(buf.String(), `"deu": {Part3: "deu"`) != 1 {
+				t.Errorf("outputPart2() = %s, expected colliding code only in LanguagesPart2T", buf.String())
+			}
+		})
+	}
+}
+
+func TestOutputRetiredCodes(t *testing.T) {
+	records := [][]string{
+		{"mol", "Moldavian", "M", "ron", "", "2008-11-03"},
+		{"daf", "Dan", "S", "", "Split into Dan [dnj] and Kla-Dan [lda]", "2013-01-30"},
+	}
+
+	buf := bytes.Buffer{}
+	if err := outputRetiredCodes(&buf, records); err != nil {
+		t.Fatalf("outputRetiredCodes() error = %v", err)
+	}
+
+	expected := `"daf": {Code: "daf", Name: "Dan", Reason: 'S', Remedy: "Split into Dan [dnj] and Kla-Dan [lda]", Effective: "2013-01-30"},
+"mol": {Code: "mol", Name: "Moldavian", Reason: 'M', ChangeTo: "ron", Effective: "2008-11-03"},
+`
+	if buf.String() != expected {
+		t.Errorf("outputRetiredCodes() = %s, expected %s", buf.String(), expected)
+	}
+
+	for _, record := range [][]string{{"mol", "Moldavian", "", "ron", "", ""}, {"mol", "Moldavian"}} {
+		if err := outputRetiredCodes(&bytes.Buffer{}, [][]string{record}); err == nil {
+			t.Errorf("outputRetiredCodes(%v) expected error", record)
+		}
+	}
+}
+
+func TestLikelyLanguages(t *testing.T) {
+	known := map[string]bool{"zho": true, "rus": true, "eng": true}
+
+	regions, scripts := likelyLanguages(known)
+
+	expectedRegions := map[string]string{"CN": "zho", "RU": "rus", "US": "eng"}
+	for region, expected := range expectedRegions {
+		if actual := regions[region]; actual != expected {
+			t.Errorf("likelyLanguages() region %v = %v, expected %v", region, actual, expected)
+		}
+	}
+	if actual, ok := regions["DE"]; ok {
+		t.Errorf("likelyLanguages() region DE = %v, expected unknown language to be skipped", actual)
+	}
+
+	expectedScripts := map[string]string{"Cyrl": "rus", "Hans": "zho", "Latn": "eng"}
+	for script, expected := range expectedScripts {
+		if actual := scripts[script]; actual != expected {
+			t.Errorf("likelyLanguages() script %v = %v, expected %v", script, actual, expected)
+		}
+	}
+	if actual, ok := scripts["Zyyy"]; ok {
+		t.Errorf("likelyLanguages() script Zyyy = %v, expected fallback to be skipped", actual)
+	}
+}
+
+func TestFoldedNames(t *testing.T) {
+	records := [][]string{
+		{"deu", "ger", "deu", "de", "I", "L", "German", "", ""},
+		{"zzz", "", "", "", "I", "L", "Straße", "", ""},
+		{"aaa", "", "", "", "I", "L", "Chinese", "", "zho"},
+		{"zho", "chi", "zho", "zh", "M", "L", "Chinese", "", ""},
+		{"bbb", "", "", "", "I", "L", "CHINESE", "", "zho"},
+		{"aab", "", "", "", "I", "E", "Old", "", ""},
+		{"aac", "", "", "", "I", "L", "old", "", ""},
+		{"nld", "dut", "nld", "nl", "I", "L", "Dutch; Flemish", "", ""},
+		{"aad", "", "", "", "I", "L", "German;  Swabian ;", "", ""},
+	}
+
+	alt := map[string][]string{"zzz": {"Street", "German"}, "aac": {"Old Tongue", "Hollandic"}, "deu": {"Hollandic"},
+		"bbb": {"Chinese, Mandarin", "chinese"}}
+
+	actual := foldedNames(records, alt)
+
+	expected := map[string]string{"german": "deu", "strasse": "zzz", "chinese": "zho", "old": "aac",
+		"dutch; flemish": "nld", "dutch": "nld", "flemish": "nld", "german; swabian ;": "aad", "swabian": "aad",
+		"street": "zzz", "old tongue": "aac", "hollandic": "aac", "chinese, mandarin": "bbb"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("foldedNames() = %v, expected %v", actual, expected)
+	}
+}
@@ -0,0 +1,51 @@
+package iso639_3
+
+import "sort"
+
+// collectiveMembers lookup table. Keys are ISO 639-2 collective codes, values are ISO 639-3 codes of individual languages
+// falling under them. ISO 639-3 doesn't define such relationship, so the table is curated by hand and covers only some collectives
+var collectiveMembers = map[string][]string{
+	"bat": {"lit", "ltg", "lvs", "olt", "prg", "sgs", "xcu", "xgl", "xsv", "xzm"},
+	"sla": {
+		"bel", "bos", "bul", "ces", "chu", "cnr", "csb", "dsb", "hrv", "hsb", "kjv", "mkd",
+		"orv", "pol", "pox", "rue", "rus", "slk", "slv", "srp", "svm", "szl", "ukr",
+	},
+}
+
+// CollectiveMembers returns ISO 639-3 individual languages falling under given ISO 639-2 collective code (e.g. "sla" for Slavic languages),
+// sorted by ISO 639-3 code.
+// The result is an approximation based on a hand-curated table, since there is no official source for such mapping.
+// Returns nil if no mapping exists for the code
+func CollectiveMembers(code string) []Language {
+	codes, ok := collectiveMembers[code]
+	if !ok {
+		return nil
+	}
+
+	ret := make([]Language, 0, len(codes))
+	for _, c := range codes {
+		if l, ok := db().part3[c]; ok {
+			ret = append(ret, l)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Part3 < ret[j].Part3 })
+	return ret
+}
+
+// IsCollectiveCode checks whether given code is an ISO 639-2 collective code, e.g. "sla" for Slavic languages.
+// Collective codes denote groups of languages and have no ISO 639-3 equivalent
+func IsCollectiveCode(code string) bool {
+	_, ok := db().part2[code]
+	return !ok && marcCodes[code]
+}
+
+// CanonicalPart3 resolves given code like FromAnyCode and returns ISO 639-3 code of the language, e.g. "deu" for "ger" or "de".
+// ISO 639-2 collective codes (see IsCollectiveCode) don't resolve, since ISO 639-3 has no equivalent of them.
+// ISO 639-2 and ISO 639-3 share code space, so no code is both collective and individual.
+// Returns empty string if code can't be resolved
+func CanonicalPart3(code string) string {
+	if l := FromAnyCode(code); l != nil {
+		return l.Part3
+	}
+	return ""
+}
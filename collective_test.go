@@ -0,0 +1,83 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestCollectiveMembers(t *testing.T) {
+	tests := []struct {
+		code            string
+		expectedMember  string
+		expectedMissing string
+	}{
+		{"sla", "rus", "lit"},
+		{"bat", "lit", "rus"},
+		{"123", "", ""}, // doesn't exist
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := CollectiveMembers(tt.code)
+
+			if tt.expectedMember == "" {
+				if actual != nil {
+					t.Errorf("CollectiveMembers() = %v, expected nil", actual)
+				}
+				return
+			}
+
+			found := map[string]bool{}
+			for i, l := range actual {
+				if i > 0 && actual[i-1].Part3 >= l.Part3 {
+					t.Errorf("CollectiveMembers() is not sorted: %v", actual)
+				}
+				found[l.Part3] = true
+			}
+			if !found[tt.expectedMember] || found[tt.expectedMissing] {
+				t.Errorf("CollectiveMembers() = %v, expected to contain %v and not %v", actual, tt.expectedMember, tt.expectedMissing)
+			}
+		})
+	}
+}
+
+func TestCanonicalPart3(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"deu", "deu"},
+		{"ger", "deu"},
+		{"de", "deu"},
+		{"zh-CN", "zho"},
+		{"sla", ""}, // collective
+		{"bat", ""}, // collective
+		{"xxx", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if actual := CanonicalPart3(tt.code); actual != tt.expected {
+				t.Errorf("CanonicalPart3(%v) = %v, expected %v", tt.code, actual, tt.expected)
+			}
+		})
+	}
+
+	for code := range marcCodes {
+		if IsCollectiveCode(code) && (FromPart3Code(code) != nil || CanonicalPart3(code) != "") {
+			t.Errorf("CanonicalPart3(%v) = %v, expected collective code not to resolve", code, CanonicalPart3(code))
+		}
+	}
+}
+
+func TestIsCollectiveCode(t *testing.T) {
+	tests := map[string]bool{
+		"sla": true,
+		"afa": true,
+		"ger": false,
+		"deu": false,
+		"zho": false,
+	}
+	for code, expected := range tests {
+		if actual := IsCollectiveCode(code); actual != expected {
+			t.Errorf("IsCollectiveCode(%v) = %v, expected %v", code, actual, expected)
+		}
+	}
+}
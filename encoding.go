@@ -0,0 +1,221 @@
+package iso639_3
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// lineSeparator separates fields of a Language serialized with Marshal
+const lineSeparator = "|"
+
+// Marshal serializes language to a compact single line "<ISO 639-3 code>|<reference name>", e.g. "rus|Russian".
+// The name is informative only: Unmarshal restores the language by its code
+func (l Language) Marshal() string {
+	return l.Part3 + lineSeparator + l.Name
+}
+
+// Unmarshal restores language serialized with Marshal by looking up its ISO 639-3 code.
+// Returns error if the line is malformed or the code is unknown
+func Unmarshal(s string) (Language, error) {
+	code := s
+	if i := strings.Index(s, lineSeparator); i >= 0 {
+		code = s[:i]
+	}
+	if code == "" {
+		return Language{}, fmt.Errorf("iso639_3: malformed language line %q", s)
+	}
+
+	l := FromPart3Code(code)
+	if l == nil {
+		return Language{}, fmt.Errorf("iso639_3: unknown part3 code %q", code)
+	}
+	return *l, nil
+}
+
+// MarshalText encodes language as its ISO 639-3 code, so it can be used as a map key in JSON or in text-based
+// configs. JSON values are still encoded with MarshalJSON
+func (l Language) MarshalText() ([]byte, error) {
+	return []byte(l.Part3), nil
+}
+
+// UnmarshalText restores language by its ISO 639-3 code, see MarshalText.
+// Empty text unmarshals to zero Language, so unset values round-trip. Returns ErrLanguageNotFound for unknown codes
+func (l *Language) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*l = Language{}
+		return nil
+	}
+
+	found := FromPart3Code(string(text))
+	if found == nil {
+		return notFound(string(text))
+	}
+	*l = *found
+	return nil
+}
+
+var (
+	// languageScopes lists all scopes defined by ISO 639-3
+	languageScopes = []LanguageScope{ScopeIndividual, ScopeMacrolanguage, ScopeSpecial}
+	// languageTypes lists all language types defined by ISO 639-3
+	languageTypes = []LanguageType{TypeLiving, TypeHistorical, TypeAncient,
+		TypeExtinct, TypeConstructed, TypeSpecial}
+
+	// scopesByName and typesByName map lowercased names of scopes and language types, as returned by String methods,
+	// to them
+	scopesByName = func() map[string]rune {
+		ret := map[string]rune{}
+		for _, s := range languageScopes {
+			ret[strings.ToLower(s.String())] = rune(s)
+		}
+		return ret
+	}()
+	typesByName = func() map[string]rune {
+		ret := map[string]rune{}
+		for _, t := range languageTypes {
+			ret[strings.ToLower(t.String())] = rune(t)
+		}
+		return ret
+	}()
+)
+
+// languageFields has the same fields as Language, but none of its methods, so it's encoded to JSON the default way
+type languageFields Language
+
+// MarshalJSON encodes language as JSON object with fields named as in Language struct.
+// Scope and LanguageType are encoded by name (see their String methods), e.g. "Individual" and "Living",
+// or as empty strings if not set. Returns error for scopes and language types not defined by ISO 639-3
+func (l Language) MarshalJSON() ([]byte, error) {
+	scope, err := encodeEnum(rune(l.Scope), l.Scope.String(), "scope", scopesByName)
+	if err != nil {
+		return nil, err
+	}
+	typ, err := encodeEnum(rune(l.LanguageType), l.LanguageType.String(), "language type", typesByName)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		languageFields
+		Scope        string
+		LanguageType string
+	}{languageFields(l), scope, typ})
+}
+
+// UnmarshalJSON decodes language encoded with MarshalJSON. Scope and LanguageType names are case-insensitive,
+// Unicode code points (e.g. 73 for 'I') written before they were encoded by name are accepted as well.
+// Returns error for unknown scopes and language types
+func (l *Language) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*languageFields
+		Scope        json.RawMessage
+		LanguageType json.RawMessage
+	}{languageFields: (*languageFields)(l)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	scope, err := decodeEnum(aux.Scope, "scope", scopesByName)
+	if err != nil {
+		return err
+	}
+	typ, err := decodeEnum(aux.LanguageType, "language type", typesByName)
+	if err != nil {
+		return err
+	}
+
+	l.Scope, l.LanguageType = LanguageScope(scope), LanguageType(typ)
+	return nil
+}
+
+// encodeEnum returns name of scope or language type, empty string if it's not set.
+// Returns error if the name is not one of known ones
+func encodeEnum(r rune, name string, kind string, known map[string]rune) (string, error) {
+	if r == 0 {
+		return "", nil
+	}
+	if _, ok := known[strings.ToLower(name)]; !ok {
+		return "", fmt.Errorf("iso639_3: unknown %s %q", kind, r)
+	}
+	return name, nil
+}
+
+// decodeEnum decodes scope or language type encoded either by name or as Unicode code point.
+// Returns 0 for missing, null or empty values
+func decodeEnum(raw json.RawMessage, kind string, known map[string]rune) (rune, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return 0, nil
+	}
+
+	if raw[0] != '"' {
+		var r rune
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return 0, fmt.Errorf("iso639_3: malformed %s %s", kind, raw)
+		}
+		for _, v := range known {
+			if v == r {
+				return r, nil
+			}
+		}
+		if r != 0 {
+			return 0, fmt.Errorf("iso639_3: unknown %s %q", kind, r)
+		}
+		return 0, nil
+	}
+
+	var name string
+	if err := json.Unmarshal(raw, &name); err != nil {
+		return 0, fmt.Errorf("iso639_3: malformed %s %s", kind, raw)
+	}
+	return enumByName(name, kind, known)
+}
+
+// enumByName returns scope or language type by its case-insensitive name, 0 for empty name.
+// Returns error if the name is not one of known ones
+func enumByName(name string, kind string, known map[string]rune) (rune, error) {
+	if name == "" {
+		return 0, nil
+	}
+	r, ok := known[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("iso639_3: unknown %s %q", kind, name)
+	}
+	return r, nil
+}
+
+// MarshalText encodes scope by name, e.g. "Macrolanguage", or as empty text if not set.
+// Returns error for scopes not defined by ISO 639-3
+func (s LanguageScope) MarshalText() ([]byte, error) {
+	name, err := encodeEnum(rune(s), s.String(), "scope", scopesByName)
+	return []byte(name), err
+}
+
+// UnmarshalText decodes scope by its case-insensitive name, empty text decodes to 0.
+// Returns error for unknown names
+func (s *LanguageScope) UnmarshalText(text []byte) error {
+	r, err := enumByName(string(text), "scope", scopesByName)
+	if err != nil {
+		return err
+	}
+	*s = LanguageScope(r)
+	return nil
+}
+
+// MarshalText encodes language type by name, e.g. "Living", or as empty text if not set.
+// Returns error for language types not defined by ISO 639-3
+func (t LanguageType) MarshalText() ([]byte, error) {
+	name, err := encodeEnum(rune(t), t.String(), "language type", typesByName)
+	return []byte(name), err
+}
+
+// UnmarshalText decodes language type by its case-insensitive name, empty text decodes to 0.
+// Returns error for unknown names
+func (t *LanguageType) UnmarshalText(text []byte) error {
+	r, err := enumByName(string(text), "language type", typesByName)
+	if err != nil {
+		return err
+	}
+	*t = LanguageType(r)
+	return nil
+}
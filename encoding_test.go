@@ -0,0 +1,232 @@
+package iso639_3
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLanguage_Marshal(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"rus", "rus|Russian"},
+		{"deu", "deu|German"},
+		{"zho", "zho|Chinese"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			l := FromPart3Code(tt.code)
+			actual := l.Marshal()
+
+			if actual != tt.expected {
+				t.Errorf("Marshal() = %v, expected %v", actual, tt.expected)
+			}
+
+			restored, err := Unmarshal(actual)
+			if err != nil || restored != *l {
+				t.Errorf("Unmarshal() = %v, %v, expected %v", restored, err, *l)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		line          string
+		expectedPart3 string
+	}{
+		{"rus|Russian", "rus"},
+		{"rus", "rus"},
+		{"rus|", "rus"},
+		{"123|Elvish", ""}, // doesn't exist
+		{"|Russian", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			actual, err := Unmarshal(tt.line)
+
+			if tt.expectedPart3 == "" {
+				if err == nil {
+					t.Errorf("Unmarshal() = %v, expected error", actual)
+				}
+			} else if err != nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("Unmarshal() = %v, %v, expected Language with Part3 %v", actual, err, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestLanguage_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(FromPart3Code("rus"))
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	for _, expected := range []string{`"Part3":"rus"`, `"Scope":"Individual"`, `"LanguageType":"Living"`} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("MarshalJSON() = %s, expected to contain %s", data, expected)
+		}
+	}
+
+	if _, err := json.Marshal(Language{Part3: "rus", Scope: 'X'}); err == nil {
+		t.Errorf("MarshalJSON() with unknown scope expected error")
+	}
+}
+
+func TestLanguage_JSONRoundTrip(t *testing.T) {
+	for _, l := range append(AllLanguages(), Language{}) {
+		data, err := json.Marshal(l)
+		if err != nil {
+			t.Fatalf("MarshalJSON(%v) error = %v", l, err)
+		}
+		var restored Language
+		if err := json.Unmarshal(data, &restored); err != nil || restored != l {
+			t.Fatalf("UnmarshalJSON(%s) = %v, %v, expected %v", data, restored, err, l)
+		}
+	}
+}
+
+func TestLanguage_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		data          string
+		expectedScope LanguageScope
+		expectedType  LanguageType
+		expectedErr   bool
+	}{
+		{`{"Part3":"rus","Scope":"Individual","LanguageType":"Living"}`, 'I', 'L', false},
+		{`{"part3":"zho","scope":"Macrolanguage","languageType":"Living"}`, 'M', 'L', false},
+		{`{"Part3":"rus","Scope":73,"LanguageType":76}`, 'I', 'L', false}, // code points
+		{`{"Part3":"rus","Scope":"","LanguageType":null}`, 0, 0, false},
+		{`{"Part3":"rus"}`, 0, 0, false},
+		{`{"Part3":"rus","Scope":"individual","LanguageType":"LIVING"}`, 'I', 'L', false},
+		{`{"Part3":"rus","Scope":"Dialect"}`, 0, 0, true},
+		{`{"Part3":"rus","LanguageType":"Fictional"}`, 0, 0, true},
+		{`{"Part3":"rus","Scope":88}`, 0, 0, true},
+		{`{"Part3":"rus","Scope":true}`, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.data, func(t *testing.T) {
+			var actual Language
+			err := json.Unmarshal([]byte(tt.data), &actual)
+
+			if tt.expectedErr {
+				if err == nil {
+					t.Errorf("UnmarshalJSON() = %v, expected error", actual)
+				}
+			} else if err != nil || actual.Scope != tt.expectedScope || actual.LanguageType != tt.expectedType {
+				t.Errorf("UnmarshalJSON() = %v, %v, expected scope %v and type %v", actual, err, tt.expectedScope, tt.expectedType)
+			}
+		})
+	}
+}
+
+func TestLanguage_MarshalText(t *testing.T) {
+	m := map[Language]int{*FromPart3Code("rus"): 1, *FromPart3Code("deu"): 2}
+	data, err := json.Marshal(m)
+	if err != nil || string(data) != `{"deu":2,"rus":1}` {
+		t.Fatalf("json.Marshal() = %s, %v, expected map keyed by part3 codes", data, err)
+	}
+
+	var restored map[Language]int
+	if err := json.Unmarshal(data, &restored); err != nil || len(restored) != 2 || restored[*FromPart3Code("rus")] != 1 {
+		t.Errorf("json.Unmarshal() = %v, %v, expected %v", restored, err, m)
+	}
+}
+
+func TestLanguage_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		text          string
+		expectedPart3 string
+		expectedErr   bool
+	}{
+		{"rus", "rus", false},
+		{"", "", false},
+		{"ru", "", true}, // part1 codes are not accepted
+		{"xxx", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			actual := Language{Part3: "deu"}
+			err := actual.UnmarshalText([]byte(tt.text))
+
+			if tt.expectedErr {
+				if !errors.Is(err, ErrLanguageNotFound) {
+					t.Errorf("UnmarshalText() = %v, %v, expected ErrLanguageNotFound", actual, err)
+				}
+			} else if err != nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("UnmarshalText() = %v, %v, expected Language with Part3 %v", actual, err, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestLanguageScope_MarshalText(t *testing.T) {
+	for _, s := range languageScopes {
+		text, err := s.MarshalText()
+		if err != nil || string(text) != s.String() {
+			t.Errorf("MarshalText() = %s, %v, expected %v", text, err, s.String())
+		}
+	}
+	if text, err := LanguageScope(0).MarshalText(); err != nil || len(text) != 0 {
+		t.Errorf("MarshalText() = %s, %v, expected empty text", text, err)
+	}
+	if _, err := LanguageScope('X').MarshalText(); err == nil {
+		t.Errorf("MarshalText() with unknown scope expected error")
+	}
+}
+
+func TestLanguageType_MarshalText(t *testing.T) {
+	for _, lt := range languageTypes {
+		text, err := lt.MarshalText()
+		if err != nil || string(text) != lt.String() {
+			t.Errorf("MarshalText() = %s, %v, expected %v", text, err, lt.String())
+		}
+	}
+	if _, err := LanguageType('X').MarshalText(); err == nil {
+		t.Errorf("MarshalText() with unknown language type expected error")
+	}
+}
+
+func TestScopeAndType_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		text          string
+		expectedScope LanguageScope
+		expectedType  LanguageType
+		expectedErr   bool
+	}{
+		{"Special", ScopeSpecial, TypeSpecial, false},
+		{"special", ScopeSpecial, TypeSpecial, false},
+		{"", 0, 0, false},
+		{"Dialect", 0, 0, true},
+		{"S", 0, 0, true}, // codes are not names
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var scope LanguageScope
+			var typ LanguageType
+			scopeErr := scope.UnmarshalText([]byte(tt.text))
+			typeErr := typ.UnmarshalText([]byte(tt.text))
+
+			if tt.expectedErr {
+				if scopeErr == nil || typeErr == nil {
+					t.Errorf("UnmarshalText() = %v, %v, expected errors", scope, typ)
+				}
+			} else if scopeErr != nil || typeErr != nil || scope != tt.expectedScope || typ != tt.expectedType {
+				t.Errorf("UnmarshalText() = %v, %v, %v, %v, expected %v and %v",
+					scope, scopeErr, typ, typeErr, tt.expectedScope, tt.expectedType)
+			}
+		})
+	}
+
+	var config struct {
+		Scope LanguageScope
+		Type  LanguageType
+	}
+	if err := json.Unmarshal([]byte(`{"Scope":"macrolanguage","Type":"Living"}`), &config); err != nil ||
+		config.Scope != ScopeMacrolanguage || config.Type != TypeLiving {
+		t.Errorf("json.Unmarshal() = %v, %v, expected Macrolanguage and Living", config, err)
+	}
+}
@@ -0,0 +1,29 @@
+package iso639_3
+
+import "sort"
+
+// ExcludeSpecialPurpose returns given languages without special-purpose entries, i.e. those with special scope:
+// "mis" (uncoded languages), "mul" (multiple languages), "und" (undetermined) and "zxx" (no linguistic content).
+// Order of languages is preserved
+func ExcludeSpecialPurpose(langs []Language) []Language {
+	ret := make([]Language, 0, len(langs))
+	for _, l := range langs {
+		if !l.IsSpecial() {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// GroupByScope returns all distinct languages grouped by scope, e.g. for tabbed UI.
+// Languages of each group are sorted by name, then by ISO 639-3 code
+func GroupByScope() map[LanguageScope][]Language {
+	ret := map[LanguageScope][]Language{}
+	for _, l := range db().byPart3 {
+		ret[l.Scope] = append(ret[l.Scope], l)
+	}
+	for _, langs := range ret {
+		sort.SliceStable(langs, func(i, j int) bool { return langs[i].Name < langs[j].Name })
+	}
+	return ret
+}
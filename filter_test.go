@@ -0,0 +1,58 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExcludeSpecialPurpose(t *testing.T) {
+	langs := LanguagesForCodes([]string{"und", "rus", "mul", "deu", "mis", "zxx", "zho"})
+
+	actual := ExcludeSpecialPurpose(langs)
+
+	expected := LanguagesForCodes([]string{"rus", "deu", "zho"})
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("ExcludeSpecialPurpose() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestGroupByScope(t *testing.T) {
+	groups := GroupByScope()
+
+	tests := []struct {
+		scope LanguageScope
+		part3 string
+	}{
+		{ScopeIndividual, "rus"},
+		{ScopeMacrolanguage, "zho"},
+		{ScopeSpecial, "und"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			found := false
+			for _, l := range groups[tt.scope] {
+				if l.Scope != tt.scope {
+					t.Errorf("GroupByScope()[%c] has %v of scope %c", tt.scope, l.Part3, l.Scope)
+				}
+				found = found || l.Part3 == tt.part3
+			}
+			if !found {
+				t.Errorf("GroupByScope()[%c] doesn't have %v", tt.scope, tt.part3)
+			}
+		})
+	}
+
+	total := 0
+	for scope, langs := range groups {
+		total += len(langs)
+		for i := 1; i < len(langs); i++ {
+			if langs[i-1].Name > langs[i].Name {
+				t.Errorf("GroupByScope()[%c] is not sorted by name", scope)
+				break
+			}
+		}
+	}
+	if total != len(db().part3) {
+		t.Errorf("GroupByScope() has %d languages, expected %d", total, len(db().part3))
+	}
+}
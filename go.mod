@@ -1,3 +1,8 @@
 module github.com/barbashov/iso639-3
 
 go 1.16
+
+require (
+	golang.org/x/text v0.13.0
+	google.golang.org/protobuf v1.28.1
+)
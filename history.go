@@ -0,0 +1,26 @@
+package iso639_3
+
+// ScopeChange describes ISO 639-3 code whose scope was changed by the Registration Authority
+type ScopeChange struct {
+	Code string // ISO639-3 code
+	From LanguageScope
+	To   LanguageScope
+}
+
+// scopeChanges is a hand-curated list of known scope changes. SIL doesn't publish code history in machine-readable form
+// (retirements file only covers retired codes), so the list is limited to well-documented individual to macrolanguage changes
+var scopeChanges = []ScopeChange{
+	{"bnc", ScopeIndividual, ScopeMacrolanguage},
+	{"est", ScopeIndividual, ScopeMacrolanguage},
+	{"lav", ScopeIndividual, ScopeMacrolanguage},
+	{"nep", ScopeIndividual, ScopeMacrolanguage},
+	{"ori", ScopeIndividual, ScopeMacrolanguage},
+}
+
+// ScopeChanges returns codes whose scope changed over time, sorted by code.
+// The list is curated by hand and is not exhaustive
+func ScopeChanges() []ScopeChange {
+	ret := make([]ScopeChange, len(scopeChanges))
+	copy(ret, scopeChanges)
+	return ret
+}
@@ -0,0 +1,28 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestScopeChanges(t *testing.T) {
+	changes := ScopeChanges()
+
+	found := false
+	for i, c := range changes {
+		if i > 0 && changes[i-1].Code >= c.Code {
+			t.Errorf("ScopeChanges() is not sorted: %v", changes)
+		}
+
+		l := FromPart3Code(c.Code)
+		if l == nil || l.Scope != c.To {
+			t.Errorf("ScopeChanges() contains %v which doesn't match current data %v", c, l)
+		}
+
+		if c.Code == "est" {
+			found = c.From == ScopeIndividual && c.To == ScopeMacrolanguage
+		}
+	}
+	if !found {
+		t.Errorf("ScopeChanges() = %v, expected Estonian change from individual to macrolanguage", changes)
+	}
+}
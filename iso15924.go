@@ -0,0 +1,30 @@
+package iso639_3
+
+// iso15924Scripts is the set of ISO 15924 script codes, as of Unicode 16.0.
+// Private use range "Qaaa".."Qabx" is not included, see isISO15924Script
+var iso15924Scripts = map[string]bool{
+	"Adlm": true, "Afak": true, "Aghb": true, "Ahom": true, "Arab": true, "Aran": true, "Armi": true, "Armn": true, "Avst": true, "Bali": true, "Bamu": true, "Bass": true,
+	"Batk": true, "Beng": true, "Bhks": true, "Blis": true, "Bopo": true, "Brah": true, "Brai": true, "Bugi": true, "Buhd": true, "Cakm": true, "Cans": true, "Cari": true,
+	"Cham": true, "Cher": true, "Chrs": true, "Cirt": true, "Copt": true, "Cpmn": true, "Cprt": true, "Cyrl": true, "Cyrs": true, "Deva": true, "Diak": true, "Dogr": true,
+	"Dsrt": true, "Dupl": true, "Egyd": true, "Egyh": true, "Egyp": true, "Elba": true, "Elym": true, "Ethi": true, "Gara": true, "Geok": true, "Geor": true, "Glag": true,
+	"Gong": true, "Gonm": true, "Goth": true, "Gran": true, "Grek": true, "Gujr": true, "Gukh": true, "Guru": true, "Hanb": true, "Hang": true, "Hani": true, "Hano": true,
+	"Hans": true, "Hant": true, "Hatr": true, "Hebr": true, "Hira": true, "Hluw": true, "Hmng": true, "Hmnp": true, "Hrkt": true, "Hung": true, "Inds": true, "Ital": true,
+	"Jamo": true, "Java": true, "Jpan": true, "Jurc": true, "Kali": true, "Kana": true, "Kawi": true, "Khar": true, "Khmr": true, "Khoj": true, "Kitl": true, "Kits": true,
+	"Knda": true, "Kore": true, "Kpel": true, "Krai": true, "Kthi": true, "Lana": true, "Laoo": true, "Latf": true, "Latg": true, "Latn": true, "Leke": true, "Lepc": true,
+	"Limb": true, "Lina": true, "Linb": true, "Lisu": true, "Loma": true, "Lyci": true, "Lydi": true, "Mahj": true, "Maka": true, "Mand": true, "Mani": true, "Marc": true,
+	"Maya": true, "Medf": true, "Mend": true, "Merc": true, "Mero": true, "Mlym": true, "Modi": true, "Mong": true, "Moon": true, "Mroo": true, "Mtei": true, "Mult": true,
+	"Mymr": true, "Nagm": true, "Nand": true, "Narb": true, "Nbat": true, "Newa": true, "Nkdb": true, "Nkgb": true, "Nkoo": true, "Nshu": true, "Ogam": true, "Olck": true,
+	"Onao": true, "Orkh": true, "Orya": true, "Osge": true, "Osma": true, "Ougr": true, "Palm": true, "Pauc": true, "Pcun": true, "Pelm": true, "Perm": true, "Phag": true,
+	"Phli": true, "Phlp": true, "Phlv": true, "Phnx": true, "Piqd": true, "Plrd": true, "Prti": true, "Psin": true, "Ranj": true, "Rjng": true, "Rohg": true, "Roro": true,
+	"Runr": true, "Samr": true, "Sara": true, "Sarb": true, "Saur": true, "Sgnw": true, "Shaw": true, "Shrd": true, "Shui": true, "Sidd": true, "Sind": true, "Sinh": true,
+	"Sogd": true, "Sogo": true, "Sora": true, "Soyo": true, "Sund": true, "Sunu": true, "Sylo": true, "Syrc": true, "Syre": true, "Syrj": true, "Syrn": true, "Tagb": true,
+	"Takr": true, "Tale": true, "Talu": true, "Taml": true, "Tang": true, "Tavt": true, "Telu": true, "Teng": true, "Tfng": true, "Tglg": true, "Thaa": true, "Thai": true,
+	"Tibt": true, "Tirh": true, "Tnsa": true, "Todr": true, "Toto": true, "Tutg": true, "Ugar": true, "Vaii": true, "Visp": true, "Vith": true, "Wara": true, "Wcho": true,
+	"Wole": true, "Xpeo": true, "Xsux": true, "Yezi": true, "Yiii": true, "Zanb": true, "Zinh": true, "Zmth": true, "Zsye": true, "Zsym": true, "Zxxx": true, "Zyyy": true,
+	"Zzzz": true,
+}
+
+// isISO15924Script checks whether given title-cased code is an ISO 15924 script code, including private use ones
+func isISO15924Script(code string) bool {
+	return iso15924Scripts[code] || len(code) == 4 && code >= "Qaaa" && code <= "Qabx"
+}
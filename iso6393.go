@@ -1,94 +1,459 @@
 package iso639_3
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // LanguageScope represents language scope as defined in ISO 639-3
 type LanguageScope rune
 
-// LanguageType represents language scope as defined in ISO 639-3
+// LanguageType represents language type as defined in ISO 639-3
 type LanguageType rune
 
 const (
-	LanguageTypeIndividual    LanguageScope = 'I'
-	LanguageTypeSpecial       LanguageScope = 'S'
-	LanguageTypeMacrolanguage LanguageScope = 'M'
-
-	LanguageScopeLiving      LanguageType = 'L'
-	LanguageScopeHistorical  LanguageType = 'H'
-	LanguageScopeAncient     LanguageType = 'A'
-	LanguageScopeExtinct     LanguageType = 'E'
-	LanguageScopeConstructed LanguageType = 'C'
-	LanguageScopeSpecial     LanguageType = 'S'
+	ScopeIndividual    LanguageScope = 'I'
+	ScopeSpecial       LanguageScope = 'S'
+	ScopeMacrolanguage LanguageScope = 'M'
+
+	TypeLiving      LanguageType = 'L'
+	TypeHistorical  LanguageType = 'H'
+	TypeAncient     LanguageType = 'A'
+	TypeExtinct     LanguageType = 'E'
+	TypeConstructed LanguageType = 'C'
+	TypeSpecial     LanguageType = 'S'
+)
+
+// Former names of scopes and language types, which had "Scope" and "Type" prefixes swapped
+const (
+	// Deprecated: use ScopeIndividual
+	LanguageTypeIndividual = ScopeIndividual
+	// Deprecated: use ScopeSpecial
+	LanguageTypeSpecial = ScopeSpecial
+	// Deprecated: use ScopeMacrolanguage
+	LanguageTypeMacrolanguage = ScopeMacrolanguage
+
+	// Deprecated: use TypeLiving
+	LanguageScopeLiving = TypeLiving
+	// Deprecated: use TypeHistorical
+	LanguageScopeHistorical = TypeHistorical
+	// Deprecated: use TypeAncient
+	LanguageScopeAncient = TypeAncient
+	// Deprecated: use TypeExtinct
+	LanguageScopeExtinct = TypeExtinct
+	// Deprecated: use TypeConstructed
+	LanguageScopeConstructed = TypeConstructed
+	// Deprecated: use TypeSpecial
+	LanguageScopeSpecial = TypeSpecial
+)
+
+// String returns name of the scope, e.g. "Individual" or "Macrolanguage", or LanguageScope('X') for unknown ones
+func (s LanguageScope) String() string {
+	switch s {
+	case ScopeIndividual:
+		return "Individual"
+	case ScopeMacrolanguage:
+		return "Macrolanguage"
+	case ScopeSpecial:
+		return "Special"
+	}
+	return fmt.Sprintf("LanguageScope(%q)", rune(s))
+}
+
+// String returns name of the language type, e.g. "Living" or "Extinct", or LanguageType('X') for unknown ones
+func (t LanguageType) String() string {
+	switch t {
+	case TypeLiving:
+		return "Living"
+	case TypeHistorical:
+		return "Historical"
+	case TypeAncient:
+		return "Ancient"
+	case TypeExtinct:
+		return "Extinct"
+	case TypeConstructed:
+		return "Constructed"
+	case TypeSpecial:
+		return "Special"
+	}
+	return fmt.Sprintf("LanguageType(%q)", rune(t))
+}
+
+var (
+	// ErrInvalidCodeLength is returned for codes which are neither two nor three symbols long
+	ErrInvalidCodeLength = errors.New("iso639_3: code must be 2 or 3 symbols long")
+	// ErrLanguageNotFound is returned when no language matches a code
+	ErrLanguageNotFound = errors.New("iso639_3: language not found")
 )
 
 // Language holds language info - all ISO 639 codes along with name and some additional info
 type Language struct {
-	Part3        string // ISO639-3 code
-	Part2B       string // ISO639-2 bibliographic code
-	Part2T       string // ISO639-2 terminology code
-	Part1        string // ISO639-1 code
-	Scope        LanguageScope
-	LanguageType LanguageType
-	Name         string
-	Comment      string
+	Part3             string // ISO639-3 code
+	Part2B            string // ISO639-2 bibliographic code
+	Part2T            string // ISO639-2 terminology code
+	Part1             string // ISO639-1 code
+	Scope             LanguageScope
+	LanguageType      LanguageType
+	Name              string
+	Comment           string
+	MacrolanguageCode string // ISO639-3 code of macrolanguage the language is a member of, e.g. "zho" for Mandarin Chinese
 }
 
-//go:generate go run cmd/generator.go -o lang-db.go
+// String returns reference name of the language followed by ISO639-3 code, e.g. "Russian (rus)", or just the code if name is empty.
+// See Label for labels intended for UI
+func (l Language) String() string {
+	if l.Name == "" {
+		return l.Part3
+	}
+	return l.Name + " (" + l.Part3 + ")"
+}
+
+// IsMacrolanguage checks whether the language is a macrolanguage, e.g. Chinese (zho)
+func (l Language) IsMacrolanguage() bool {
+	return l.Scope == ScopeMacrolanguage
+}
+
+// IsIndividual checks whether the language is an individual language, e.g. Russian (rus) or Mandarin Chinese (cmn)
+func (l Language) IsIndividual() bool {
+	return l.Scope == ScopeIndividual
+}
+
+// IsSpecial checks whether the language is a special-purpose entry, e.g. "und" (undetermined), see ExcludeSpecialPurpose
+func (l Language) IsSpecial() bool {
+	return l.Scope == ScopeSpecial
+}
+
+// BibliographicCode returns ISO639-2 bibliographic code, preferred by library systems (MARC), e.g. "ger" for German.
+// Returns empty string if language has no ISO639-2 code
+func (l Language) BibliographicCode() string {
+	return l.Part2B
+}
+
+// Part2MatchingPart1 returns ISO639-2 code aligned with ISO639-1 code of the language, e.g. "deu" rather than "ger" for "de".
+// ISO639-1 codes mostly derive from native names, as terminology codes do, so the heuristic is: the code starting with
+// ISO639-1 code wins, and terminology code is preferred if both or neither do (e.g. "fra" over "fre" for "fr").
+// Returns terminology code for languages without ISO639-1 code, empty string if language has no ISO639-2 code
+func (l Language) Part2MatchingPart1() string {
+	if l.Part1 != "" && !strings.HasPrefix(l.Part2T, l.Part1) && strings.HasPrefix(l.Part2B, l.Part1) {
+		return l.Part2B
+	}
+	return l.Part2T
+}
+
+// CacheKey returns a stable key identifying the language for caching derived data, e.g. "<version>:rus".
+// The key includes version of the embedded dataset, so cached data is invalidated when the dataset is updated
+func (l Language) CacheKey() string {
+	return datasetVersion + ":" + l.Part3
+}
 
-// FromPart3Code looks up language for given ISO639-3 three-symbol code.
+//go:generate go run cmd/generator.go -o lang-db.go -blob lang-blob.go -blob-data lang-db.bin -mph lang-mph.go -likely lang-likely.go -names lang-names.go
+
+// FromPart3Code looks up language for given ISO639-3 three-symbol code. Surrounding whitespace is ignored.
+// Returned language is shared by all callers and must not be modified, copy it if you need to.
 // Returns nil if not found
 func FromPart3Code(code string) *Language {
-	if l, ok := LanguagesPart3[code]; ok {
-		return &l
+	return lookupPart3(strings.TrimSpace(code))
+}
+
+// FromPart3CodeFold looks up language for given ISO639-3 three-symbol code case-insensitively, e.g. "RUS" or "Deu".
+// Returns nil if not found
+func FromPart3CodeFold(code string) *Language {
+	return FromPart3Code(strings.ToLower(code))
+}
+
+// LookupPart3 looks up language for given ISO639-3 three-symbol code.
+// Unlike FromPart3Code it returns a copy of language, which is safe to modify.
+// Returns wrapped ErrLanguageNotFound if not found
+func LookupPart3(code string) (Language, error) {
+	if l := lookupPart3(strings.TrimSpace(code)); l != nil {
+		return *l, nil
 	}
-	return nil
+	return Language{}, notFound(code)
+}
+
+// LookupPart2 looks up language for given ISO639-2 (both bibliographic or terminology) three-symbol code.
+// Returns wrapped ErrLanguageNotFound if not found
+func LookupPart2(code string) (Language, error) {
+	if l, ok := db().part2Index[strings.TrimSpace(code)]; ok {
+		return *l, nil
+	}
+	return Language{}, notFound(code)
+}
+
+// LookupPart1 looks up language for given ISO639-1 two-symbol code.
+// Returns wrapped ErrLanguageNotFound if not found
+func LookupPart1(code string) (Language, error) {
+	if l, ok := db().part1Index[strings.TrimSpace(code)]; ok {
+		return *l, nil
+	}
+	return Language{}, notFound(code)
+}
+
+// LookupAny looks up language for given code like FromAnyCode.
+// Returns wrapped ErrLanguageNotFound if not found, including malformed codes
+func LookupAny(code string) (Language, error) {
+	if l := FromAnyCode(code); l != nil {
+		return *l, nil
+	}
+	return Language{}, notFound(code)
+}
+
+// notFound returns ErrLanguageNotFound wrapped with the code
+func notFound(code string) error {
+	return fmt.Errorf("%w: %q", ErrLanguageNotFound, code)
 }
 
 // FromPart2Code looks up language for given ISO639-2 (both bibliographic or terminology) three-symbol code.
+// Surrounding whitespace is ignored. Returned language is shared by all callers and must not be modified.
 // Returns nil if not found
 func FromPart2Code(code string) *Language {
-	if l, ok := LanguagesPart2[code]; ok {
-		return &l
-	}
-	return nil
+	return db().part2Index[strings.TrimSpace(code)]
 }
 
-// FromPart1Code looks up language for given ISO639-1 two-symbol code.
+// FromPart1Code looks up language for given ISO639-1 two-symbol code. Surrounding whitespace is ignored.
+// Returned language is shared by all callers and must not be modified.
 // Returns nil if not found
 func FromPart1Code(code string) *Language {
-	if l, ok := LanguagesPart1[code]; ok {
-		return &l
-	}
-	return nil
+	return db().part1Index[strings.TrimSpace(code)]
+}
+
+// FromPart1CodeFold looks up language for given ISO639-1 two-symbol code case-insensitively, e.g. "RU" or "De".
+// Returns nil if not found
+func FromPart1CodeFold(code string) *Language {
+	return FromPart1Code(strings.ToLower(code))
 }
 
 // FromAnyCode looks up language for given code.
 // For three-symbol codes it tries ISO639-3 first, then ISO639-2.
 // For two-symbol codes it tries ISO639-1.
+// Code followed by region or other subtags is also accepted, so both "en-US" and "eng-US" resolve to English.
+// Surrounding whitespace is ignored. Returned language is shared by all callers and must not be modified.
 // Returns nil if not found
 func FromAnyCode(code string) *Language {
-	codeLen := len(code)
+	code = languageSubtag(strings.TrimSpace(code))
 
-	if codeLen == 3 {
+	switch len(code) {
+	case 3:
 		ret := FromPart3Code(code)
 		if ret == nil {
 			ret = FromPart2Code(code)
 		}
 		return ret
-	}
-
-	if codeLen == 2 {
+	case 2:
 		return FromPart1Code(code)
 	}
 
 	return nil
 }
 
-// FromName looks up language for given reference name.
+// FromAnyCodeFold looks up language for given code like FromAnyCode, but case-insensitively and ignoring surrounding whitespace,
+// e.g. " Eng " or "RU" resolve as well. Returns nil if not found
+func FromAnyCodeFold(code string) *Language {
+	return FromAnyCode(strings.ToLower(strings.TrimSpace(code)))
+}
+
+// FromAnyCodeE looks up language for given code like FromAnyCode, but returns descriptive error if lookup fails:
+// wrapped ErrInvalidCodeLength for malformed codes, wrapped ErrLanguageNotFound for well-formed codes which are not known
+func FromAnyCodeE(code string) (*Language, error) {
+	if n := len(languageSubtag(strings.TrimSpace(code))); n != 2 && n != 3 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidCodeLength, code)
+	}
+
+	if l := FromAnyCode(code); l != nil {
+		return l, nil
+	}
+	return nil, notFound(code)
+}
+
+// NameForCode returns English reference name of the language for given ISO639-1, ISO639-2 or ISO639-3 code,
+// e.g. "German" for "de", "ger" or "deu". Surrounding whitespace and case of the code are ignored.
+// Returns empty string if code can't be resolved
+func NameForCode(code string) string {
+	if l := FromAnyCodeFold(code); l != nil {
+		return l.Name
+	}
+	return ""
+}
+
+// IsValidPart3Code checks whether given code is a known ISO639-3 code, without copying language info.
+// As in FromPart3Code, case matters and surrounding whitespace is ignored
+func IsValidPart3Code(code string) bool {
+	_, ok := db().part3[strings.TrimSpace(code)]
+	return ok
+}
+
+// IsValidPart2Code checks whether given code is a known ISO639-2 (either bibliographic or terminology) code,
+// without copying language info. As in FromPart2Code, case matters and surrounding whitespace is ignored
+func IsValidPart2Code(code string) bool {
+	_, ok := db().part2[strings.TrimSpace(code)]
+	return ok
+}
+
+// IsValidPart1Code checks whether given code is a known ISO639-1 code, without copying language info.
+// As in FromPart1Code, case matters and surrounding whitespace is ignored
+func IsValidPart1Code(code string) bool {
+	_, ok := db().part1[strings.TrimSpace(code)]
+	return ok
+}
+
+// CodeLength checks given code against lookup tables of its length:
+// two-symbol codes only against ISO639-1, three-symbol codes only against ISO639-3 and ISO639-2.
+// Surrounding whitespace is ignored, as in FromAnyCode.
+// Returns length of the code (2 or 3) if it's known, 0 otherwise, including codes of any other length
+func CodeLength(code string) int {
+	code = strings.TrimSpace(code)
+	switch len(code) {
+	case 3:
+		if _, ok := db().part3[code]; ok {
+			return 3
+		}
+		if _, ok := db().part2[code]; ok {
+			return 3
+		}
+	case 2:
+		if _, ok := db().part1[code]; ok {
+			return 2
+		}
+	}
+
+	return 0
+}
+
+// LanguagesForCodes resolves given codes with FromAnyCode and returns distinct languages in order of their first appearance.
+// Codes which can't be resolved are skipped
+func LanguagesForCodes(codes []string) []Language {
+	var ret []Language
+	seen := map[string]bool{}
+	for _, code := range codes {
+		l := FromAnyCode(code)
+		if l == nil || seen[l.Part3] {
+			continue
+		}
+		seen[l.Part3] = true
+		ret = append(ret, *l)
+	}
+	return ret
+}
+
+// FromCodes resolves given codes with FromAnyCode and returns results in the same order, so i-th language corresponds to i-th code.
+// Codes which can't be resolved map to nil
+func FromCodes(codes []string) []*Language {
+	ret := make([]*Language, len(codes))
+	for i, code := range codes {
+		ret[i] = FromAnyCode(code)
+	}
+	return ret
+}
+
+// FromCodesMap resolves given codes with FromAnyCode and returns results keyed by codes as given.
+// Each distinct code is resolved once. Codes which can't be resolved map to nil
+func FromCodesMap(codes []string) map[string]*Language {
+	ret := make(map[string]*Language, len(codes))
+	for _, code := range codes {
+		if _, ok := ret[code]; !ok {
+			ret[code] = FromAnyCode(code)
+		}
+	}
+	return ret
+}
+
+// CodesFor returns all keys of LanguagesPart3, LanguagesPart2 and LanguagesPart1 lookup tables resolving to given language,
+// sorted and deduplicated, e.g. "de", "deu", "ger" for German
+func CodesFor(l Language) []string {
+	unique := map[string]bool{}
+	for _, lookup := range []map[string]Language{db().part3, db().part2, db().part1} {
+		for code, candidate := range lookup {
+			if candidate.Part3 == l.Part3 {
+				unique[code] = true
+			}
+		}
+	}
+
+	ret := make([]string, 0, len(unique))
+	for code := range unique {
+		ret = append(ret, code)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// AmbiguousFoldedCodes returns codes of LanguagesPart3, LanguagesPart2 and LanguagesPart1 lookup tables which,
+// lowercased, resolve to more than one language, mapped to ISO639-3 codes of those languages sorted.
+// Case-insensitive lookups (e.g. Suggest) are safe as long as the result is empty, which holds for current dataset
+func AmbiguousFoldedCodes() map[string][]string {
+	byCode := map[string]map[string]bool{}
+	for _, lookup := range []map[string]Language{db().part3, db().part2, db().part1} {
+		for code, l := range lookup {
+			folded := strings.ToLower(code)
+			if byCode[folded] == nil {
+				byCode[folded] = map[string]bool{}
+			}
+			byCode[folded][l.Part3] = true
+		}
+	}
+
+	ret := map[string][]string{}
+	for code, langs := range byCode {
+		if len(langs) < 2 {
+			continue
+		}
+		for part3 := range langs {
+			ret[code] = append(ret[code], part3)
+		}
+		sort.Strings(ret[code])
+	}
+	return ret
+}
+
+// LanguagesMissingName returns languages with empty reference name, sorted by ISO639-3 code.
+// It's a data integrity check: every language in ISO 639-3 has a name, so the result is expected to be empty
+func LanguagesMissingName() []Language {
+	var ret []Language
+	for _, l := range db().byPart3 {
+		if l.Name == "" {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// DifferingPart2Codes returns languages with ISO639-2 bibliographic code differing from terminology one,
+// e.g. German with "ger" and "deu", sorted by ISO639-3 code
+func DifferingPart2Codes() []Language {
+	var ret []Language
+	for _, l := range db().byPart3 {
+		if l.Part2B != l.Part2T {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// FromName looks up language for given reference name. Case matters, but whitespace is normalized:
+// surrounding whitespace is ignored and internal runs of whitespace, including non-breaking spaces, match single space,
+// so "Old\u00a0 English (ca. 450-1100) " finds Old English. Reference names packing several alternatives separated by ";"
+// in ISO 639-2 style, e.g. "Dutch; Flemish", are found by each of them as well, while Name of returned language stays the full one.
+// Alternative names from ISO 639-3 name index (see AltNames), mostly inverted ones, are found too if the lookup tables
+// were generated with it, e.g. "Chinese, Mandarin" finds Mandarin Chinese, unless some language has such reference name.
+// Shipped lookup tables are generated without the name index, see README.
+// If several languages share the name, the result is still deterministic: macrolanguage is preferred over its members,
+// then living language over others, then the one with lowest ISO639-3 code.
+// Returned language is shared by all callers and must not be modified.
 // Returns nil if not found
 func FromName(name string) *Language {
-	for _, l := range LanguagesPart3 {
-		if l.Name == name {
-			return &l
-		}
+	return languagesByName()[normalizeSpace(name)]
+}
+
+// FromNameFold looks up language for given reference or alternative name like FromName, but case-insensitively,
+// so "russian", "  German " and "FRENCH" are found. See foldName for details of case folding.
+// Returned language is shared by all callers and must not be modified.
+// Returns nil if not found
+func FromNameFold(name string) *Language {
+	code, ok := languageByFoldedName[foldName(name)]
+	if !ok {
+		return nil
 	}
-	return nil
+	return FromPart3Code(code)
 }
@@ -1,6 +1,10 @@
 package iso639_3
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -85,7 +89,14 @@ func TestFromAnyCode(t *testing.T) {
 		{"ru", "Russian"},
 		{"de", "German"},
 		{"ger", "German"},
+		{"en-US", "English"},
+		{"eng-US", "English"},
+		{"eng_GB", "English"},
+		{"zh-Hant-TW", "Chinese"},
+		{"pt-BR", "Portuguese"},
 		{"123", ""}, // doesn't exist
+		{"xxx-US", ""},
+		{"-US", ""},
 	}
 	for _, tt := range tests {
 		t.Run(tt.code, func(t *testing.T) {
@@ -109,6 +120,10 @@ func TestFromName(t *testing.T) {
 	}{
 		{"Russian", "rus"},
 		{"German", "deu"},
+		{" German\t", "deu"},
+		{"Old  English (ca. 450-1100)", "ang"},
+		{"Old\u00a0English (ca.\u00a0450-1100)", "ang"},
+		{"flemish", ""},
 		{"Elvish", ""}, // doesn't exist (ouch)
 	}
 	for _, tt := range tests {
@@ -125,3 +140,726 @@ func TestFromName(t *testing.T) {
 		})
 	}
 }
+
+func TestFromNameFold(t *testing.T) {
+	tests := []struct {
+		name          string
+		expectedPart3 string
+	}{
+		{"russian", "rus"},
+		{"  German ", "deu"},
+		{"FRENCH", "fra"},
+		{"chinese", "zho"},
+		{"old\u00a0 english (CA. 450-1100)", "ang"},
+		{"Elvish", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := FromNameFold(tt.name)
+
+			if tt.expectedPart3 == "" {
+				if actual != nil {
+					t.Errorf("FromNameFold() = %v, expected nil", actual)
+				}
+			} else if actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("FromNameFold() = %v, expected Language with Part3 %v", actual, tt.expectedPart3)
+			}
+		})
+	}
+
+	if actual := FromName("russian"); actual != nil {
+		t.Errorf("FromName() = %v, expected nil since it's case-sensitive", actual)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	lookups := map[string]func(string) (Language, error){
+		"LookupPart3": LookupPart3,
+		"LookupPart2": LookupPart2,
+		"LookupPart1": LookupPart1,
+		"LookupAny":   LookupAny,
+	}
+	tests := []struct {
+		lookup       string
+		code         string
+		expectedName string
+	}{
+		{"LookupPart3", "rus", "Russian"},
+		{"LookupPart3", "deu", "German"},
+		{"LookupPart3", "ger", ""},
+		{"LookupPart3", "123", ""},
+		{"LookupPart2", "ger", "German"},
+		{"LookupPart2", "deu", "German"},
+		{"LookupPart2", "cmn", ""},
+		{"LookupPart1", "de", "German"},
+		{"LookupPart1", "deu", ""},
+		{"LookupAny", "cmn", "Mandarin Chinese"},
+		{"LookupAny", "ger", "German"},
+		{"LookupAny", "de-AT", "German"},
+		{"LookupAny", "xx", ""},
+		{"LookupAny", "toolong", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lookup+"/"+tt.code, func(t *testing.T) {
+			actual, err := lookups[tt.lookup](tt.code)
+
+			if tt.expectedName == "" {
+				if !errors.Is(err, ErrLanguageNotFound) || actual != (Language{}) {
+					t.Errorf("%s() = %v, %v, expected ErrLanguageNotFound", tt.lookup, actual, err)
+				}
+				return
+			}
+			if err != nil || actual.Name != tt.expectedName {
+				t.Errorf("%s() = %v, %v, expected Language with english name %v", tt.lookup, actual, err, tt.expectedName)
+			}
+		})
+	}
+}
+
+func BenchmarkFromPart3Code(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if FromPart3Code("rus") == nil {
+				b.Fatal("FromPart3Code() = nil")
+			}
+		}
+	})
+}
+
+func BenchmarkFromName(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if FromName("Russian") == nil {
+				b.Fatal("FromName() = nil")
+			}
+		}
+	})
+}
+
+func BenchmarkLookupPart3(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if _, err := LookupPart3("rus"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestLookupPart3Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		LookupPart3("rus")
+	})
+	if allocs != 0 {
+		t.Errorf("LookupPart3() allocates %v times, expected 0", allocs)
+	}
+}
+
+func TestFromCodeAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		FromPart3Code("rus")
+		FromPart2Code("ger")
+		FromPart1Code("ru")
+		FromAnyCode("deu")
+	})
+	if allocs != 0 {
+		t.Errorf("From*Code() allocates %v times, expected 0", allocs)
+	}
+}
+
+func TestFromCodeSharesLanguage(t *testing.T) {
+	part3 := FromPart3Code("deu")
+	for _, l := range []*Language{FromPart2Code("ger"), FromPart2Code("deu"), FromPart1Code("de"), FromAnyCode("de-DE")} {
+		if l != part3 {
+			t.Errorf("lookup = %p, expected %p shared with FromPart3Code()", l, part3)
+		}
+	}
+
+	for code, l := range db().part2 {
+		if actual := FromPart2Code(code); actual == nil || *actual != l {
+			t.Errorf("FromPart2Code(%v) = %v, expected %v", code, actual, l)
+		}
+	}
+	for code, l := range db().part1 {
+		if actual := FromPart1Code(code); actual == nil || *actual != l {
+			t.Errorf("FromPart1Code(%v) = %v, expected %v", code, actual, l)
+		}
+	}
+}
+
+func TestLanguageEnums(t *testing.T) {
+	scopes := map[LanguageScope]bool{
+		ScopeIndividual:    true,
+		ScopeSpecial:       true,
+		ScopeMacrolanguage: true,
+	}
+	types := map[LanguageType]bool{
+		TypeLiving:      true,
+		TypeHistorical:  true,
+		TypeAncient:     true,
+		TypeExtinct:     true,
+		TypeConstructed: true,
+		TypeSpecial:     true,
+	}
+	for name, lookup := range map[string]map[string]Language{
+		"LanguagesPart3": db().part3,
+		"LanguagesPart2": db().part2,
+		"LanguagesPart1": db().part1,
+	} {
+		for code, l := range lookup {
+			if !scopes[l.Scope] {
+				t.Errorf("%v[%v] has unknown scope %q", name, code, l.Scope)
+			}
+			if !types[l.LanguageType] {
+				t.Errorf("%v[%v] has unknown language type %q", name, code, l.LanguageType)
+			}
+		}
+	}
+}
+
+func TestDeprecatedEnums(t *testing.T) {
+	// former names must keep their types, so existing code assigning them to Language fields still compiles
+	l := Language{Scope: LanguageTypeMacrolanguage, LanguageType: LanguageScopeLiving}
+	if l.Scope != ScopeMacrolanguage || l.LanguageType != TypeLiving {
+		t.Errorf("deprecated enums = %v, %v, expected %v, %v", l.Scope, l.LanguageType, ScopeMacrolanguage, TypeLiving)
+	}
+}
+
+func TestLanguage_ScopePredicates(t *testing.T) {
+	tests := []struct {
+		part3                 string
+		expectedMacrolanguage bool
+		expectedIndividual    bool
+		expectedSpecial       bool
+	}{
+		{"zho", true, false, false},
+		{"rus", false, true, false},
+		{"cmn", false, true, false},
+		{"und", false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			l := FromPart3Code(tt.part3)
+
+			if actual := l.IsMacrolanguage(); actual != tt.expectedMacrolanguage {
+				t.Errorf("IsMacrolanguage() = %v, expected %v", actual, tt.expectedMacrolanguage)
+			}
+			if actual := l.IsIndividual(); actual != tt.expectedIndividual {
+				t.Errorf("IsIndividual() = %v, expected %v", actual, tt.expectedIndividual)
+			}
+			if actual := l.IsSpecial(); actual != tt.expectedSpecial {
+				t.Errorf("IsSpecial() = %v, expected %v", actual, tt.expectedSpecial)
+			}
+		})
+	}
+
+	var zero Language
+	if zero.IsMacrolanguage() || zero.IsIndividual() || zero.IsSpecial() {
+		t.Errorf("scope predicates of zero Language expected to be false")
+	}
+}
+
+func TestLanguage_BibliographicCode(t *testing.T) {
+	tests := []struct {
+		part3    string
+		expected string
+	}{
+		{"deu", "ger"},
+		{"rus", "rus"},
+		{"zho", "chi"},
+		{"cmn", ""}, // no part 2 code
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			actual := FromPart3Code(tt.part3).BibliographicCode()
+
+			if actual != tt.expected {
+				t.Errorf("BibliographicCode() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguagesForCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		codes    []string
+		expected []string
+	}{
+		{"duplicates", []string{"en", "eng", "de", "ger", "deu"}, []string{"eng", "deu"}},
+		{"unknown skipped", []string{"123", "ru", "xx"}, []string{"rus"}},
+		{"empty", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := LanguagesForCodes(tt.codes)
+
+			var actualCodes []string
+			for _, l := range actual {
+				actualCodes = append(actualCodes, l.Part3)
+			}
+			if !reflect.DeepEqual(actualCodes, tt.expected) {
+				t.Errorf("LanguagesForCodes() = %v, expected languages %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguage_CacheKey(t *testing.T) {
+	l := FromPart3Code("rus")
+
+	key := l.CacheKey()
+	if key != datasetVersion+":rus" {
+		t.Errorf("CacheKey() = %v, expected dataset version and part 3 code", key)
+	}
+	if FromPart3Code("deu").CacheKey() == key {
+		t.Errorf("CacheKey() = %v for different languages", key)
+	}
+
+	defer func(v string) { datasetVersion = v }(datasetVersion)
+	datasetVersion = "changed"
+	if l.CacheKey() == key {
+		t.Errorf("CacheKey() = %v for different dataset versions", key)
+	}
+}
+
+func TestCodeLength(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected int
+	}{
+		{"ru", 2},
+		{"rus", 3},
+		{"ger", 3},
+		{" en", 2},
+		{"rus\n", 3},
+		{"r", 0},
+		{"russ", 0},
+		{"xx", 0},
+		{"123", 0},
+		{"", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if actual := CodeLength(tt.code); actual != tt.expected {
+				t.Errorf("CodeLength() = %v, expected %v", actual, tt.expected)
+			}
+			if actual := FromAnyCode(tt.code); (actual != nil) != (tt.expected != 0) {
+				t.Errorf("FromAnyCode() = %v, inconsistent with CodeLength() = %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFromAnyCodeE(t *testing.T) {
+	tests := []struct {
+		code          string
+		expectedPart3 string
+		expectedErr   error
+	}{
+		{"rus", "rus", nil},
+		{"de", "deu", nil},
+		{"eng-US", "eng", nil},
+		{" en", "eng", nil},
+		{"r", "", ErrInvalidCodeLength},
+		{"engl-US", "", ErrInvalidCodeLength},
+		{"russ", "", ErrInvalidCodeLength},
+		{"", "", ErrInvalidCodeLength},
+		{"qqq", "", ErrLanguageNotFound},
+		{"qq", "", ErrLanguageNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual, err := FromAnyCodeE(tt.code)
+
+			if tt.expectedErr != nil {
+				if actual != nil || !errors.Is(err, tt.expectedErr) {
+					t.Errorf("FromAnyCodeE() = %v, %v, expected error %v", actual, err, tt.expectedErr)
+				}
+			} else if err != nil || actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("FromAnyCodeE() = %v, %v, expected Language with Part3 %v", actual, err, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestCodesFor(t *testing.T) {
+	tests := []struct {
+		part3    string
+		expected []string
+	}{
+		{"deu", []string{"de", "deu", "ger"}},
+		{"rus", []string{"ru", "rus"}},
+		{"cmn", []string{"cmn"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			actual := CodesFor(*FromPart3Code(tt.part3))
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("CodesFor() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguageScope_String(t *testing.T) {
+	tests := map[LanguageScope]string{
+		ScopeIndividual:    "Individual",
+		ScopeMacrolanguage: "Macrolanguage",
+		ScopeSpecial:       "Special",
+		'X':                "LanguageScope('X')",
+		0:                  `LanguageScope('\x00')`,
+	}
+	for scope, expected := range tests {
+		if actual := fmt.Sprintf("%v", scope); actual != expected {
+			t.Errorf("String() = %v, expected %v", actual, expected)
+		}
+	}
+}
+
+func TestLanguageType_String(t *testing.T) {
+	tests := map[LanguageType]string{
+		TypeLiving:      "Living",
+		TypeHistorical:  "Historical",
+		TypeAncient:     "Ancient",
+		TypeExtinct:     "Extinct",
+		TypeConstructed: "Constructed",
+		TypeSpecial:     "Special",
+		'X':             "LanguageType('X')",
+	}
+	for typ, expected := range tests {
+		if actual := fmt.Sprintf("%s", typ); actual != expected {
+			t.Errorf("String() = %v, expected %v", actual, expected)
+		}
+	}
+}
+
+func TestLanguage_String(t *testing.T) {
+	tests := []struct {
+		lang     Language
+		expected string
+	}{
+		{*FromPart3Code("rus"), "Russian (rus)"},
+		{Language{Part3: "xyz"}, "xyz"},
+		{Language{}, ""},
+	}
+	for _, tt := range tests {
+		if actual := tt.lang.String(); actual != tt.expected {
+			t.Errorf("String() = %q, expected %q", actual, tt.expected)
+		}
+	}
+
+	if actual := fmt.Sprint(FromPart3Code("deu")); actual != "German (deu)" {
+		t.Errorf("fmt.Sprint() = %q, expected %q", actual, "German (deu)")
+	}
+}
+
+func TestLanguage_Part2MatchingPart1(t *testing.T) {
+	tests := []struct {
+		part3    string
+		expected string
+	}{
+		{"deu", "deu"}, // not "ger"
+		{"fra", "fra"}, // both "fra" and "fre" start with "fr"
+		{"zho", "zho"}, // not "chi"
+		{"isl", "isl"}, // not "ice"
+		{"eng", "eng"},
+		{"ang", "ang"}, // no ISO 639-1 code
+		{"cmn", ""},    // no ISO 639-2 code
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			if actual := FromPart3Code(tt.part3).Part2MatchingPart1(); actual != tt.expected {
+				t.Errorf("Part2MatchingPart1() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+
+	// no language has ISO 639-1 code aligned with bibliographic code only
+	for _, l := range db().part1 {
+		if l.Part2MatchingPart1() != l.Part2T {
+			t.Errorf("Part2MatchingPart1() of %v = %v, expected terminology code %v", l.Part3, l.Part2MatchingPart1(), l.Part2T)
+		}
+	}
+}
+
+func TestFromPart3CodeFold(t *testing.T) {
+	tests := []struct {
+		code          string
+		expectedPart3 string
+	}{
+		{"rus", "rus"},
+		{"RUS", "rus"},
+		{"Deu", "deu"},
+		{"XXX", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := ""
+			if l := FromPart3CodeFold(tt.code); l != nil {
+				actual = l.Part3
+			}
+
+			if actual != tt.expectedPart3 {
+				t.Errorf("FromPart3CodeFold(%v) = %v, expected %v", tt.code, actual, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestFromPart1CodeFold(t *testing.T) {
+	tests := []struct {
+		code          string
+		expectedPart3 string
+	}{
+		{"en", "eng"},
+		{"EN", "eng"},
+		{"De", "deu"},
+		{"rU", "rus"},
+		{"QQ", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := ""
+			if l := FromPart1CodeFold(tt.code); l != nil {
+				actual = l.Part3
+			}
+
+			if actual != tt.expectedPart3 {
+				t.Errorf("FromPart1CodeFold(%v) = %v, expected %v", tt.code, actual, tt.expectedPart3)
+			}
+		})
+	}
+
+	if FromPart1Code("EN") != nil {
+		t.Errorf("FromPart1Code(EN) != nil, expected exact match only")
+	}
+}
+
+func TestFromAnyCodeFold(t *testing.T) {
+	tests := []struct {
+		code          string
+		expectedPart3 string
+	}{
+		{"Eng", "eng"},
+		{"Ru", "rus"},
+		{" en ", "eng"},
+		{"\tGER\n", "deu"},
+		{"EN-us", "eng"},
+		{"XX", ""},
+		{"", ""},
+		{"   ", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := ""
+			if l := FromAnyCodeFold(tt.code); l != nil {
+				actual = l.Part3
+			}
+
+			if actual != tt.expectedPart3 {
+				t.Errorf("FromAnyCodeFold(%q) = %v, expected %v", tt.code, actual, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestLookupTrimsWhitespace(t *testing.T) {
+	lookups := []struct {
+		name   string
+		lookup func(string) *Language
+		code   string
+	}{
+		{"FromPart3Code", FromPart3Code, "\teng"},
+		{"FromPart3Code", FromPart3Code, "eng\n"},
+		{"FromPart2Code", FromPart2Code, " ger\r\n"},
+		{"FromPart1Code", FromPart1Code, " ru "},
+		{"FromPart1Code", FromPart1Code, "\u00a0ru"},
+		{"FromAnyCode", FromAnyCode, "\teng"},
+		{"FromAnyCode", FromAnyCode, "eng\n"},
+		{"FromAnyCode", FromAnyCode, " ru "},
+		{"FromAnyCode", FromAnyCode, " en-US "},
+	}
+	for _, tt := range lookups {
+		t.Run(fmt.Sprintf("%s(%q)", tt.name, tt.code), func(t *testing.T) {
+			if tt.lookup(tt.code) == nil {
+				t.Errorf("%s(%q) = nil, expected language", tt.name, tt.code)
+			}
+		})
+	}
+
+	for _, code := range []string{"e ng", "r u", " "} {
+		if l := FromAnyCode(code); l != nil {
+			t.Errorf("FromAnyCode(%q) = %v, expected nil", code, l)
+		}
+	}
+	if _, err := FromAnyCodeE(" eng "); err != nil {
+		t.Errorf("FromAnyCodeE(%q) error = %v, expected nil", " eng ", err)
+	}
+}
+
+func TestIsValidCode(t *testing.T) {
+	predicates := map[string]func(string) bool{
+		"IsValidPart3Code": IsValidPart3Code,
+		"IsValidPart2Code": IsValidPart2Code,
+		"IsValidPart1Code": IsValidPart1Code,
+	}
+	tests := []struct {
+		predicate string
+		code      string
+		expected  bool
+	}{
+		{"IsValidPart3Code", "deu", true},
+		{"IsValidPart3Code", " deu\n", true},
+		{"IsValidPart3Code", "ger", false},
+		{"IsValidPart3Code", "DEU", false},
+		{"IsValidPart2Code", "ger", true},
+		{"IsValidPart2Code", "deu", true},
+		{"IsValidPart2Code", "cmn", false},
+		{"IsValidPart1Code", "de", true},
+		{"IsValidPart1Code", "DE", false},
+		{"IsValidPart1Code", "deu", false},
+		{"IsValidPart1Code", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.predicate+"/"+tt.code, func(t *testing.T) {
+			if actual := predicates[tt.predicate](tt.code); actual != tt.expected {
+				t.Errorf("%s(%q) = %v, expected %v", tt.predicate, tt.code, actual, tt.expected)
+			}
+		})
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		IsValidPart3Code("rus")
+		IsValidPart2Code("ger")
+		IsValidPart1Code("ru")
+	})
+	if allocs != 0 {
+		t.Errorf("IsValidPart*Code() allocates %v times, expected 0", allocs)
+	}
+}
+
+func TestFromCodes(t *testing.T) {
+	codes := []string{"rus", "xx", "de", "rus", "", "ger"}
+
+	actual := FromCodes(codes)
+
+	expected := []string{"rus", "", "deu", "rus", "", "deu"}
+	if len(actual) != len(expected) {
+		t.Fatalf("FromCodes() returned %d languages, expected %d", len(actual), len(expected))
+	}
+	for i, l := range actual {
+		part3 := ""
+		if l != nil {
+			part3 = l.Part3
+		}
+		if part3 != expected[i] {
+			t.Errorf("FromCodes()[%d] = %v, expected %v", i, part3, expected[i])
+		}
+	}
+}
+
+func TestFromCodesMap(t *testing.T) {
+	actual := FromCodesMap([]string{"rus", "xx", "de", "rus", "ger"})
+
+	expected := map[string]string{"rus": "rus", "xx": "", "de": "deu", "ger": "deu"}
+	if len(actual) != len(expected) {
+		t.Errorf("FromCodesMap() = %v, expected %d entries", actual, len(expected))
+	}
+	for code, part3 := range expected {
+		l, ok := actual[code]
+		if !ok {
+			t.Errorf("FromCodesMap() has no %v", code)
+		} else if (l == nil) != (part3 == "") || l != nil && l.Part3 != part3 {
+			t.Errorf("FromCodesMap()[%v] = %v, expected %v", code, l, part3)
+		}
+	}
+}
+
+func TestNameForCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"de", "German"},
+		{"ger", "German"},
+		{"deu", "German"},
+		{" DEU\n", "German"},
+		{"En", "English"},
+		{"zh-Hans", "Chinese"},
+		{"cmn", "Mandarin Chinese"},
+		{"xx", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if actual := NameForCode(tt.code); actual != tt.expected {
+				t.Errorf("NameForCode(%q) = %q, expected %q", tt.code, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguagesMissingName(t *testing.T) {
+	if actual := LanguagesMissingName(); len(actual) != 0 {
+		t.Errorf("LanguagesMissingName() = %v, expected none", actual)
+	}
+
+	for _, lookup := range []map[string]Language{db().part2, db().part1} {
+		for code, l := range lookup {
+			if l.Name == "" {
+				t.Errorf("language of code %v has no name", code)
+			}
+		}
+	}
+}
+
+func TestAmbiguousFoldedCodes(t *testing.T) {
+	if actual := AmbiguousFoldedCodes(); len(actual) != 0 {
+		t.Errorf("AmbiguousFoldedCodes() = %v, expected no collisions", actual)
+	}
+
+	// every code must be already folded, so case-insensitive lookups can simply lowercase input
+	for _, lookup := range []map[string]Language{db().part3, db().part2, db().part1} {
+		for code := range lookup {
+			if code != strings.ToLower(code) {
+				t.Errorf("code %q is not lowercase", code)
+			}
+		}
+	}
+}
+
+func TestDifferingPart2Codes(t *testing.T) {
+	langs := DifferingPart2Codes()
+	if len(langs) < 15 || len(langs) > 25 {
+		t.Errorf("DifferingPart2Codes() returned %v languages, expected about 20", len(langs))
+	}
+
+	found := map[string]bool{}
+	for i, l := range langs {
+		if l.Part2B == l.Part2T {
+			t.Errorf("DifferingPart2Codes() returned %v with equal codes %v", l.Part3, l.Part2B)
+		}
+		if i > 0 && l.Part3 <= langs[i-1].Part3 {
+			t.Errorf("DifferingPart2Codes() has %v after %v, expected sorted order", l.Part3, langs[i-1].Part3)
+		}
+		found[l.Part3] = true
+	}
+	for _, code := range []string{"deu", "fra"} {
+		if !found[code] {
+			t.Errorf("DifferingPart2Codes() doesn't contain %v", code)
+		}
+	}
+}
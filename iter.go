@@ -0,0 +1,31 @@
+//go:build go1.23
+// +build go1.23
+
+package iso639_3
+
+import "iter"
+
+// All returns iterator over all distinct languages sorted by ISO639-3 code, e.g. for lang := range iso639_3.All().
+// Unlike AllLanguages it doesn't allocate a slice
+func All() iter.Seq[Language] {
+	return func(yield func(Language) bool) {
+		for _, l := range db().byPart3 {
+			if !yield(l) {
+				return
+			}
+		}
+	}
+}
+
+// AllPointers returns iterator over all distinct languages sorted by ISO639-3 code, like All, but without copying them.
+// Yielded languages are shared by all callers and must not be modified
+func AllPointers() iter.Seq[*Language] {
+	return func(yield func(*Language) bool) {
+		langs := db().byPart3
+		for i := range langs {
+			if !yield(&langs[i]) {
+				return
+			}
+		}
+	}
+}
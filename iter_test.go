@@ -0,0 +1,54 @@
+//go:build go1.23
+// +build go1.23
+
+package iso639_3
+
+import "testing"
+
+func TestAll(t *testing.T) {
+	expected := AllLanguages()
+	i := 0
+	for l := range All() {
+		if i >= len(expected) || l != expected[i] {
+			t.Fatalf("All() yielded %v at %v, expected same order as AllLanguages()", l.Part3, i)
+		}
+		i++
+	}
+	if i != len(expected) {
+		t.Errorf("All() yielded %v languages, expected %v", i, len(expected))
+	}
+
+	for l := range All() {
+		if l.Part3 != "aaa" {
+			t.Errorf("All() yielded %v first, expected aaa", l.Part3)
+		}
+		break
+	}
+}
+
+func TestAllPointers(t *testing.T) {
+	i := 0
+	for l := range AllPointers() {
+		if *l != db().byPart3[i] {
+			t.Fatalf("AllPointers() yielded %v at %v, expected %v", l.Part3, i, db().byPart3[i].Part3)
+		}
+		if l != FromPart3Code(l.Part3) {
+			t.Errorf("AllPointers() yielded %p for %v, expected %p shared with FromPart3Code()", l, l.Part3, FromPart3Code(l.Part3))
+		}
+		i++
+	}
+	if i != len(db().byPart3) {
+		t.Errorf("AllPointers() yielded %v languages, expected %v", i, len(db().byPart3))
+	}
+}
+
+func BenchmarkAll(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for l := range All() {
+			if l.Part3 == "zza" {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,219 @@
+//go:build iso639_blob
+// +build iso639_blob
+
+package iso639_3
+
+import _ "embed"
+
+// datasetVersion identifies data the lookup tables were generated from
+var datasetVersion = "545dd92dd535"
+
+// languagesBlob holds all languages packed by the generator with -blob-data flag, sorted by ISO 639-3 code
+//
+//go:embed lang-db.bin
+var languagesBlob string
+
+// macrolanguageMembers lookup table. Keys are ISO 639-3 macrolanguage codes, values are ISO 639-3 codes of their members
+var macrolanguageMembers = map[string][]string{
+	"aka": {"fat", "twi"},
+	"ara": {"aao", "abh", "abv", "acm", "acq", "acw", "acx", "acy", "adf", "aeb", "aec", "afb", "apc", "apd", "arb", "arq", "ars", "ary", "arz", "auz", "avl", "ayh", "ayl", "ayn", "ayp", "pga", "shu", "ssh"},
+	"aym": {"ayc", "ayr"},
+	"aze": {"azb", "azj"},
+	"bal": {"bcc", "bgn", "bgp"},
+	"bik": {"bcl", "bln", "bto", "cts", "fbl", "lbl", "rbl", "ubl"},
+	"bnc": {"ebk", "lbk", "obk", "rbk", "vbk"},
+	"bua": {"bxm", "bxr", "bxu"},
+	"chm": {"mhr", "mrj"},
+	"cre": {"crj", "crk", "crl", "crm", "csw", "cwd"},
+	"del": {"umu", "unm"},
+	"den": {"scs", "xsl"},
+	"din": {"dib", "dik", "dip", "diw", "dks"},
+	"doi": {"dgo", "xnr"},
+	"est": {"ekk", "vro"},
+	"fas": {"pes", "prs"},
+	"ful": {"ffm", "fub", "fuc", "fue", "fuf", "fuh", "fui", "fuq", "fuv", "fvr"},
+	"gba": {"bdt", "gbp", "gbq", "gmm", "gso", "gya"},
+	"gon": {"esg", "gno", "wsg"},
+	"grb": {"gbo", "gec", "grj", "grv", "gry"},
+	"grn": {"gnw", "gug", "gui", "gun", "nhd"},
+	"hai": {"hax", "hdn"},
+	"hbs": {"bos", "cnr", "hrv", "srp"},
+	"hmn": {"cqd", "hea", "hma", "hmc", "hmd", "hme", "hmg", "hmh", "hmi", "hmj", "hml", "hmm", "hmp", "hmq", "hms", "hmw", "hmy", "hmz", "hnj", "hrm", "huj", "mmr", "muq", "mww", "sfm"},
+	"iku": {"ike", "ikt"},
+	"ipk": {"esi", "esk"},
+	"jrb": {"ajt", "aju", "jye", "yhd", "yud"},
+	"kau": {"kby", "knc", "krt"},
+	"kln": {"enb", "eyo", "niq", "oki", "pko", "sgc", "spy", "tec", "tuy"},
+	"kok": {"gom", "knn"},
+	"kom": {"koi", "kpv"},
+	"kon": {"kng", "kwy", "ldi"},
+	"kpe": {"gkp", "xpe"},
+	"kur": {"ckb", "kmr", "sdh"},
+	"lah": {"hnd", "hno", "jat", "phr", "pnb", "skr", "xhe"},
+	"lav": {"ltg", "lvs"},
+	"luy": {"bxk", "ida", "lkb", "lko", "lks", "lri", "lrm", "lsm", "lto", "lts", "lwg", "nle", "nyd", "rag"},
+	"man": {"emk", "mku", "mlq", "mnk", "msc", "mwk"},
+	"mlg": {"bhr", "bmm", "bzc", "msh", "plt", "skg", "tdx", "tkg", "txy", "xmv", "xmw"},
+	"mon": {"khk", "mvf"},
+	"msa": {"bjn", "btj", "bve", "bvu", "coa", "dup", "hji", "ind", "jak", "jax", "kvb", "kvr", "kxd", "lce", "lcf", "liw", "max", "meo", "mfa", "mfb", "min", "mqg", "msi", "mui", "orn", "ors", "pel", "pse", "tmw", "urk", "vkk", "vkt", "xmm", "zlm", "zmi", "zsm"},
+	"mwr": {"dhd", "mtr", "mve", "rwr", "swv", "wry"},
+	"nep": {"dty", "npi"},
+	"nor": {"nno", "nob"},
+	"oji": {"ciw", "ojb", "ojc", "ojg", "ojs", "ojw", "otw"},
+	"ori": {"ory", "spv"},
+	"orm": {"gax", "gaz", "hae", "orc"},
+	"pus": {"pbt", "pbu", "pst"},
+	"que": {"qub", "qud", "quf", "qug", "quh", "quk", "qul", "qup", "qur", "qus", "quw", "qux", "quy", "quz", "qva", "qvc", "qve", "qvh", "qvi", "qvj", "qvl", "qvm", "qvn", "qvo", "qvp", "qvs", "qvw", "qvz", "qwa", "qws", "qxa", "qxc", "qxh", "qxl", "qxn", "qxo", "qxp", "qxr", "qxt", "qxu", "qxw"},
+	"raj": {"bgq", "gda", "gju", "hoj", "mup", "wbr"},
+	"rom": {"rmc", "rmf", "rml", "rmn", "rmo", "rmw", "rmy"},
+	"sqi": {"aae", "aat", "aln", "als"},
+	"srd": {"sdc", "sdn", "src", "sro"},
+	"swa": {"swc", "swh"},
+	"syr": {"aii", "cld"},
+	"tmh": {"taq", "thv", "thz", "ttq"},
+	"uzb": {"uzn", "uzs"},
+	"yid": {"ydd", "yih"},
+	"zap": {"zaa", "zab", "zac", "zad", "zae", "zaf", "zai", "zam", "zao", "zaq", "zar", "zas", "zat", "zav", "zaw", "zax", "zca", "zoo", "zpa", "zpb", "zpc", "zpd", "zpe", "zpf", "zpg", "zph", "zpi", "zpj", "zpk", "zpl", "zpm", "zpn", "zpo", "zpp", "zpq", "zpr", "zps", "zpt", "zpu", "zpv", "zpw", "zpx", "zpy", "zpz", "zsr", "zte", "ztg", "ztl", "ztm", "ztn", "ztp", "ztq", "zts", "ztt", "ztu", "ztx", "zty"},
+	"zha": {"zch", "zeh", "zgb", "zgm", "zgn", "zhd", "zhn", "zlj", "zln", "zlq", "zqe", "zyb", "zyg", "zyj", "zyn", "zzj"},
+	"zho": {"cdo", "cjy", "cmn", "cnp", "cpx", "csp", "czh", "czo", "gan", "hak", "hsn", "lzh", "mnp", "nan", "wuu", "yue"},
+	"zza": {"diq", "kiu"},
+}
+
+// languageAltNames lookup table. Keys are ISO 639-3 codes, values are alternative names from the name index,
+// sorted and without exact (case-sensitive) duplicates. Empty unless generated with the name index
+var languageAltNames = map[string][]string{}
+
+// retiredCodes lookup table. Keys are retired ISO 639-3 codes
+var retiredCodes = map[string]RetiredCode{}
+
+// languageAutonyms lookup table. Keys are ISO 639-3 codes, values are names of languages in themselves from Unicode CLDR
+var languageAutonyms = map[string]string{
+	"afr": "Afrikaans",
+	"aka": "Akan",
+	"amh": "አማርኛ",
+	"ara": "العربية",
+	"asm": "অসমীয়া",
+	"aze": "azərbaycan",
+	"bam": "bamanakan",
+	"bel": "беларуская",
+	"ben": "বাংলা",
+	"bod": "བོད་སྐད་",
+	"bos": "bosanski",
+	"bre": "brezhoneg",
+	"bul": "български",
+	"cat": "català",
+	"ces": "čeština",
+	"che": "нохчийн",
+	"cor": "kernewek",
+	"cym": "Cymraeg",
+	"dan": "dansk",
+	"deu": "Deutsch",
+	"dzo": "རྫོང་ཁ",
+	"ell": "Ελληνικά",
+	"eng": "English",
+	"epo": "esperanto",
+	"est": "eesti",
+	"eus": "euskara",
+	"ewe": "Eʋegbe",
+	"fao": "føroyskt",
+	"fas": "فارسی",
+	"fin": "suomi",
+	"fra": "français",
+	"fry": "Frysk",
+	"ful": "Pulaar",
+	"gla": "Gàidhlig",
+	"gle": "Gaeilge",
+	"glg": "galego",
+	"glv": "Gaelg",
+	"guj": "ગુજરાતી",
+	"hau": "Hausa",
+	"hbs": "srpskohrvatski",
+	"heb": "עברית",
+	"hin": "हिन्दी",
+	"hrv": "hrvatski",
+	"hun": "magyar",
+	"hye": "հայերեն",
+	"ibo": "Igbo",
+	"iii": "ꆈꌠꉙ",
+	"ind": "Indonesia",
+	"isl": "íslenska",
+	"ita": "italiano",
+	"jpn": "日本語",
+	"kal": "kalaallisut",
+	"kan": "ಕನ್ನಡ",
+	"kas": "کٲشُر",
+	"kat": "ქართული",
+	"kaz": "қазақ тілі",
+	"khm": "ខ្មែរ",
+	"kik": "Gikuyu",
+	"kin": "Kinyarwanda",
+	"kir": "кыргызча",
+	"kor": "한국어",
+	"lao": "ລາວ",
+	"lav": "latviešu",
+	"lin": "lingála",
+	"lit": "lietuvių",
+	"ltz": "Lëtzebuergesch",
+	"lub": "Tshiluba",
+	"lug": "Luganda",
+	"mal": "മലയാളം",
+	"mar": "मराठी",
+	"mkd": "македонски",
+	"mlg": "Malagasy",
+	"mlt": "Malti",
+	"mon": "монгол",
+	"msa": "Melayu",
+	"mya": "မြန်မာ",
+	"nde": "isiNdebele",
+	"nep": "नेपाली",
+	"nld": "Nederlands",
+	"nno": "nynorsk",
+	"nob": "norsk bokmål",
+	"nor": "norsk bokmål",
+	"ori": "ଓଡ଼ିଆ",
+	"orm": "Oromoo",
+	"oss": "ирон",
+	"pan": "ਪੰਜਾਬੀ",
+	"pol": "polski",
+	"por": "português",
+	"pus": "پښتو",
+	"que": "Runasimi",
+	"roh": "rumantsch",
+	"ron": "română",
+	"run": "Ikirundi",
+	"rus": "русский",
+	"sag": "Sängö",
+	"sin": "සිංහල",
+	"slk": "slovenčina",
+	"slv": "slovenščina",
+	"sme": "davvisámegiella",
+	"sna": "chiShona",
+	"snd": "سنڌي",
+	"som": "Soomaali",
+	"spa": "español",
+	"sqi": "shqip",
+	"srp": "српски",
+	"swa": "Kiswahili",
+	"swe": "svenska",
+	"tam": "தமிழ்",
+	"tat": "татар",
+	"tel": "తెలుగు",
+	"tgk": "тоҷикӣ",
+	"tgl": "Filipino",
+	"tha": "ไทย",
+	"tir": "ትግርኛ",
+	"ton": "lea fakatonga",
+	"tuk": "Türkmen dili",
+	"tur": "Türkçe",
+	"twi": "Akan",
+	"uig": "ئۇيغۇرچە",
+	"ukr": "українська",
+	"urd": "اردو",
+	"uzb": "o‘zbek",
+	"vie": "Tiếng Việt",
+	"wol": "Wolof",
+	"yid": "ייִדיש",
+	"yor": "Èdè Yorùbá",
+	"zho": "中文",
+	"zul": "isiZulu",
+}
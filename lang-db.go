@@ -1,12 +1,19 @@
+//go:build !iso639_blob
+// +build !iso639_blob
+
 package iso639_3
 
-// LanguagesPart3 lookup table. Keys are ISO 639-3 codes
+// datasetVersion identifies data the lookup tables were generated from
+var datasetVersion = "545dd92dd535"
+
+// LanguagesPart3 lookup table. Keys are ISO 639-3 codes.
+// Not declared with iso639_blob build tag, use LanguageTables to get it regardless of build tags
 var LanguagesPart3 = map[string]Language{
 	"aaa": {Part3: "aaa", Scope: 'I', LanguageType: 'L', Name: "Ghotuo"},
 	"aab": {Part3: "aab", Scope: 'I', LanguageType: 'L', Name: "Alumu-Tesu"},
 	"aac": {Part3: "aac", Scope: 'I', LanguageType: 'L', Name: "Ari"},
 	"aad": {Part3: "aad", Scope: 'I', LanguageType: 'L', Name: "Amal"},
-	"aae": {Part3: "aae", Scope: 'I', LanguageType: 'L', Name: "Arbëreshë Albanian"},
+	"aae": {Part3: "aae", Scope: 'I', LanguageType: 'L', Name: "Arbëreshë Albanian", MacrolanguageCode: "sqi"},
 	"aaf": {Part3: "aaf", Scope: 'I', LanguageType: 'L', Name: "Aranadan"},
 	"aag": {Part3: "aag", Scope: 'I', LanguageType: 'L', Name: "Ambrak"},
 	"aah": {Part3: "aah", Scope: 'I', LanguageType: 'L', Name: "Abu' Arapesh"},
@@ -14,12 +21,12 @@ var LanguagesPart3 = map[string]Language{
 	"aak": {Part3: "aak", Scope: 'I', LanguageType: 'L', Name: "Ankave"},
 	"aal": {Part3: "aal", Scope: 'I', LanguageType: 'L', Name: "Afade"},
 	"aan": {Part3: "aan", Scope: 'I', LanguageType: 'L', Name: "Anambé"},
-	"aao": {Part3: "aao", Scope: 'I', LanguageType: 'L', Name: "Algerian Saharan Arabic"},
+	"aao": {Part3: "aao", Scope: 'I', LanguageType: 'L', Name: "Algerian Saharan Arabic", MacrolanguageCode: "ara"},
 	"aap": {Part3: "aap", Scope: 'I', LanguageType: 'L', Name: "Pará Arára"},
 	"aaq": {Part3: "aaq", Scope: 'I', LanguageType: 'E', Name: "Eastern Abnaki"},
 	"aar": {Part3: "aar", Part2B: "aar", Part2T: "aar", Part1: "aa", Scope: 'I', LanguageType: 'L', Name: "Afar"},
 	"aas": {Part3: "aas", Scope: 'I', LanguageType: 'L', Name: "Aasáx"},
-	"aat": {Part3: "aat", Scope: 'I', LanguageType: 'L', Name: "Arvanitika Albanian"},
+	"aat": {Part3: "aat", Scope: 'I', LanguageType: 'L', Name: "Arvanitika Albanian", MacrolanguageCode: "sqi"},
 	"aau": {Part3: "aau", Scope: 'I', LanguageType: 'L', Name: "Abau"},
 	"aaw": {Part3: "aaw", Scope: 'I', LanguageType: 'L', Name: "Solong"},
 	"aax": {Part3: "aax", Scope: 'I', LanguageType: 'L', Name: "Mandobo Atas"},
@@ -31,7 +38,7 @@ var LanguagesPart3 = map[string]Language{
 	"abe": {Part3: "abe", Scope: 'I', LanguageType: 'L', Name: "Western Abnaki"},
 	"abf": {Part3: "abf", Scope: 'I', LanguageType: 'L', Name: "Abai Sungai"},
 	"abg": {Part3: "abg", Scope: 'I', LanguageType: 'L', Name: "Abaga"},
-	"abh": {Part3: "abh", Scope: 'I', LanguageType: 'L', Name: "Tajiki Arabic"},
+	"abh": {Part3: "abh", Scope: 'I', LanguageType: 'L', Name: "Tajiki Arabic", MacrolanguageCode: "ara"},
 	"abi": {Part3: "abi", Scope: 'I', LanguageType: 'L', Name: "Abidji"},
 	"abj": {Part3: "abj", Scope: 'I', LanguageType: 'E', Name: "Aka-Bea"},
 	"abk": {Part3: "abk", Part2B: "abk", Part2T: "abk", Part1: "ab", Scope: 'I', LanguageType: 'L', Name: "Abkhazian"},
@@ -45,7 +52,7 @@ var LanguagesPart3 = map[string]Language{
 	"abs": {Part3: "abs", Scope: 'I', LanguageType: 'L', Name: "Ambonese Malay"},
 	"abt": {Part3: "abt", Scope: 'I', LanguageType: 'L', Name: "Ambulas"},
 	"abu": {Part3: "abu", Scope: 'I', LanguageType: 'L', Name: "Abure"},
-	"abv": {Part3: "abv", Scope: 'I', LanguageType: 'L', Name: "Baharna Arabic"},
+	"abv": {Part3: "abv", Scope: 'I', LanguageType: 'L', Name: "Baharna Arabic", MacrolanguageCode: "ara"},
 	"abw": {Part3: "abw", Scope: 'I', LanguageType: 'L', Name: "Pal"},
 	"abx": {Part3: "abx", Scope: 'I', LanguageType: 'L', Name: "Inabaknon"},
 	"aby": {Part3: "aby", Scope: 'I', LanguageType: 'L', Name: "Aneme Wake"},
@@ -59,24 +66,24 @@ var LanguagesPart3 = map[string]Language{
 	"aci": {Part3: "aci", Scope: 'I', LanguageType: 'E', Name: "Aka-Cari"},
 	"ack": {Part3: "ack", Scope: 'I', LanguageType: 'E', Name: "Aka-Kora"},
 	"acl": {Part3: "acl", Scope: 'I', LanguageType: 'E', Name: "Akar-Bale"},
-	"acm": {Part3: "acm", Scope: 'I', LanguageType: 'L', Name: "Mesopotamian Arabic"},
+	"acm": {Part3: "acm", Scope: 'I', LanguageType: 'L', Name: "Mesopotamian Arabic", MacrolanguageCode: "ara"},
 	"acn": {Part3: "acn", Scope: 'I', LanguageType: 'L', Name: "Achang"},
 	"acp": {Part3: "acp", Scope: 'I', LanguageType: 'L', Name: "Eastern Acipa"},
-	"acq": {Part3: "acq", Scope: 'I', LanguageType: 'L', Name: "Ta'izzi-Adeni Arabic"},
+	"acq": {Part3: "acq", Scope: 'I', LanguageType: 'L', Name: "Ta'izzi-Adeni Arabic", MacrolanguageCode: "ara"},
 	"acr": {Part3: "acr", Scope: 'I', LanguageType: 'L', Name: "Achi"},
 	"acs": {Part3: "acs", Scope: 'I', LanguageType: 'E', Name: "Acroá"},
 	"act": {Part3: "act", Scope: 'I', LanguageType: 'L', Name: "Achterhoeks"},
 	"acu": {Part3: "acu", Scope: 'I', LanguageType: 'L', Name: "Achuar-Shiwiar"},
 	"acv": {Part3: "acv", Scope: 'I', LanguageType: 'L', Name: "Achumawi"},
-	"acw": {Part3: "acw", Scope: 'I', LanguageType: 'L', Name: "Hijazi Arabic"},
-	"acx": {Part3: "acx", Scope: 'I', LanguageType: 'L', Name: "Omani Arabic"},
-	"acy": {Part3: "acy", Scope: 'I', LanguageType: 'L', Name: "Cypriot Arabic"},
+	"acw": {Part3: "acw", Scope: 'I', LanguageType: 'L', Name: "Hijazi Arabic", MacrolanguageCode: "ara"},
+	"acx": {Part3: "acx", Scope: 'I', LanguageType: 'L', Name: "Omani Arabic", MacrolanguageCode: "ara"},
+	"acy": {Part3: "acy", Scope: 'I', LanguageType: 'L', Name: "Cypriot Arabic", MacrolanguageCode: "ara"},
 	"acz": {Part3: "acz", Scope: 'I', LanguageType: 'L', Name: "Acheron"},
 	"ada": {Part3: "ada", Part2B: "ada", Part2T: "ada", Scope: 'I', LanguageType: 'L', Name: "Adangme"},
 	"adb": {Part3: "adb", Scope: 'I', LanguageType: 'L', Name: "Atauran"},
 	"add": {Part3: "add", Scope: 'I', LanguageType: 'L', Name: "Lidzonka"},
 	"ade": {Part3: "ade", Scope: 'I', LanguageType: 'L', Name: "Adele"},
-	"adf": {Part3: "adf", Scope: 'I', LanguageType: 'L', Name: "Dhofari Arabic"},
+	"adf": {Part3: "adf", Scope: 'I', LanguageType: 'L', Name: "Dhofari Arabic", MacrolanguageCode: "ara"},
 	"adg": {Part3: "adg", Scope: 'I', LanguageType: 'L', Name: "Andegerebinha"},
 	"adh": {Part3: "adh", Scope: 'I', LanguageType: 'L', Name: "Adhola"},
 	"adi": {Part3: "adi", Scope: 'I', LanguageType: 'L', Name: "Adi"},
@@ -94,8 +101,8 @@ var LanguagesPart3 = map[string]Language{
 	"ady": {Part3: "ady", Part2B: "ady", Part2T: "ady", Scope: 'I', LanguageType: 'L', Name: "Adyghe"},
 	"adz": {Part3: "adz", Scope: 'I', LanguageType: 'L', Name: "Adzera"},
 	"aea": {Part3: "aea", Scope: 'I', LanguageType: 'E', Name: "Areba"},
-	"aeb": {Part3: "aeb", Scope: 'I', LanguageType: 'L', Name: "Tunisian Arabic"},
-	"aec": {Part3: "aec", Scope: 'I', LanguageType: 'L', Name: "Saidi Arabic"},
+	"aeb": {Part3: "aeb", Scope: 'I', LanguageType: 'L', Name: "Tunisian Arabic", MacrolanguageCode: "ara"},
+	"aec": {Part3: "aec", Scope: 'I', LanguageType: 'L', Name: "Saidi Arabic", MacrolanguageCode: "ara"},
 	"aed": {Part3: "aed", Scope: 'I', LanguageType: 'L', Name: "Argentine Sign Language"},
 	"aee": {Part3: "aee", Scope: 'I', LanguageType: 'L', Name: "Northeast Pashai"},
 	"aek": {Part3: "aek", Scope: 'I', LanguageType: 'L', Name: "Haeke"},
@@ -109,7 +116,7 @@ var LanguagesPart3 = map[string]Language{
 	"aew": {Part3: "aew", Scope: 'I', LanguageType: 'L', Name: "Ambakich"},
 	"aey": {Part3: "aey", Scope: 'I', LanguageType: 'L', Name: "Amele"},
 	"aez": {Part3: "aez", Scope: 'I', LanguageType: 'L', Name: "Aeka"},
-	"afb": {Part3: "afb", Scope: 'I', LanguageType: 'L', Name: "Gulf Arabic"},
+	"afb": {Part3: "afb", Scope: 'I', LanguageType: 'L', Name: "Gulf Arabic", MacrolanguageCode: "ara"},
 	"afd": {Part3: "afd", Scope: 'I', LanguageType: 'L', Name: "Andai"},
 	"afe": {Part3: "afe", Scope: 'I', LanguageType: 'L', Name: "Putukwam"},
 	"afg": {Part3: "afg", Scope: 'I', LanguageType: 'L', Name: "Afghan Sign Language"},
@@ -171,7 +178,7 @@ var LanguagesPart3 = map[string]Language{
 	"aif": {Part3: "aif", Scope: 'I', LanguageType: 'L', Name: "Agi"},
 	"aig": {Part3: "aig", Scope: 'I', LanguageType: 'L', Name: "Antigua and Barbuda Creole English"},
 	"aih": {Part3: "aih", Scope: 'I', LanguageType: 'L', Name: "Ai-Cham"},
-	"aii": {Part3: "aii", Scope: 'I', LanguageType: 'L', Name: "Assyrian Neo-Aramaic"},
+	"aii": {Part3: "aii", Scope: 'I', LanguageType: 'L', Name: "Assyrian Neo-Aramaic", MacrolanguageCode: "syr"},
 	"aij": {Part3: "aij", Scope: 'I', LanguageType: 'L', Name: "Lishanid Noshan"},
 	"aik": {Part3: "aik", Scope: 'I', LanguageType: 'L', Name: "Ake"},
 	"ail": {Part3: "ail", Scope: 'I', LanguageType: 'L', Name: "Aimele"},
@@ -190,8 +197,8 @@ var LanguagesPart3 = map[string]Language{
 	"aji": {Part3: "aji", Scope: 'I', LanguageType: 'L', Name: "Ajië"},
 	"ajn": {Part3: "ajn", Scope: 'I', LanguageType: 'L', Name: "Andajin"},
 	"ajp": {Part3: "ajp", Scope: 'I', LanguageType: 'L', Name: "South Levantine Arabic"},
-	"ajt": {Part3: "ajt", Scope: 'I', LanguageType: 'L', Name: "Judeo-Tunisian Arabic"},
-	"aju": {Part3: "aju", Scope: 'I', LanguageType: 'L', Name: "Judeo-Moroccan Arabic"},
+	"ajt": {Part3: "ajt", Scope: 'I', LanguageType: 'L', Name: "Judeo-Tunisian Arabic", MacrolanguageCode: "jrb"},
+	"aju": {Part3: "aju", Scope: 'I', LanguageType: 'L', Name: "Judeo-Moroccan Arabic", MacrolanguageCode: "jrb"},
 	"ajw": {Part3: "ajw", Scope: 'I', LanguageType: 'E', Name: "Ajawa"},
 	"ajz": {Part3: "ajz", Scope: 'I', LanguageType: 'L', Name: "Amri Karbi"},
 	"aka": {Part3: "aka", Part2B: "aka", Part2T: "aka", Part1: "ak", Scope: 'M', LanguageType: 'L', Name: "Akan"},
@@ -230,12 +237,12 @@ var LanguagesPart3 = map[string]Language{
 	"alk": {Part3: "alk", Scope: 'I', LanguageType: 'L', Name: "Alak"},
 	"all": {Part3: "all", Scope: 'I', LanguageType: 'L', Name: "Allar"},
 	"alm": {Part3: "alm", Scope: 'I', LanguageType: 'L', Name: "Amblong"},
-	"aln": {Part3: "aln", Scope: 'I', LanguageType: 'L', Name: "Gheg Albanian"},
+	"aln": {Part3: "aln", Scope: 'I', LanguageType: 'L', Name: "Gheg Albanian", MacrolanguageCode: "sqi"},
 	"alo": {Part3: "alo", Scope: 'I', LanguageType: 'L', Name: "Larike-Wakasihu"},
 	"alp": {Part3: "alp", Scope: 'I', LanguageType: 'L', Name: "Alune"},
 	"alq": {Part3: "alq", Scope: 'I', LanguageType: 'L', Name: "Algonquin"},
 	"alr": {Part3: "alr", Scope: 'I', LanguageType: 'L', Name: "Alutor"},
-	"als": {Part3: "als", Scope: 'I', LanguageType: 'L', Name: "Tosk Albanian"},
+	"als": {Part3: "als", Scope: 'I', LanguageType: 'L', Name: "Tosk Albanian", MacrolanguageCode: "sqi"},
 	"alt": {Part3: "alt", Part2B: "alt", Part2T: "alt", Scope: 'I', LanguageType: 'L', Name: "Southern Altai"},
 	"alu": {Part3: "alu", Scope: 'I', LanguageType: 'L', Name: "'Are'are"},
 	"alw": {Part3: "alw", Scope: 'I', LanguageType: 'L', Name: "Alaba-K’abeena"},
@@ -313,8 +320,8 @@ var LanguagesPart3 = map[string]Language{
 	"aox": {Part3: "aox", Scope: 'I', LanguageType: 'L', Name: "Atorada"},
 	"aoz": {Part3: "aoz", Scope: 'I', LanguageType: 'L', Name: "Uab Meto"},
 	"apb": {Part3: "apb", Scope: 'I', LanguageType: 'L', Name: "Sa'a"},
-	"apc": {Part3: "apc", Scope: 'I', LanguageType: 'L', Name: "North Levantine Arabic"},
-	"apd": {Part3: "apd", Scope: 'I', LanguageType: 'L', Name: "Sudanese Arabic"},
+	"apc": {Part3: "apc", Scope: 'I', LanguageType: 'L', Name: "North Levantine Arabic", MacrolanguageCode: "ara"},
+	"apd": {Part3: "apd", Scope: 'I', LanguageType: 'L', Name: "Sudanese Arabic", MacrolanguageCode: "ara"},
 	"ape": {Part3: "ape", Scope: 'I', LanguageType: 'L', Name: "Bukiyip"},
 	"apf": {Part3: "apf", Scope: 'I', LanguageType: 'L', Name: "Pahanan Agta"},
 	"apg": {Part3: "apg", Scope: 'I', LanguageType: 'L', Name: "Ampanang"},
@@ -348,7 +355,7 @@ var LanguagesPart3 = map[string]Language{
 	"aqt": {Part3: "aqt", Scope: 'I', LanguageType: 'L', Name: "Angaité"},
 	"aqz": {Part3: "aqz", Scope: 'I', LanguageType: 'L', Name: "Akuntsu"},
 	"ara": {Part3: "ara", Part2B: "ara", Part2T: "ara", Part1: "ar", Scope: 'M', LanguageType: 'L', Name: "Arabic"},
-	"arb": {Part3: "arb", Scope: 'I', LanguageType: 'L', Name: "Standard Arabic"},
+	"arb": {Part3: "arb", Scope: 'I', LanguageType: 'L', Name: "Standard Arabic", MacrolanguageCode: "ara"},
 	"arc": {Part3: "arc", Part2B: "arc", Part2T: "arc", Scope: 'I', LanguageType: 'A', Name: "Official Aramaic (700-300 BCE)"},
 	"ard": {Part3: "ard", Scope: 'I', LanguageType: 'E', Name: "Arabana"},
 	"are": {Part3: "are", Scope: 'I', LanguageType: 'L', Name: "Western Arrarnta"},
@@ -361,15 +368,15 @@ var LanguagesPart3 = map[string]Language{
 	"arn": {Part3: "arn", Part2B: "arn", Part2T: "arn", Scope: 'I', LanguageType: 'L', Name: "Mapudungun"},
 	"aro": {Part3: "aro", Scope: 'I', LanguageType: 'L', Name: "Araona"},
 	"arp": {Part3: "arp", Part2B: "arp", Part2T: "arp", Scope: 'I', LanguageType: 'L', Name: "Arapaho"},
-	"arq": {Part3: "arq", Scope: 'I', LanguageType: 'L', Name: "Algerian Arabic"},
+	"arq": {Part3: "arq", Scope: 'I', LanguageType: 'L', Name: "Algerian Arabic", MacrolanguageCode: "ara"},
 	"arr": {Part3: "arr", Scope: 'I', LanguageType: 'L', Name: "Karo (Brazil)"},
-	"ars": {Part3: "ars", Scope: 'I', LanguageType: 'L', Name: "Najdi Arabic"},
+	"ars": {Part3: "ars", Scope: 'I', LanguageType: 'L', Name: "Najdi Arabic", MacrolanguageCode: "ara"},
 	"aru": {Part3: "aru", Scope: 'I', LanguageType: 'E', Name: "Aruá (Amazonas State)"},
 	"arv": {Part3: "arv", Scope: 'I', LanguageType: 'L', Name: "Arbore"},
 	"arw": {Part3: "arw", Part2B: "arw", Part2T: "arw", Scope: 'I', LanguageType: 'L', Name: "Arawak"},
 	"arx": {Part3: "arx", Scope: 'I', LanguageType: 'L', Name: "Aruá (Rodonia State)"},
-	"ary": {Part3: "ary", Scope: 'I', LanguageType: 'L', Name: "Moroccan Arabic"},
-	"arz": {Part3: "arz", Scope: 'I', LanguageType: 'L', Name: "Egyptian Arabic"},
+	"ary": {Part3: "ary", Scope: 'I', LanguageType: 'L', Name: "Moroccan Arabic", MacrolanguageCode: "ara"},
+	"arz": {Part3: "arz", Scope: 'I', LanguageType: 'L', Name: "Egyptian Arabic", MacrolanguageCode: "ara"},
 	"asa": {Part3: "asa", Scope: 'I', LanguageType: 'L', Name: "Asu (Tanzania)"},
 	"asb": {Part3: "asb", Scope: 'I', LanguageType: 'L', Name: "Assiniboine"},
 	"asc": {Part3: "asc", Scope: 'I', LanguageType: 'L', Name: "Casuarina Coast Asmat"},
@@ -440,14 +447,14 @@ var LanguagesPart3 = map[string]Language{
 	"auw": {Part3: "auw", Scope: 'I', LanguageType: 'L', Name: "Awyi"},
 	"aux": {Part3: "aux", Scope: 'I', LanguageType: 'E', Name: "Aurá"},
 	"auy": {Part3: "auy", Scope: 'I', LanguageType: 'L', Name: "Awiyaana"},
-	"auz": {Part3: "auz", Scope: 'I', LanguageType: 'L', Name: "Uzbeki Arabic"},
+	"auz": {Part3: "auz", Scope: 'I', LanguageType: 'L', Name: "Uzbeki Arabic", MacrolanguageCode: "ara"},
 	"ava": {Part3: "ava", Part2B: "ava", Part2T: "ava", Part1: "av", Scope: 'I', LanguageType: 'L', Name: "Avaric"},
 	"avb": {Part3: "avb", Scope: 'I', LanguageType: 'L', Name: "Avau"},
 	"avd": {Part3: "avd", Scope: 'I', LanguageType: 'L', Name: "Alviri-Vidari"},
 	"ave": {Part3: "ave", Part2B: "ave", Part2T: "ave", Part1: "ae", Scope: 'I', LanguageType: 'A', Name: "Avestan"},
 	"avi": {Part3: "avi", Scope: 'I', LanguageType: 'L', Name: "Avikam"},
 	"avk": {Part3: "avk", Scope: 'I', LanguageType: 'C', Name: "Kotava"},
-	"avl": {Part3: "avl", Scope: 'I', LanguageType: 'L', Name: "Eastern Egyptian Bedawi Arabic"},
+	"avl": {Part3: "avl", Scope: 'I', LanguageType: 'L', Name: "Eastern Egyptian Bedawi Arabic", MacrolanguageCode: "ara"},
 	"avm": {Part3: "avm", Scope: 'I', LanguageType: 'E', Name: "Angkamuthi"},
 	"avn": {Part3: "avn", Scope: 'I', LanguageType: 'L', Name: "Avatime"},
 	"avo": {Part3: "avo", Scope: 'I', LanguageType: 'E', Name: "Agavotaguerra"},
@@ -483,30 +490,30 @@ var LanguagesPart3 = map[string]Language{
 	"axx": {Part3: "axx", Scope: 'I', LanguageType: 'L', Name: "Xârâgurè"},
 	"aya": {Part3: "aya", Scope: 'I', LanguageType: 'L', Name: "Awar"},
 	"ayb": {Part3: "ayb", Scope: 'I', LanguageType: 'L', Name: "Ayizo Gbe"},
-	"ayc": {Part3: "ayc", Scope: 'I', LanguageType: 'L', Name: "Southern Aymara"},
+	"ayc": {Part3: "ayc", Scope: 'I', LanguageType: 'L', Name: "Southern Aymara", MacrolanguageCode: "aym"},
 	"ayd": {Part3: "ayd", Scope: 'I', LanguageType: 'E', Name: "Ayabadhu"},
 	"aye": {Part3: "aye", Scope: 'I', LanguageType: 'L', Name: "Ayere"},
 	"ayg": {Part3: "ayg", Scope: 'I', LanguageType: 'L', Name: "Ginyanga"},
-	"ayh": {Part3: "ayh", Scope: 'I', LanguageType: 'L', Name: "Hadrami Arabic"},
+	"ayh": {Part3: "ayh", Scope: 'I', LanguageType: 'L', Name: "Hadrami Arabic", MacrolanguageCode: "ara"},
 	"ayi": {Part3: "ayi", Scope: 'I', LanguageType: 'L', Name: "Leyigha"},
 	"ayk": {Part3: "ayk", Scope: 'I', LanguageType: 'L', Name: "Akuku"},
-	"ayl": {Part3: "ayl", Scope: 'I', LanguageType: 'L', Name: "Libyan Arabic"},
+	"ayl": {Part3: "ayl", Scope: 'I', LanguageType: 'L', Name: "Libyan Arabic", MacrolanguageCode: "ara"},
 	"aym": {Part3: "aym", Part2B: "aym", Part2T: "aym", Part1: "ay", Scope: 'M', LanguageType: 'L', Name: "Aymara"},
-	"ayn": {Part3: "ayn", Scope: 'I', LanguageType: 'L', Name: "Sanaani Arabic"},
+	"ayn": {Part3: "ayn", Scope: 'I', LanguageType: 'L', Name: "Sanaani Arabic", MacrolanguageCode: "ara"},
 	"ayo": {Part3: "ayo", Scope: 'I', LanguageType: 'L', Name: "Ayoreo"},
-	"ayp": {Part3: "ayp", Scope: 'I', LanguageType: 'L', Name: "North Mesopotamian Arabic"},
+	"ayp": {Part3: "ayp", Scope: 'I', LanguageType: 'L', Name: "North Mesopotamian Arabic", MacrolanguageCode: "ara"},
 	"ayq": {Part3: "ayq", Scope: 'I', LanguageType: 'L', Name: "Ayi (Papua New Guinea)"},
-	"ayr": {Part3: "ayr", Scope: 'I', LanguageType: 'L', Name: "Central Aymara"},
+	"ayr": {Part3: "ayr", Scope: 'I', LanguageType: 'L', Name: "Central Aymara", MacrolanguageCode: "aym"},
 	"ays": {Part3: "ays", Scope: 'I', LanguageType: 'L', Name: "Sorsogon Ayta"},
 	"ayt": {Part3: "ayt", Scope: 'I', LanguageType: 'L', Name: "Magbukun Ayta"},
 	"ayu": {Part3: "ayu", Scope: 'I', LanguageType: 'L', Name: "Ayu"},
 	"ayz": {Part3: "ayz", Scope: 'I', LanguageType: 'L', Name: "Mai Brat"},
 	"aza": {Part3: "aza", Scope: 'I', LanguageType: 'L', Name: "Azha"},
-	"azb": {Part3: "azb", Scope: 'I', LanguageType: 'L', Name: "South Azerbaijani"},
+	"azb": {Part3: "azb", Scope: 'I', LanguageType: 'L', Name: "South Azerbaijani", MacrolanguageCode: "aze"},
 	"azd": {Part3: "azd", Scope: 'I', LanguageType: 'L', Name: "Eastern Durango Nahuatl"},
 	"aze": {Part3: "aze", Part2B: "aze", Part2T: "aze", Part1: "az", Scope: 'M', LanguageType: 'L', Name: "Azerbaijani"},
 	"azg": {Part3: "azg", Scope: 'I', LanguageType: 'L', Name: "San Pedro Amuzgos Amuzgo"},
-	"azj": {Part3: "azj", Scope: 'I', LanguageType: 'L', Name: "North Azerbaijani"},
+	"azj": {Part3: "azj", Scope: 'I', LanguageType: 'L', Name: "North Azerbaijani", MacrolanguageCode: "aze"},
 	"azm": {Part3: "azm", Scope: 'I', LanguageType: 'L', Name: "Ipalapa Amuzgo"},
 	"azn": {Part3: "azn", Scope: 'I', LanguageType: 'L', Name: "Western Durango Nahuatl"},
 	"azo": {Part3: "azo", Scope: 'I', LanguageType: 'L', Name: "Awing"},
@@ -560,7 +567,7 @@ var LanguagesPart3 = map[string]Language{
 	"bby": {Part3: "bby", Scope: 'I', LanguageType: 'L', Name: "Befang"},
 	"bca": {Part3: "bca", Scope: 'I', LanguageType: 'L', Name: "Central Bai"},
 	"bcb": {Part3: "bcb", Scope: 'I', LanguageType: 'L', Name: "Bainouk-Samik"},
-	"bcc": {Part3: "bcc", Scope: 'I', LanguageType: 'L', Name: "Southern Balochi"},
+	"bcc": {Part3: "bcc", Scope: 'I', LanguageType: 'L', Name: "Southern Balochi", MacrolanguageCode: "bal"},
 	"bcd": {Part3: "bcd", Scope: 'I', LanguageType: 'L', Name: "North Babar"},
 	"bce": {Part3: "bce", Scope: 'I', LanguageType: 'L', Name: "Bamenyam"},
 	"bcf": {Part3: "bcf", Scope: 'I', LanguageType: 'L', Name: "Bamu"},
@@ -569,7 +576,7 @@ var LanguagesPart3 = map[string]Language{
 	"bci": {Part3: "bci", Scope: 'I', LanguageType: 'L', Name: "Baoulé"},
 	"bcj": {Part3: "bcj", Scope: 'I', LanguageType: 'L', Name: "Bardi"},
 	"bck": {Part3: "bck", Scope: 'I', LanguageType: 'L', Name: "Bunuba"},
-	"bcl": {Part3: "bcl", Scope: 'I', LanguageType: 'L', Name: "Central Bikol"},
+	"bcl": {Part3: "bcl", Scope: 'I', LanguageType: 'L', Name: "Central Bikol", MacrolanguageCode: "bik"},
 	"bcm": {Part3: "bcm", Scope: 'I', LanguageType: 'L', Name: "Bannoni"},
 	"bcn": {Part3: "bcn", Scope: 'I', LanguageType: 'L', Name: "Bali (Nigeria)"},
 	"bco": {Part3: "bco", Scope: 'I', LanguageType: 'L', Name: "Kaluli"},
@@ -602,7 +609,7 @@ var LanguagesPart3 = map[string]Language{
 	"bdq": {Part3: "bdq", Scope: 'I', LanguageType: 'L', Name: "Bahnar"},
 	"bdr": {Part3: "bdr", Scope: 'I', LanguageType: 'L', Name: "West Coast Bajau"},
 	"bds": {Part3: "bds", Scope: 'I', LanguageType: 'L', Name: "Burunge"},
-	"bdt": {Part3: "bdt", Scope: 'I', LanguageType: 'L', Name: "Bokoto"},
+	"bdt": {Part3: "bdt", Scope: 'I', LanguageType: 'L', Name: "Bokoto", MacrolanguageCode: "gba"},
 	"bdu": {Part3: "bdu", Scope: 'I', LanguageType: 'L', Name: "Oroko"},
 	"bdv": {Part3: "bdv", Scope: 'I', LanguageType: 'L', Name: "Bodo Parja"},
 	"bdw": {Part3: "bdw", Scope: 'I', LanguageType: 'L', Name: "Baham"},
@@ -670,10 +677,10 @@ var LanguagesPart3 = map[string]Language{
 	"bgj": {Part3: "bgj", Scope: 'I', LanguageType: 'L', Name: "Bangolan"},
 	"bgk": {Part3: "bgk", Scope: 'I', LanguageType: 'L', Name: "Bit"},
 	"bgl": {Part3: "bgl", Scope: 'I', LanguageType: 'L', Name: "Bo (Laos)"},
-	"bgn": {Part3: "bgn", Scope: 'I', LanguageType: 'L', Name: "Western Balochi"},
+	"bgn": {Part3: "bgn", Scope: 'I', LanguageType: 'L', Name: "Western Balochi", MacrolanguageCode: "bal"},
 	"bgo": {Part3: "bgo", Scope: 'I', LanguageType: 'L', Name: "Baga Koga"},
-	"bgp": {Part3: "bgp", Scope: 'I', LanguageType: 'L', Name: "Eastern Balochi"},
-	"bgq": {Part3: "bgq", Scope: 'I', LanguageType: 'L', Name: "Bagri"},
+	"bgp": {Part3: "bgp", Scope: 'I', LanguageType: 'L', Name: "Eastern Balochi", MacrolanguageCode: "bal"},
+	"bgq": {Part3: "bgq", Scope: 'I', LanguageType: 'L', Name: "Bagri", MacrolanguageCode: "raj"},
 	"bgr": {Part3: "bgr", Scope: 'I', LanguageType: 'L', Name: "Bawm Chin"},
 	"bgs": {Part3: "bgs", Scope: 'I', LanguageType: 'L', Name: "Tagabawa"},
 	"bgt": {Part3: "bgt", Scope: 'I', LanguageType: 'L', Name: "Bughotu"},
@@ -699,7 +706,7 @@ var LanguagesPart3 = map[string]Language{
 	"bho": {Part3: "bho", Part2B: "bho", Part2T: "bho", Scope: 'I', LanguageType: 'L', Name: "Bhojpuri"},
 	"bhp": {Part3: "bhp", Scope: 'I', LanguageType: 'L', Name: "Bima"},
 	"bhq": {Part3: "bhq", Scope: 'I', LanguageType: 'L', Name: "Tukang Besi South"},
-	"bhr": {Part3: "bhr", Scope: 'I', LanguageType: 'L', Name: "Bara Malagasy"},
+	"bhr": {Part3: "bhr", Scope: 'I', LanguageType: 'L', Name: "Bara Malagasy", MacrolanguageCode: "mlg"},
 	"bhs": {Part3: "bhs", Scope: 'I', LanguageType: 'L', Name: "Buwal"},
 	"bht": {Part3: "bht", Scope: 'I', LanguageType: 'L', Name: "Bhattiyali"},
 	"bhu": {Part3: "bhu", Scope: 'I', LanguageType: 'L', Name: "Bhunjia"},
@@ -742,7 +749,7 @@ var LanguagesPart3 = map[string]Language{
 	"bjk": {Part3: "bjk", Scope: 'I', LanguageType: 'L', Name: "Barok"},
 	"bjl": {Part3: "bjl", Scope: 'I', LanguageType: 'L', Name: "Bulu (Papua New Guinea)"},
 	"bjm": {Part3: "bjm", Scope: 'I', LanguageType: 'L', Name: "Bajelani"},
-	"bjn": {Part3: "bjn", Scope: 'I', LanguageType: 'L', Name: "Banjar"},
+	"bjn": {Part3: "bjn", Scope: 'I', LanguageType: 'L', Name: "Banjar", MacrolanguageCode: "msa"},
 	"bjo": {Part3: "bjo", Scope: 'I', LanguageType: 'L', Name: "Mid-Southern Banda"},
 	"bjp": {Part3: "bjp", Scope: 'I', LanguageType: 'L', Name: "Fanamaket"},
 	"bjr": {Part3: "bjr", Scope: 'I', LanguageType: 'L', Name: "Binumarien"},
@@ -790,7 +797,7 @@ var LanguagesPart3 = map[string]Language{
 	"blk": {Part3: "blk", Scope: 'I', LanguageType: 'L', Name: "Pa'o Karen"},
 	"bll": {Part3: "bll", Scope: 'I', LanguageType: 'E', Name: "Biloxi"},
 	"blm": {Part3: "blm", Scope: 'I', LanguageType: 'L', Name: "Beli (South Sudan)"},
-	"bln": {Part3: "bln", Scope: 'I', LanguageType: 'L', Name: "Southern Catanduanes Bikol"},
+	"bln": {Part3: "bln", Scope: 'I', LanguageType: 'L', Name: "Southern Catanduanes Bikol", MacrolanguageCode: "bik"},
 	"blo": {Part3: "blo", Scope: 'I', LanguageType: 'L', Name: "Anii"},
 	"blp": {Part3: "blp", Scope: 'I', LanguageType: 'L', Name: "Blablanga"},
 	"blq": {Part3: "blq", Scope: 'I', LanguageType: 'L', Name: "Baluan-Pam"},
@@ -814,7 +821,7 @@ var LanguagesPart3 = map[string]Language{
 	"bmj": {Part3: "bmj", Scope: 'I', LanguageType: 'L', Name: "Bote-Majhi"},
 	"bmk": {Part3: "bmk", Scope: 'I', LanguageType: 'L', Name: "Ghayavi"},
 	"bml": {Part3: "bml", Scope: 'I', LanguageType: 'L', Name: "Bomboli"},
-	"bmm": {Part3: "bmm", Scope: 'I', LanguageType: 'L', Name: "Northern Betsimisaraka Malagasy"},
+	"bmm": {Part3: "bmm", Scope: 'I', LanguageType: 'L', Name: "Northern Betsimisaraka Malagasy", MacrolanguageCode: "mlg"},
 	"bmn": {Part3: "bmn", Scope: 'I', LanguageType: 'E', Name: "Bina (Papua New Guinea)"},
 	"bmo": {Part3: "bmo", Scope: 'I', LanguageType: 'L', Name: "Bambalang"},
 	"bmp": {Part3: "bmp", Scope: 'I', LanguageType: 'L', Name: "Bulgebi"},
@@ -868,7 +875,7 @@ var LanguagesPart3 = map[string]Language{
 	"bop": {Part3: "bop", Scope: 'I', LanguageType: 'L', Name: "Bonkiman"},
 	"boq": {Part3: "boq", Scope: 'I', LanguageType: 'L', Name: "Bogaya"},
 	"bor": {Part3: "bor", Scope: 'I', LanguageType: 'L', Name: "Borôro"},
-	"bos": {Part3: "bos", Part2B: "bos", Part2T: "bos", Part1: "bs", Scope: 'I', LanguageType: 'L', Name: "Bosnian"},
+	"bos": {Part3: "bos", Part2B: "bos", Part2T: "bos", Part1: "bs", Scope: 'I', LanguageType: 'L', Name: "Bosnian", MacrolanguageCode: "hbs"},
 	"bot": {Part3: "bot", Scope: 'I', LanguageType: 'L', Name: "Bongo"},
 	"bou": {Part3: "bou", Scope: 'I', LanguageType: 'L', Name: "Bondei"},
 	"bov": {Part3: "bov", Scope: 'I', LanguageType: 'L', Name: "Tuwuli"},
@@ -982,10 +989,10 @@ var LanguagesPart3 = map[string]Language{
 	"btg": {Part3: "btg", Scope: 'I', LanguageType: 'L', Name: "Gagnoa Bété"},
 	"bth": {Part3: "bth", Scope: 'I', LanguageType: 'L', Name: "Biatah Bidayuh"},
 	"bti": {Part3: "bti", Scope: 'I', LanguageType: 'L', Name: "Burate"},
-	"btj": {Part3: "btj", Scope: 'I', LanguageType: 'L', Name: "Bacanese Malay"},
+	"btj": {Part3: "btj", Scope: 'I', LanguageType: 'L', Name: "Bacanese Malay", MacrolanguageCode: "msa"},
 	"btm": {Part3: "btm", Scope: 'I', LanguageType: 'L', Name: "Batak Mandailing"},
 	"btn": {Part3: "btn", Scope: 'I', LanguageType: 'L', Name: "Ratagnon"},
-	"bto": {Part3: "bto", Scope: 'I', LanguageType: 'L', Name: "Rinconada Bikol"},
+	"bto": {Part3: "bto", Scope: 'I', LanguageType: 'L', Name: "Rinconada Bikol", MacrolanguageCode: "bik"},
 	"btp": {Part3: "btp", Scope: 'I', LanguageType: 'L', Name: "Budibud"},
 	"btq": {Part3: "btq", Scope: 'I', LanguageType: 'L', Name: "Batek"},
 	"btr": {Part3: "btr", Scope: 'I', LanguageType: 'L', Name: "Baetora"},
@@ -1026,7 +1033,7 @@ var LanguagesPart3 = map[string]Language{
 	"bvb": {Part3: "bvb", Scope: 'I', LanguageType: 'L', Name: "Bube"},
 	"bvc": {Part3: "bvc", Scope: 'I', LanguageType: 'L', Name: "Baelelea"},
 	"bvd": {Part3: "bvd", Scope: 'I', LanguageType: 'L', Name: "Baeggu"},
-	"bve": {Part3: "bve", Scope: 'I', LanguageType: 'L', Name: "Berau Malay"},
+	"bve": {Part3: "bve", Scope: 'I', LanguageType: 'L', Name: "Berau Malay", MacrolanguageCode: "msa"},
 	"bvf": {Part3: "bvf", Scope: 'I', LanguageType: 'L', Name: "Boor"},
 	"bvg": {Part3: "bvg", Scope: 'I', LanguageType: 'L', Name: "Bonkeng"},
 	"bvh": {Part3: "bvh", Scope: 'I', LanguageType: 'L', Name: "Bure"},
@@ -1041,7 +1048,7 @@ var LanguagesPart3 = map[string]Language{
 	"bvq": {Part3: "bvq", Scope: 'I', LanguageType: 'L', Name: "Birri"},
 	"bvr": {Part3: "bvr", Scope: 'I', LanguageType: 'L', Name: "Burarra"},
 	"bvt": {Part3: "bvt", Scope: 'I', LanguageType: 'L', Name: "Bati (Indonesia)"},
-	"bvu": {Part3: "bvu", Scope: 'I', LanguageType: 'L', Name: "Bukit Malay"},
+	"bvu": {Part3: "bvu", Scope: 'I', LanguageType: 'L', Name: "Bukit Malay", MacrolanguageCode: "msa"},
 	"bvv": {Part3: "bvv", Scope: 'I', LanguageType: 'E', Name: "Baniva"},
 	"bvw": {Part3: "bvw", Scope: 'I', LanguageType: 'L', Name: "Boga"},
 	"bvx": {Part3: "bvx", Scope: 'I', LanguageType: 'L', Name: "Dibole"},
@@ -1082,16 +1089,16 @@ var LanguagesPart3 = map[string]Language{
 	"bxh": {Part3: "bxh", Scope: 'I', LanguageType: 'L', Name: "Buhutu"},
 	"bxi": {Part3: "bxi", Scope: 'I', LanguageType: 'E', Name: "Pirlatapa"},
 	"bxj": {Part3: "bxj", Scope: 'I', LanguageType: 'L', Name: "Bayungu"},
-	"bxk": {Part3: "bxk", Scope: 'I', LanguageType: 'L', Name: "Bukusu"},
+	"bxk": {Part3: "bxk", Scope: 'I', LanguageType: 'L', Name: "Bukusu", MacrolanguageCode: "luy"},
 	"bxl": {Part3: "bxl", Scope: 'I', LanguageType: 'L', Name: "Jalkunan"},
-	"bxm": {Part3: "bxm", Scope: 'I', LanguageType: 'L', Name: "Mongolia Buriat"},
+	"bxm": {Part3: "bxm", Scope: 'I', LanguageType: 'L', Name: "Mongolia Buriat", MacrolanguageCode: "bua"},
 	"bxn": {Part3: "bxn", Scope: 'I', LanguageType: 'L', Name: "Burduna"},
 	"bxo": {Part3: "bxo", Scope: 'I', LanguageType: 'L', Name: "Barikanchi"},
 	"bxp": {Part3: "bxp", Scope: 'I', LanguageType: 'L', Name: "Bebil"},
 	"bxq": {Part3: "bxq", Scope: 'I', LanguageType: 'L', Name: "Beele"},
-	"bxr": {Part3: "bxr", Scope: 'I', LanguageType: 'L', Name: "Russia Buriat"},
+	"bxr": {Part3: "bxr", Scope: 'I', LanguageType: 'L', Name: "Russia Buriat", MacrolanguageCode: "bua"},
 	"bxs": {Part3: "bxs", Scope: 'I', LanguageType: 'L', Name: "Busam"},
-	"bxu": {Part3: "bxu", Scope: 'I', LanguageType: 'L', Name: "China Buriat"},
+	"bxu": {Part3: "bxu", Scope: 'I', LanguageType: 'L', Name: "China Buriat", MacrolanguageCode: "bua"},
 	"bxv": {Part3: "bxv", Scope: 'I', LanguageType: 'L', Name: "Berakou"},
 	"bxw": {Part3: "bxw", Scope: 'I', LanguageType: 'L', Name: "Bankagooma"},
 	"bxz": {Part3: "bxz", Scope: 'I', LanguageType: 'L', Name: "Binahari"},
@@ -1121,7 +1128,7 @@ var LanguagesPart3 = map[string]Language{
 	"byz": {Part3: "byz", Scope: 'I', LanguageType: 'L', Name: "Banaro"},
 	"bza": {Part3: "bza", Scope: 'I', LanguageType: 'L', Name: "Bandi"},
 	"bzb": {Part3: "bzb", Scope: 'I', LanguageType: 'L', Name: "Andio"},
-	"bzc": {Part3: "bzc", Scope: 'I', LanguageType: 'L', Name: "Southern Betsimisaraka Malagasy"},
+	"bzc": {Part3: "bzc", Scope: 'I', LanguageType: 'L', Name: "Southern Betsimisaraka Malagasy", MacrolanguageCode: "mlg"},
 	"bzd": {Part3: "bzd", Scope: 'I', LanguageType: 'L', Name: "Bribri"},
 	"bze": {Part3: "bze", Scope: 'I', LanguageType: 'L', Name: "Jenaama Bozo"},
 	"bzf": {Part3: "bzf", Scope: 'I', LanguageType: 'L', Name: "Boikin"},
@@ -1206,7 +1213,7 @@ var LanguagesPart3 = map[string]Language{
 	"cdj": {Part3: "cdj", Scope: 'I', LanguageType: 'L', Name: "Churahi"},
 	"cdm": {Part3: "cdm", Scope: 'I', LanguageType: 'L', Name: "Chepang"},
 	"cdn": {Part3: "cdn", Scope: 'I', LanguageType: 'L', Name: "Chaudangsi"},
-	"cdo": {Part3: "cdo", Scope: 'I', LanguageType: 'L', Name: "Min Dong Chinese"},
+	"cdo": {Part3: "cdo", Scope: 'I', LanguageType: 'L', Name: "Min Dong Chinese", MacrolanguageCode: "zho"},
 	"cdr": {Part3: "cdr", Scope: 'I', LanguageType: 'L', Name: "Cinda-Regi-Tiyal"},
 	"cds": {Part3: "cds", Scope: 'I', LanguageType: 'L', Name: "Chadian Sign Language"},
 	"cdy": {Part3: "cdy", Scope: 'I', LanguageType: 'L', Name: "Chadong"},
@@ -1262,7 +1269,7 @@ var LanguagesPart3 = map[string]Language{
 	"cin": {Part3: "cin", Scope: 'I', LanguageType: 'L', Name: "Cinta Larga"},
 	"cip": {Part3: "cip", Scope: 'I', LanguageType: 'L', Name: "Chiapanec"},
 	"cir": {Part3: "cir", Scope: 'I', LanguageType: 'L', Name: "Tiri"},
-	"ciw": {Part3: "ciw", Scope: 'I', LanguageType: 'L', Name: "Chippewa"},
+	"ciw": {Part3: "ciw", Scope: 'I', LanguageType: 'L', Name: "Chippewa", MacrolanguageCode: "oji"},
 	"ciy": {Part3: "ciy", Scope: 'I', LanguageType: 'L', Name: "Chaima"},
 	"cja": {Part3: "cja", Scope: 'I', LanguageType: 'L', Name: "Western Cham"},
 	"cje": {Part3: "cje", Scope: 'I', LanguageType: 'L', Name: "Chru"},
@@ -1275,8 +1282,8 @@ var LanguagesPart3 = map[string]Language{
 	"cjp": {Part3: "cjp", Scope: 'I', LanguageType: 'L', Name: "Cabécar"},
 	"cjs": {Part3: "cjs", Scope: 'I', LanguageType: 'L', Name: "Shor"},
 	"cjv": {Part3: "cjv", Scope: 'I', LanguageType: 'L', Name: "Chuave"},
-	"cjy": {Part3: "cjy", Scope: 'I', LanguageType: 'L', Name: "Jinyu Chinese"},
-	"ckb": {Part3: "ckb", Scope: 'I', LanguageType: 'L', Name: "Central Kurdish"},
+	"cjy": {Part3: "cjy", Scope: 'I', LanguageType: 'L', Name: "Jinyu Chinese", MacrolanguageCode: "zho"},
+	"ckb": {Part3: "ckb", Scope: 'I', LanguageType: 'L', Name: "Central Kurdish", MacrolanguageCode: "kur"},
 	"ckh": {Part3: "ckh", Scope: 'I', LanguageType: 'L', Name: "Chak"},
 	"ckl": {Part3: "ckl", Scope: 'I', LanguageType: 'L', Name: "Cibak"},
 	"ckm": {Part3: "ckm", Scope: 'I', LanguageType: 'L', Name: "Chakavian"},
@@ -1293,7 +1300,7 @@ var LanguagesPart3 = map[string]Language{
 	"ckz": {Part3: "ckz", Scope: 'I', LanguageType: 'L', Name: "Cakchiquel-Quiché Mixed Language"},
 	"cla": {Part3: "cla", Scope: 'I', LanguageType: 'L', Name: "Ron"},
 	"clc": {Part3: "clc", Scope: 'I', LanguageType: 'L', Name: "Chilcotin"},
-	"cld": {Part3: "cld", Scope: 'I', LanguageType: 'L', Name: "Chaldean Neo-Aramaic"},
+	"cld": {Part3: "cld", Scope: 'I', LanguageType: 'L', Name: "Chaldean Neo-Aramaic", MacrolanguageCode: "syr"},
 	"cle": {Part3: "cle", Scope: 'I', LanguageType: 'L', Name: "Lealao Chinantec"},
 	"clh": {Part3: "clh", Scope: 'I', LanguageType: 'L', Name: "Chilisso"},
 	"cli": {Part3: "cli", Scope: 'I', LanguageType: 'L', Name: "Chakali"},
@@ -1312,7 +1319,7 @@ var LanguagesPart3 = map[string]Language{
 	"cmi": {Part3: "cmi", Scope: 'I', LanguageType: 'L', Name: "Emberá-Chamí"},
 	"cml": {Part3: "cml", Scope: 'I', LanguageType: 'L', Name: "Campalagian"},
 	"cmm": {Part3: "cmm", Scope: 'I', LanguageType: 'E', Name: "Michigamea"},
-	"cmn": {Part3: "cmn", Scope: 'I', LanguageType: 'L', Name: "Mandarin Chinese"},
+	"cmn": {Part3: "cmn", Scope: 'I', LanguageType: 'L', Name: "Mandarin Chinese", MacrolanguageCode: "zho"},
 	"cmo": {Part3: "cmo", Scope: 'I', LanguageType: 'L', Name: "Central Mnong"},
 	"cmr": {Part3: "cmr", Scope: 'I', LanguageType: 'L', Name: "Mro-Khimi Chin"},
 	"cms": {Part3: "cms", Scope: 'I', LanguageType: 'A', Name: "Messapic"},
@@ -1326,14 +1333,14 @@ var LanguagesPart3 = map[string]Language{
 	"cnk": {Part3: "cnk", Scope: 'I', LanguageType: 'L', Name: "Khumi Chin"},
 	"cnl": {Part3: "cnl", Scope: 'I', LanguageType: 'L', Name: "Lalana Chinantec"},
 	"cno": {Part3: "cno", Scope: 'I', LanguageType: 'L', Name: "Con"},
-	"cnp": {Part3: "cnp", Scope: 'I', LanguageType: 'L', Name: "Northern Ping Chinese"},
-	"cnr": {Part3: "cnr", Part2B: "cnr", Part2T: "cnr", Scope: 'I', LanguageType: 'L', Name: "Montenegrin"},
+	"cnp": {Part3: "cnp", Scope: 'I', LanguageType: 'L', Name: "Northern Ping Chinese", MacrolanguageCode: "zho"},
+	"cnr": {Part3: "cnr", Part2B: "cnr", Part2T: "cnr", Scope: 'I', LanguageType: 'L', Name: "Montenegrin", MacrolanguageCode: "hbs"},
 	"cns": {Part3: "cns", Scope: 'I', LanguageType: 'L', Name: "Central Asmat"},
 	"cnt": {Part3: "cnt", Scope: 'I', LanguageType: 'L', Name: "Tepetotutla Chinantec"},
 	"cnu": {Part3: "cnu", Scope: 'I', LanguageType: 'L', Name: "Chenoua"},
 	"cnw": {Part3: "cnw", Scope: 'I', LanguageType: 'L', Name: "Ngawn Chin"},
 	"cnx": {Part3: "cnx", Scope: 'I', LanguageType: 'H', Name: "Middle Cornish"},
-	"coa": {Part3: "coa", Scope: 'I', LanguageType: 'L', Name: "Cocos Islands Malay"},
+	"coa": {Part3: "coa", Scope: 'I', LanguageType: 'L', Name: "Cocos Islands Malay", MacrolanguageCode: "msa"},
 	"cob": {Part3: "cob", Scope: 'I', LanguageType: 'E', Name: "Chicomuceltec"},
 	"coc": {Part3: "coc", Scope: 'I', LanguageType: 'L', Name: "Cocopa"},
 	"cod": {Part3: "cod", Scope: 'I', LanguageType: 'L', Name: "Cocama-Cocamilla"},
@@ -1366,9 +1373,9 @@ var LanguagesPart3 = map[string]Language{
 	"cpo": {Part3: "cpo", Scope: 'I', LanguageType: 'L', Name: "Kpeego"},
 	"cps": {Part3: "cps", Scope: 'I', LanguageType: 'L', Name: "Capiznon"},
 	"cpu": {Part3: "cpu", Scope: 'I', LanguageType: 'L', Name: "Pichis Ashéninka"},
-	"cpx": {Part3: "cpx", Scope: 'I', LanguageType: 'L', Name: "Pu-Xian Chinese"},
+	"cpx": {Part3: "cpx", Scope: 'I', LanguageType: 'L', Name: "Pu-Xian Chinese", MacrolanguageCode: "zho"},
 	"cpy": {Part3: "cpy", Scope: 'I', LanguageType: 'L', Name: "South Ucayali Ashéninka"},
-	"cqd": {Part3: "cqd", Scope: 'I', LanguageType: 'L', Name: "Chuanqiandian Cluster Miao"},
+	"cqd": {Part3: "cqd", Scope: 'I', LanguageType: 'L', Name: "Chuanqiandian Cluster Miao", MacrolanguageCode: "hmn"},
 	"cra": {Part3: "cra", Scope: 'I', LanguageType: 'L', Name: "Chara"},
 	"crb": {Part3: "crb", Scope: 'I', LanguageType: 'E', Name: "Island Carib"},
 	"crc": {Part3: "crc", Scope: 'I', LanguageType: 'L', Name: "Lonwolwol"},
@@ -1378,10 +1385,10 @@ var LanguagesPart3 = map[string]Language{
 	"crg": {Part3: "crg", Scope: 'I', LanguageType: 'L', Name: "Michif"},
 	"crh": {Part3: "crh", Part2B: "crh", Part2T: "crh", Scope: 'I', LanguageType: 'L', Name: "Crimean Tatar"},
 	"cri": {Part3: "cri", Scope: 'I', LanguageType: 'L', Name: "Sãotomense"},
-	"crj": {Part3: "crj", Scope: 'I', LanguageType: 'L', Name: "Southern East Cree"},
-	"crk": {Part3: "crk", Scope: 'I', LanguageType: 'L', Name: "Plains Cree"},
-	"crl": {Part3: "crl", Scope: 'I', LanguageType: 'L', Name: "Northern East Cree"},
-	"crm": {Part3: "crm", Scope: 'I', LanguageType: 'L', Name: "Moose Cree"},
+	"crj": {Part3: "crj", Scope: 'I', LanguageType: 'L', Name: "Southern East Cree", MacrolanguageCode: "cre"},
+	"crk": {Part3: "crk", Scope: 'I', LanguageType: 'L', Name: "Plains Cree", MacrolanguageCode: "cre"},
+	"crl": {Part3: "crl", Scope: 'I', LanguageType: 'L', Name: "Northern East Cree", MacrolanguageCode: "cre"},
+	"crm": {Part3: "crm", Scope: 'I', LanguageType: 'L', Name: "Moose Cree", MacrolanguageCode: "cre"},
 	"crn": {Part3: "crn", Scope: 'I', LanguageType: 'L', Name: "El Nayar Cora"},
 	"cro": {Part3: "cro", Scope: 'I', LanguageType: 'L', Name: "Crow"},
 	"crq": {Part3: "crq", Scope: 'I', LanguageType: 'L', Name: "Iyo'wujwa Chorote"},
@@ -1408,13 +1415,13 @@ var LanguagesPart3 = map[string]Language{
 	"csm": {Part3: "csm", Scope: 'I', LanguageType: 'L', Name: "Central Sierra Miwok"},
 	"csn": {Part3: "csn", Scope: 'I', LanguageType: 'L', Name: "Colombian Sign Language"},
 	"cso": {Part3: "cso", Scope: 'I', LanguageType: 'L', Name: "Sochiapam Chinantec"},
-	"csp": {Part3: "csp", Scope: 'I', LanguageType: 'L', Name: "Southern Ping Chinese"},
+	"csp": {Part3: "csp", Scope: 'I', LanguageType: 'L', Name: "Southern Ping Chinese", MacrolanguageCode: "zho"},
 	"csq": {Part3: "csq", Scope: 'I', LanguageType: 'L', Name: "Croatia Sign Language"},
 	"csr": {Part3: "csr", Scope: 'I', LanguageType: 'L', Name: "Costa Rican Sign Language"},
 	"css": {Part3: "css", Scope: 'I', LanguageType: 'E', Name: "Southern Ohlone"},
 	"cst": {Part3: "cst", Scope: 'I', LanguageType: 'L', Name: "Northern Ohlone"},
 	"csv": {Part3: "csv", Scope: 'I', LanguageType: 'L', Name: "Sumtu Chin"},
-	"csw": {Part3: "csw", Scope: 'I', LanguageType: 'L', Name: "Swampy Cree"},
+	"csw": {Part3: "csw", Scope: 'I', LanguageType: 'L', Name: "Swampy Cree", MacrolanguageCode: "cre"},
 	"csx": {Part3: "csx", Scope: 'I', LanguageType: 'L', Name: "Cambodian Sign Language"},
 	"csy": {Part3: "csy", Scope: 'I', LanguageType: 'L', Name: "Siyin Chin"},
 	"csz": {Part3: "csz", Scope: 'I', LanguageType: 'L', Name: "Coos"},
@@ -1429,7 +1436,7 @@ var LanguagesPart3 = map[string]Language{
 	"ctn": {Part3: "ctn", Scope: 'I', LanguageType: 'L', Name: "Chhintange"},
 	"cto": {Part3: "cto", Scope: 'I', LanguageType: 'L', Name: "Emberá-Catío"},
 	"ctp": {Part3: "ctp", Scope: 'I', LanguageType: 'L', Name: "Western Highland Chatino"},
-	"cts": {Part3: "cts", Scope: 'I', LanguageType: 'L', Name: "Northern Catanduanes Bikol"},
+	"cts": {Part3: "cts", Scope: 'I', LanguageType: 'L', Name: "Northern Catanduanes Bikol", MacrolanguageCode: "bik"},
 	"ctt": {Part3: "ctt", Scope: 'I', LanguageType: 'L', Name: "Wayanad Chetti"},
 	"ctu": {Part3: "ctu", Scope: 'I', LanguageType: 'L', Name: "Chol"},
 	"cty": {Part3: "cty", Scope: 'I', LanguageType: 'L', Name: "Moundadan Chetty"},
@@ -1457,7 +1464,7 @@ var LanguagesPart3 = map[string]Language{
 	"cvn": {Part3: "cvn", Scope: 'I', LanguageType: 'L', Name: "Valle Nacional Chinantec"},
 	"cwa": {Part3: "cwa", Scope: 'I', LanguageType: 'L', Name: "Kabwa"},
 	"cwb": {Part3: "cwb", Scope: 'I', LanguageType: 'L', Name: "Maindo"},
-	"cwd": {Part3: "cwd", Scope: 'I', LanguageType: 'L', Name: "Woods Cree"},
+	"cwd": {Part3: "cwd", Scope: 'I', LanguageType: 'L', Name: "Woods Cree", MacrolanguageCode: "cre"},
 	"cwe": {Part3: "cwe", Scope: 'I', LanguageType: 'L', Name: "Kwere"},
 	"cwg": {Part3: "cwg", Scope: 'I', LanguageType: 'L', Name: "Chewong"},
 	"cwt": {Part3: "cwt", Scope: 'I', LanguageType: 'L', Name: "Kuwaataay"},
@@ -1465,10 +1472,10 @@ var LanguagesPart3 = map[string]Language{
 	"cyb": {Part3: "cyb", Scope: 'I', LanguageType: 'E', Name: "Cayubaba"},
 	"cym": {Part3: "cym", Part2B: "wel", Part2T: "cym", Part1: "cy", Scope: 'I', LanguageType: 'L', Name: "Welsh"},
 	"cyo": {Part3: "cyo", Scope: 'I', LanguageType: 'L', Name: "Cuyonon"},
-	"czh": {Part3: "czh", Scope: 'I', LanguageType: 'L', Name: "Huizhou Chinese"},
+	"czh": {Part3: "czh", Scope: 'I', LanguageType: 'L', Name: "Huizhou Chinese", MacrolanguageCode: "zho"},
 	"czk": {Part3: "czk", Scope: 'I', LanguageType: 'E', Name: "Knaanic"},
 	"czn": {Part3: "czn", Scope: 'I', LanguageType: 'L', Name: "Zenzontepec Chatino"},
-	"czo": {Part3: "czo", Scope: 'I', LanguageType: 'L', Name: "Min Zhong Chinese"},
+	"czo": {Part3: "czo", Scope: 'I', LanguageType: 'L', Name: "Min Zhong Chinese", MacrolanguageCode: "zho"},
 	"czt": {Part3: "czt", Scope: 'I', LanguageType: 'L', Name: "Zotung Chin"},
 	"daa": {Part3: "daa", Scope: 'I', LanguageType: 'L', Name: "Dangaléat"},
 	"dac": {Part3: "dac", Scope: 'I', LanguageType: 'L', Name: "Dambi"},
@@ -1553,14 +1560,14 @@ var LanguagesPart3 = map[string]Language{
 	"dgk": {Part3: "dgk", Scope: 'I', LanguageType: 'L', Name: "Dagba"},
 	"dgl": {Part3: "dgl", Scope: 'I', LanguageType: 'L', Name: "Andaandi"},
 	"dgn": {Part3: "dgn", Scope: 'I', LanguageType: 'E', Name: "Dagoman"},
-	"dgo": {Part3: "dgo", Scope: 'I', LanguageType: 'L', Name: "Dogri (individual language)"},
+	"dgo": {Part3: "dgo", Scope: 'I', LanguageType: 'L', Name: "Dogri (individual language)", MacrolanguageCode: "doi"},
 	"dgr": {Part3: "dgr", Part2B: "dgr", Part2T: "dgr", Scope: 'I', LanguageType: 'L', Name: "Dogrib"},
 	"dgs": {Part3: "dgs", Scope: 'I', LanguageType: 'L', Name: "Dogoso"},
 	"dgt": {Part3: "dgt", Scope: 'I', LanguageType: 'E', Name: "Ndra'ngith"},
 	"dgw": {Part3: "dgw", Scope: 'I', LanguageType: 'E', Name: "Daungwurrung"},
 	"dgx": {Part3: "dgx", Scope: 'I', LanguageType: 'L', Name: "Doghoro"},
 	"dgz": {Part3: "dgz", Scope: 'I', LanguageType: 'L', Name: "Daga"},
-	"dhd": {Part3: "dhd", Scope: 'I', LanguageType: 'L', Name: "Dhundari"},
+	"dhd": {Part3: "dhd", Scope: 'I', LanguageType: 'L', Name: "Dhundari", MacrolanguageCode: "mwr"},
 	"dhg": {Part3: "dhg", Scope: 'I', LanguageType: 'L', Name: "Dhangu-Djangu"},
 	"dhi": {Part3: "dhi", Scope: 'I', LanguageType: 'L', Name: "Dhimal"},
 	"dhl": {Part3: "dhl", Scope: 'I', LanguageType: 'L', Name: "Dhalandji"},
@@ -1574,7 +1581,7 @@ var LanguagesPart3 = map[string]Language{
 	"dhw": {Part3: "dhw", Scope: 'I', LanguageType: 'L', Name: "Dhanwar (Nepal)"},
 	"dhx": {Part3: "dhx", Scope: 'I', LanguageType: 'L', Name: "Dhungaloo"},
 	"dia": {Part3: "dia", Scope: 'I', LanguageType: 'L', Name: "Dia"},
-	"dib": {Part3: "dib", Scope: 'I', LanguageType: 'L', Name: "South Central Dinka"},
+	"dib": {Part3: "dib", Scope: 'I', LanguageType: 'L', Name: "South Central Dinka", MacrolanguageCode: "din"},
 	"dic": {Part3: "dic", Scope: 'I', LanguageType: 'L', Name: "Lakota Dida"},
 	"did": {Part3: "did", Scope: 'I', LanguageType: 'L', Name: "Didinga"},
 	"dif": {Part3: "dif", Scope: 'I', LanguageType: 'E', Name: "Dieri"},
@@ -1582,18 +1589,18 @@ var LanguagesPart3 = map[string]Language{
 	"dih": {Part3: "dih", Scope: 'I', LanguageType: 'L', Name: "Kumiai"},
 	"dii": {Part3: "dii", Scope: 'I', LanguageType: 'L', Name: "Dimbong"},
 	"dij": {Part3: "dij", Scope: 'I', LanguageType: 'L', Name: "Dai"},
-	"dik": {Part3: "dik", Scope: 'I', LanguageType: 'L', Name: "Southwestern Dinka"},
+	"dik": {Part3: "dik", Scope: 'I', LanguageType: 'L', Name: "Southwestern Dinka", MacrolanguageCode: "din"},
 	"dil": {Part3: "dil", Scope: 'I', LanguageType: 'L', Name: "Dilling"},
 	"dim": {Part3: "dim", Scope: 'I', LanguageType: 'L', Name: "Dime"},
 	"din": {Part3: "din", Part2B: "din", Part2T: "din", Scope: 'M', LanguageType: 'L', Name: "Dinka"},
 	"dio": {Part3: "dio", Scope: 'I', LanguageType: 'L', Name: "Dibo"},
-	"dip": {Part3: "dip", Scope: 'I', LanguageType: 'L', Name: "Northeastern Dinka"},
-	"diq": {Part3: "diq", Scope: 'I', LanguageType: 'L', Name: "Dimli (individual language)"},
+	"dip": {Part3: "dip", Scope: 'I', LanguageType: 'L', Name: "Northeastern Dinka", MacrolanguageCode: "din"},
+	"diq": {Part3: "diq", Scope: 'I', LanguageType: 'L', Name: "Dimli (individual language)", MacrolanguageCode: "zza"},
 	"dir": {Part3: "dir", Scope: 'I', LanguageType: 'L', Name: "Dirim"},
 	"dis": {Part3: "dis", Scope: 'I', LanguageType: 'L', Name: "Dimasa"},
 	"diu": {Part3: "diu", Scope: 'I', LanguageType: 'L', Name: "Diriku"},
 	"div": {Part3: "div", Part2B: "div", Part2T: "div", Part1: "dv", Scope: 'I', LanguageType: 'L', Name: "Dhivehi"},
-	"diw": {Part3: "diw", Scope: 'I', LanguageType: 'L', Name: "Northwestern Dinka"},
+	"diw": {Part3: "diw", Scope: 'I', LanguageType: 'L', Name: "Northwestern Dinka", MacrolanguageCode: "din"},
 	"dix": {Part3: "dix", Scope: 'I', LanguageType: 'L', Name: "Dixon Reef"},
 	"diy": {Part3: "diy", Scope: 'I', LanguageType: 'L', Name: "Diuwe"},
 	"diz": {Part3: "diz", Scope: 'I', LanguageType: 'L', Name: "Ding"},
@@ -1616,7 +1623,7 @@ var LanguagesPart3 = map[string]Language{
 	"dkg": {Part3: "dkg", Scope: 'I', LanguageType: 'L', Name: "Kadung"},
 	"dkk": {Part3: "dkk", Scope: 'I', LanguageType: 'L', Name: "Dakka"},
 	"dkr": {Part3: "dkr", Scope: 'I', LanguageType: 'L', Name: "Kuijau"},
-	"dks": {Part3: "dks", Scope: 'I', LanguageType: 'L', Name: "Southeastern Dinka"},
+	"dks": {Part3: "dks", Scope: 'I', LanguageType: 'L', Name: "Southeastern Dinka", MacrolanguageCode: "din"},
 	"dkx": {Part3: "dkx", Scope: 'I', LanguageType: 'L', Name: "Mazagway"},
 	"dlg": {Part3: "dlg", Scope: 'I', LanguageType: 'L', Name: "Dolgan"},
 	"dlk": {Part3: "dlk", Scope: 'I', LanguageType: 'L', Name: "Dahalik"},
@@ -1713,7 +1720,7 @@ var LanguagesPart3 = map[string]Language{
 	"dts": {Part3: "dts", Scope: 'I', LanguageType: 'L', Name: "Toro So Dogon"},
 	"dtt": {Part3: "dtt", Scope: 'I', LanguageType: 'L', Name: "Toro Tegu Dogon"},
 	"dtu": {Part3: "dtu", Scope: 'I', LanguageType: 'L', Name: "Tebul Ure Dogon"},
-	"dty": {Part3: "dty", Scope: 'I', LanguageType: 'L', Name: "Dotyali"},
+	"dty": {Part3: "dty", Scope: 'I', LanguageType: 'L', Name: "Dotyali", MacrolanguageCode: "nep"},
 	"dua": {Part3: "dua", Part2B: "dua", Part2T: "dua", Scope: 'I', LanguageType: 'L', Name: "Duala"},
 	"dub": {Part3: "dub", Scope: 'I', LanguageType: 'L', Name: "Dubli"},
 	"duc": {Part3: "duc", Scope: 'I', LanguageType: 'L', Name: "Duna"},
@@ -1727,7 +1734,7 @@ var LanguagesPart3 = map[string]Language{
 	"dum": {Part3: "dum", Part2B: "dum", Part2T: "dum", Scope: 'I', LanguageType: 'H', Name: "Middle Dutch (ca. 1050-1350)"},
 	"dun": {Part3: "dun", Scope: 'I', LanguageType: 'L', Name: "Dusun Deyah"},
 	"duo": {Part3: "duo", Scope: 'I', LanguageType: 'L', Name: "Dupaninan Agta"},
-	"dup": {Part3: "dup", Scope: 'I', LanguageType: 'L', Name: "Duano"},
+	"dup": {Part3: "dup", Scope: 'I', LanguageType: 'L', Name: "Duano", MacrolanguageCode: "msa"},
 	"duq": {Part3: "duq", Scope: 'I', LanguageType: 'L', Name: "Dusun Malang"},
 	"dur": {Part3: "dur", Scope: 'I', LanguageType: 'L', Name: "Dii"},
 	"dus": {Part3: "dus", Scope: 'I', LanguageType: 'L', Name: "Dumi"},
@@ -1765,7 +1772,7 @@ var LanguagesPart3 = map[string]Language{
 	"eaa": {Part3: "eaa", Scope: 'I', LanguageType: 'E', Name: "Karenggapa"},
 	"ebc": {Part3: "ebc", Scope: 'I', LanguageType: 'L', Name: "Beginci"},
 	"ebg": {Part3: "ebg", Scope: 'I', LanguageType: 'L', Name: "Ebughu"},
-	"ebk": {Part3: "ebk", Scope: 'I', LanguageType: 'L', Name: "Eastern Bontok"},
+	"ebk": {Part3: "ebk", Scope: 'I', LanguageType: 'L', Name: "Eastern Bontok", MacrolanguageCode: "bnc"},
 	"ebo": {Part3: "ebo", Scope: 'I', LanguageType: 'L', Name: "Teke-Ebo"},
 	"ebr": {Part3: "ebr", Scope: 'I', LanguageType: 'L', Name: "Ebrié"},
 	"ebu": {Part3: "ebu", Scope: 'I', LanguageType: 'L', Name: "Embu"},
@@ -1790,7 +1797,7 @@ var LanguagesPart3 = map[string]Language{
 	"eke": {Part3: "eke", Scope: 'I', LanguageType: 'L', Name: "Ekit"},
 	"ekg": {Part3: "ekg", Scope: 'I', LanguageType: 'L', Name: "Ekari"},
 	"eki": {Part3: "eki", Scope: 'I', LanguageType: 'L', Name: "Eki"},
-	"ekk": {Part3: "ekk", Scope: 'I', LanguageType: 'L', Name: "Standard Estonian"},
+	"ekk": {Part3: "ekk", Scope: 'I', LanguageType: 'L', Name: "Standard Estonian", MacrolanguageCode: "est"},
 	"ekl": {Part3: "ekl", Scope: 'I', LanguageType: 'L', Name: "Kol (Bangladesh)"},
 	"ekm": {Part3: "ekm", Scope: 'I', LanguageType: 'L', Name: "Elip"},
 	"eko": {Part3: "eko", Scope: 'I', LanguageType: 'L', Name: "Koti"},
@@ -1811,7 +1818,7 @@ var LanguagesPart3 = map[string]Language{
 	"eme": {Part3: "eme", Scope: 'I', LanguageType: 'L', Name: "Emerillon"},
 	"emg": {Part3: "emg", Scope: 'I', LanguageType: 'L', Name: "Eastern Meohang"},
 	"emi": {Part3: "emi", Scope: 'I', LanguageType: 'L', Name: "Mussau-Emira"},
-	"emk": {Part3: "emk", Scope: 'I', LanguageType: 'L', Name: "Eastern Maninkakan"},
+	"emk": {Part3: "emk", Scope: 'I', LanguageType: 'L', Name: "Eastern Maninkakan", MacrolanguageCode: "man"},
 	"emm": {Part3: "emm", Scope: 'I', LanguageType: 'E', Name: "Mamulique"},
 	"emn": {Part3: "emn", Scope: 'I', LanguageType: 'L', Name: "Eman"},
 	"emp": {Part3: "emp", Scope: 'I', LanguageType: 'L', Name: "Northern Emberá"},
@@ -1823,7 +1830,7 @@ var LanguagesPart3 = map[string]Language{
 	"emy": {Part3: "emy", Scope: 'I', LanguageType: 'A', Name: "Epigraphic Mayan"},
 	"emz": {Part3: "emz", Scope: 'I', LanguageType: 'L', Name: "Mbessa"},
 	"ena": {Part3: "ena", Scope: 'I', LanguageType: 'L', Name: "Apali"},
-	"enb": {Part3: "enb", Scope: 'I', LanguageType: 'L', Name: "Markweeta"},
+	"enb": {Part3: "enb", Scope: 'I', LanguageType: 'L', Name: "Markweeta", MacrolanguageCode: "kln"},
 	"enc": {Part3: "enc", Scope: 'I', LanguageType: 'L', Name: "En"},
 	"end": {Part3: "end", Scope: 'I', LanguageType: 'L', Name: "Ende"},
 	"enf": {Part3: "enf", Scope: 'I', LanguageType: 'L', Name: "Forest Enets"},
@@ -1853,10 +1860,10 @@ var LanguagesPart3 = map[string]Language{
 	"ert": {Part3: "ert", Scope: 'I', LanguageType: 'L', Name: "Eritai"},
 	"erw": {Part3: "erw", Scope: 'I', LanguageType: 'L', Name: "Erokwanas"},
 	"ese": {Part3: "ese", Scope: 'I', LanguageType: 'L', Name: "Ese Ejja"},
-	"esg": {Part3: "esg", Scope: 'I', LanguageType: 'L', Name: "Aheri Gondi"},
+	"esg": {Part3: "esg", Scope: 'I', LanguageType: 'L', Name: "Aheri Gondi", MacrolanguageCode: "gon"},
 	"esh": {Part3: "esh", Scope: 'I', LanguageType: 'L', Name: "Eshtehardi"},
-	"esi": {Part3: "esi", Scope: 'I', LanguageType: 'L', Name: "North Alaskan Inupiatun"},
-	"esk": {Part3: "esk", Scope: 'I', LanguageType: 'L', Name: "Northwest Alaska Inupiatun"},
+	"esi": {Part3: "esi", Scope: 'I', LanguageType: 'L', Name: "North Alaskan Inupiatun", MacrolanguageCode: "ipk"},
+	"esk": {Part3: "esk", Scope: 'I', LanguageType: 'L', Name: "Northwest Alaska Inupiatun", MacrolanguageCode: "ipk"},
 	"esl": {Part3: "esl", Scope: 'I', LanguageType: 'L', Name: "Egypt Sign Language"},
 	"esm": {Part3: "esm", Scope: 'I', LanguageType: 'E', Name: "Esuma"},
 	"esn": {Part3: "esn", Scope: 'I', LanguageType: 'L', Name: "Salvadoran Sign Language"},
@@ -1885,7 +1892,7 @@ var LanguagesPart3 = map[string]Language{
 	"ewo": {Part3: "ewo", Part2B: "ewo", Part2T: "ewo", Scope: 'I', LanguageType: 'L', Name: "Ewondo"},
 	"ext": {Part3: "ext", Scope: 'I', LanguageType: 'L', Name: "Extremaduran"},
 	"eya": {Part3: "eya", Scope: 'I', LanguageType: 'E', Name: "Eyak"},
-	"eyo": {Part3: "eyo", Scope: 'I', LanguageType: 'L', Name: "Keiyo"},
+	"eyo": {Part3: "eyo", Scope: 'I', LanguageType: 'L', Name: "Keiyo", MacrolanguageCode: "kln"},
 	"eza": {Part3: "eza", Scope: 'I', LanguageType: 'L', Name: "Ezaa"},
 	"eze": {Part3: "eze", Scope: 'I', LanguageType: 'L', Name: "Uzekwe"},
 	"faa": {Part3: "faa", Scope: 'I', LanguageType: 'L', Name: "Fasu"},
@@ -1904,16 +1911,16 @@ var LanguagesPart3 = map[string]Language{
 	"fap": {Part3: "fap", Scope: 'I', LanguageType: 'L', Name: "Paloor"},
 	"far": {Part3: "far", Scope: 'I', LanguageType: 'L', Name: "Fataleka"},
 	"fas": {Part3: "fas", Part2B: "per", Part2T: "fas", Part1: "fa", Scope: 'M', LanguageType: 'L', Name: "Persian"},
-	"fat": {Part3: "fat", Part2B: "fat", Part2T: "fat", Scope: 'I', LanguageType: 'L', Name: "Fanti"},
+	"fat": {Part3: "fat", Part2B: "fat", Part2T: "fat", Scope: 'I', LanguageType: 'L', Name: "Fanti", MacrolanguageCode: "aka"},
 	"fau": {Part3: "fau", Scope: 'I', LanguageType: 'L', Name: "Fayu"},
 	"fax": {Part3: "fax", Scope: 'I', LanguageType: 'L', Name: "Fala"},
 	"fay": {Part3: "fay", Scope: 'I', LanguageType: 'L', Name: "Southwestern Fars"},
 	"faz": {Part3: "faz", Scope: 'I', LanguageType: 'L', Name: "Northwestern Fars"},
-	"fbl": {Part3: "fbl", Scope: 'I', LanguageType: 'L', Name: "West Albay Bikol"},
+	"fbl": {Part3: "fbl", Scope: 'I', LanguageType: 'L', Name: "West Albay Bikol", MacrolanguageCode: "bik"},
 	"fcs": {Part3: "fcs", Scope: 'I', LanguageType: 'L', Name: "Quebec Sign Language"},
 	"fer": {Part3: "fer", Scope: 'I', LanguageType: 'L', Name: "Feroge"},
 	"ffi": {Part3: "ffi", Scope: 'I', LanguageType: 'L', Name: "Foia Foia"},
-	"ffm": {Part3: "ffm", Scope: 'I', LanguageType: 'L', Name: "Maasina Fulfulde"},
+	"ffm": {Part3: "ffm", Scope: 'I', LanguageType: 'L', Name: "Maasina Fulfulde", MacrolanguageCode: "ful"},
 	"fgr": {Part3: "fgr", Scope: 'I', LanguageType: 'L', Name: "Fongoro"},
 	"fia": {Part3: "fia", Scope: 'I', LanguageType: 'L', Name: "Nobiin"},
 	"fie": {Part3: "fie", Scope: 'I', LanguageType: 'L', Name: "Fyer"},
@@ -1962,24 +1969,24 @@ var LanguagesPart3 = map[string]Language{
 	"fse": {Part3: "fse", Scope: 'I', LanguageType: 'L', Name: "Finnish Sign Language"},
 	"fsl": {Part3: "fsl", Scope: 'I', LanguageType: 'L', Name: "French Sign Language"},
 	"fss": {Part3: "fss", Scope: 'I', LanguageType: 'L', Name: "Finland-Swedish Sign Language"},
-	"fub": {Part3: "fub", Scope: 'I', LanguageType: 'L', Name: "Adamawa Fulfulde"},
-	"fuc": {Part3: "fuc", Scope: 'I', LanguageType: 'L', Name: "Pulaar"},
+	"fub": {Part3: "fub", Scope: 'I', LanguageType: 'L', Name: "Adamawa Fulfulde", MacrolanguageCode: "ful"},
+	"fuc": {Part3: "fuc", Scope: 'I', LanguageType: 'L', Name: "Pulaar", MacrolanguageCode: "ful"},
 	"fud": {Part3: "fud", Scope: 'I', LanguageType: 'L', Name: "East Futuna"},
-	"fue": {Part3: "fue", Scope: 'I', LanguageType: 'L', Name: "Borgu Fulfulde"},
-	"fuf": {Part3: "fuf", Scope: 'I', LanguageType: 'L', Name: "Pular"},
-	"fuh": {Part3: "fuh", Scope: 'I', LanguageType: 'L', Name: "Western Niger Fulfulde"},
-	"fui": {Part3: "fui", Scope: 'I', LanguageType: 'L', Name: "Bagirmi Fulfulde"},
+	"fue": {Part3: "fue", Scope: 'I', LanguageType: 'L', Name: "Borgu Fulfulde", MacrolanguageCode: "ful"},
+	"fuf": {Part3: "fuf", Scope: 'I', LanguageType: 'L', Name: "Pular", MacrolanguageCode: "ful"},
+	"fuh": {Part3: "fuh", Scope: 'I', LanguageType: 'L', Name: "Western Niger Fulfulde", MacrolanguageCode: "ful"},
+	"fui": {Part3: "fui", Scope: 'I', LanguageType: 'L', Name: "Bagirmi Fulfulde", MacrolanguageCode: "ful"},
 	"fuj": {Part3: "fuj", Scope: 'I', LanguageType: 'L', Name: "Ko"},
 	"ful": {Part3: "ful", Part2B: "ful", Part2T: "ful", Part1: "ff", Scope: 'M', LanguageType: 'L', Name: "Fulah"},
 	"fum": {Part3: "fum", Scope: 'I', LanguageType: 'L', Name: "Fum"},
 	"fun": {Part3: "fun", Scope: 'I', LanguageType: 'L', Name: "Fulniô"},
-	"fuq": {Part3: "fuq", Scope: 'I', LanguageType: 'L', Name: "Central-Eastern Niger Fulfulde"},
+	"fuq": {Part3: "fuq", Scope: 'I', LanguageType: 'L', Name: "Central-Eastern Niger Fulfulde", MacrolanguageCode: "ful"},
 	"fur": {Part3: "fur", Part2B: "fur", Part2T: "fur", Scope: 'I', LanguageType: 'L', Name: "Friulian"},
 	"fut": {Part3: "fut", Scope: 'I', LanguageType: 'L', Name: "Futuna-Aniwa"},
 	"fuu": {Part3: "fuu", Scope: 'I', LanguageType: 'L', Name: "Furu"},
-	"fuv": {Part3: "fuv", Scope: 'I', LanguageType: 'L', Name: "Nigerian Fulfulde"},
+	"fuv": {Part3: "fuv", Scope: 'I', LanguageType: 'L', Name: "Nigerian Fulfulde", MacrolanguageCode: "ful"},
 	"fuy": {Part3: "fuy", Scope: 'I', LanguageType: 'L', Name: "Fuyug"},
-	"fvr": {Part3: "fvr", Scope: 'I', LanguageType: 'L', Name: "Fur"},
+	"fvr": {Part3: "fvr", Scope: 'I', LanguageType: 'L', Name: "Fur", MacrolanguageCode: "ful"},
 	"fwa": {Part3: "fwa", Scope: 'I', LanguageType: 'L', Name: "Fwâi"},
 	"fwe": {Part3: "fwe", Scope: 'I', LanguageType: 'L', Name: "Fwe"},
 	"gaa": {Part3: "gaa", Part2B: "gaa", Part2T: "gaa", Scope: 'I', LanguageType: 'L', Name: "Ga"},
@@ -1995,7 +2002,7 @@ var LanguagesPart3 = map[string]Language{
 	"gak": {Part3: "gak", Scope: 'I', LanguageType: 'L', Name: "Gamkonora"},
 	"gal": {Part3: "gal", Scope: 'I', LanguageType: 'L', Name: "Galolen"},
 	"gam": {Part3: "gam", Scope: 'I', LanguageType: 'L', Name: "Kandawo"},
-	"gan": {Part3: "gan", Scope: 'I', LanguageType: 'L', Name: "Gan Chinese"},
+	"gan": {Part3: "gan", Scope: 'I', LanguageType: 'L', Name: "Gan Chinese", MacrolanguageCode: "zho"},
 	"gao": {Part3: "gao", Scope: 'I', LanguageType: 'L', Name: "Gants"},
 	"gap": {Part3: "gap", Scope: 'I', LanguageType: 'L', Name: "Gal"},
 	"gaq": {Part3: "gaq", Scope: 'I', LanguageType: 'L', Name: "Gata'"},
@@ -2004,9 +2011,9 @@ var LanguagesPart3 = map[string]Language{
 	"gat": {Part3: "gat", Scope: 'I', LanguageType: 'L', Name: "Kenati"},
 	"gau": {Part3: "gau", Scope: 'I', LanguageType: 'L', Name: "Mudhili Gadaba"},
 	"gaw": {Part3: "gaw", Scope: 'I', LanguageType: 'L', Name: "Nobonob"},
-	"gax": {Part3: "gax", Scope: 'I', LanguageType: 'L', Name: "Borana-Arsi-Guji Oromo"},
+	"gax": {Part3: "gax", Scope: 'I', LanguageType: 'L', Name: "Borana-Arsi-Guji Oromo", MacrolanguageCode: "orm"},
 	"gay": {Part3: "gay", Part2B: "gay", Part2T: "gay", Scope: 'I', LanguageType: 'L', Name: "Gayo"},
-	"gaz": {Part3: "gaz", Scope: 'I', LanguageType: 'L', Name: "West Central Oromo"},
+	"gaz": {Part3: "gaz", Scope: 'I', LanguageType: 'L', Name: "West Central Oromo", MacrolanguageCode: "orm"},
 	"gba": {Part3: "gba", Part2B: "gba", Part2T: "gba", Scope: 'M', LanguageType: 'L', Name: "Gbaya (Central African Republic)"},
 	"gbb": {Part3: "gbb", Scope: 'I', LanguageType: 'L', Name: "Kaytetye"},
 	"gbd": {Part3: "gbd", Scope: 'I', LanguageType: 'L', Name: "Karajarri"},
@@ -2020,9 +2027,9 @@ var LanguagesPart3 = map[string]Language{
 	"gbl": {Part3: "gbl", Scope: 'I', LanguageType: 'L', Name: "Gamit"},
 	"gbm": {Part3: "gbm", Scope: 'I', LanguageType: 'L', Name: "Garhwali"},
 	"gbn": {Part3: "gbn", Scope: 'I', LanguageType: 'L', Name: "Mo'da"},
-	"gbo": {Part3: "gbo", Scope: 'I', LanguageType: 'L', Name: "Northern Grebo"},
-	"gbp": {Part3: "gbp", Scope: 'I', LanguageType: 'L', Name: "Gbaya-Bossangoa"},
-	"gbq": {Part3: "gbq", Scope: 'I', LanguageType: 'L', Name: "Gbaya-Bozoum"},
+	"gbo": {Part3: "gbo", Scope: 'I', LanguageType: 'L', Name: "Northern Grebo", MacrolanguageCode: "grb"},
+	"gbp": {Part3: "gbp", Scope: 'I', LanguageType: 'L', Name: "Gbaya-Bossangoa", MacrolanguageCode: "gba"},
+	"gbq": {Part3: "gbq", Scope: 'I', LanguageType: 'L', Name: "Gbaya-Bozoum", MacrolanguageCode: "gba"},
 	"gbr": {Part3: "gbr", Scope: 'I', LanguageType: 'L', Name: "Gbagyi"},
 	"gbs": {Part3: "gbs", Scope: 'I', LanguageType: 'L', Name: "Gbesi Gbe"},
 	"gbu": {Part3: "gbu", Scope: 'I', LanguageType: 'L', Name: "Gagadu"},
@@ -2039,7 +2046,7 @@ var LanguagesPart3 = map[string]Language{
 	"gcn": {Part3: "gcn", Scope: 'I', LanguageType: 'L', Name: "Gaina"},
 	"gcr": {Part3: "gcr", Scope: 'I', LanguageType: 'L', Name: "Guianese Creole French"},
 	"gct": {Part3: "gct", Scope: 'I', LanguageType: 'L', Name: "Colonia Tovar German"},
-	"gda": {Part3: "gda", Scope: 'I', LanguageType: 'L', Name: "Gade Lohar"},
+	"gda": {Part3: "gda", Scope: 'I', LanguageType: 'L', Name: "Gade Lohar", MacrolanguageCode: "raj"},
 	"gdb": {Part3: "gdb", Scope: 'I', LanguageType: 'L', Name: "Pottangi Ollar Gadaba"},
 	"gdc": {Part3: "gdc", Scope: 'I', LanguageType: 'E', Name: "Gugu Badhun"},
 	"gdd": {Part3: "gdd", Scope: 'I', LanguageType: 'L', Name: "Gedaged"},
@@ -2062,7 +2069,7 @@ var LanguagesPart3 = map[string]Language{
 	"gdx": {Part3: "gdx", Scope: 'I', LanguageType: 'L', Name: "Godwari"},
 	"gea": {Part3: "gea", Scope: 'I', LanguageType: 'L', Name: "Geruma"},
 	"geb": {Part3: "geb", Scope: 'I', LanguageType: 'L', Name: "Kire"},
-	"gec": {Part3: "gec", Scope: 'I', LanguageType: 'L', Name: "Gboloo Grebo"},
+	"gec": {Part3: "gec", Scope: 'I', LanguageType: 'L', Name: "Gboloo Grebo", MacrolanguageCode: "grb"},
 	"ged": {Part3: "ged", Scope: 'I', LanguageType: 'L', Name: "Gade"},
 	"gef": {Part3: "gef", Scope: 'I', LanguageType: 'L', Name: "Gerai"},
 	"geg": {Part3: "geg", Scope: 'I', LanguageType: 'L', Name: "Gengle"},
@@ -2126,13 +2133,13 @@ var LanguagesPart3 = map[string]Language{
 	"gjm": {Part3: "gjm", Scope: 'I', LanguageType: 'E', Name: "Gunditjmara"},
 	"gjn": {Part3: "gjn", Scope: 'I', LanguageType: 'L', Name: "Gonja"},
 	"gjr": {Part3: "gjr", Scope: 'I', LanguageType: 'L', Name: "Gurindji Kriol"},
-	"gju": {Part3: "gju", Scope: 'I', LanguageType: 'L', Name: "Gujari"},
+	"gju": {Part3: "gju", Scope: 'I', LanguageType: 'L', Name: "Gujari", MacrolanguageCode: "raj"},
 	"gka": {Part3: "gka", Scope: 'I', LanguageType: 'L', Name: "Guya"},
 	"gkd": {Part3: "gkd", Scope: 'I', LanguageType: 'L', Name: "Magɨ (Madang Province)"},
 	"gke": {Part3: "gke", Scope: 'I', LanguageType: 'L', Name: "Ndai"},
 	"gkn": {Part3: "gkn", Scope: 'I', LanguageType: 'L', Name: "Gokana"},
 	"gko": {Part3: "gko", Scope: 'I', LanguageType: 'E', Name: "Kok-Nar"},
-	"gkp": {Part3: "gkp", Scope: 'I', LanguageType: 'L', Name: "Guinea Kpelle"},
+	"gkp": {Part3: "gkp", Scope: 'I', LanguageType: 'L', Name: "Guinea Kpelle", MacrolanguageCode: "kpe"},
 	"gku": {Part3: "gku", Scope: 'I', LanguageType: 'E', Name: "ǂUngkue"},
 	"gla": {Part3: "gla", Part2B: "gla", Part2T: "gla", Part1: "gd", Scope: 'I', LanguageType: 'L', Name: "Scottish Gaelic"},
 	"glb": {Part3: "glb", Scope: 'I', LanguageType: 'L', Name: "Belning"},
@@ -2156,7 +2163,7 @@ var LanguagesPart3 = map[string]Language{
 	"gmg": {Part3: "gmg", Scope: 'I', LanguageType: 'L', Name: "Magɨyi"},
 	"gmh": {Part3: "gmh", Part2B: "gmh", Part2T: "gmh", Scope: 'I', LanguageType: 'H', Name: "Middle High German (ca. 1050-1500)"},
 	"gml": {Part3: "gml", Scope: 'I', LanguageType: 'H', Name: "Middle Low German"},
-	"gmm": {Part3: "gmm", Scope: 'I', LanguageType: 'L', Name: "Gbaya-Mbodomo"},
+	"gmm": {Part3: "gmm", Scope: 'I', LanguageType: 'L', Name: "Gbaya-Mbodomo", MacrolanguageCode: "gba"},
 	"gmn": {Part3: "gmn", Scope: 'I', LanguageType: 'L', Name: "Gimnime"},
 	"gmr": {Part3: "gmr", Scope: 'I', LanguageType: 'L', Name: "Mirning"},
 	"gmu": {Part3: "gmu", Scope: 'I', LanguageType: 'L', Name: "Gumalu"},
@@ -2177,12 +2184,12 @@ var LanguagesPart3 = map[string]Language{
 	"gnl": {Part3: "gnl", Scope: 'I', LanguageType: 'E', Name: "Gangulu"},
 	"gnm": {Part3: "gnm", Scope: 'I', LanguageType: 'L', Name: "Ginuman"},
 	"gnn": {Part3: "gnn", Scope: 'I', LanguageType: 'L', Name: "Gumatj"},
-	"gno": {Part3: "gno", Scope: 'I', LanguageType: 'L', Name: "Northern Gondi"},
+	"gno": {Part3: "gno", Scope: 'I', LanguageType: 'L', Name: "Northern Gondi", MacrolanguageCode: "gon"},
 	"gnq": {Part3: "gnq", Scope: 'I', LanguageType: 'L', Name: "Gana"},
 	"gnr": {Part3: "gnr", Scope: 'I', LanguageType: 'E', Name: "Gureng Gureng"},
 	"gnt": {Part3: "gnt", Scope: 'I', LanguageType: 'L', Name: "Guntai"},
 	"gnu": {Part3: "gnu", Scope: 'I', LanguageType: 'L', Name: "Gnau"},
-	"gnw": {Part3: "gnw", Scope: 'I', LanguageType: 'L', Name: "Western Bolivian Guaraní"},
+	"gnw": {Part3: "gnw", Scope: 'I', LanguageType: 'L', Name: "Western Bolivian Guaraní", MacrolanguageCode: "grn"},
 	"gnz": {Part3: "gnz", Scope: 'I', LanguageType: 'L', Name: "Ganzi"},
 	"goa": {Part3: "goa", Scope: 'I', LanguageType: 'L', Name: "Guro"},
 	"gob": {Part3: "gob", Scope: 'I', LanguageType: 'L', Name: "Playero"},
@@ -2196,7 +2203,7 @@ var LanguagesPart3 = map[string]Language{
 	"goj": {Part3: "goj", Scope: 'I', LanguageType: 'L', Name: "Gowlan"},
 	"gok": {Part3: "gok", Scope: 'I', LanguageType: 'L', Name: "Gowli"},
 	"gol": {Part3: "gol", Scope: 'I', LanguageType: 'L', Name: "Gola"},
-	"gom": {Part3: "gom", Scope: 'I', LanguageType: 'L', Name: "Goan Konkani"},
+	"gom": {Part3: "gom", Scope: 'I', LanguageType: 'L', Name: "Goan Konkani", MacrolanguageCode: "kok"},
 	"gon": {Part3: "gon", Part2B: "gon", Part2T: "gon", Scope: 'M', LanguageType: 'L', Name: "Gondi"},
 	"goo": {Part3: "goo", Scope: 'I', LanguageType: 'L', Name: "Gone Dau"},
 	"gop": {Part3: "gop", Scope: 'I', LanguageType: 'L', Name: "Yeretuar"},
@@ -2224,7 +2231,7 @@ var LanguagesPart3 = map[string]Language{
 	"grg": {Part3: "grg", Scope: 'I', LanguageType: 'L', Name: "Madi"},
 	"grh": {Part3: "grh", Scope: 'I', LanguageType: 'L', Name: "Gbiri-Niragu"},
 	"gri": {Part3: "gri", Scope: 'I', LanguageType: 'L', Name: "Ghari"},
-	"grj": {Part3: "grj", Scope: 'I', LanguageType: 'L', Name: "Southern Grebo"},
+	"grj": {Part3: "grj", Scope: 'I', LanguageType: 'L', Name: "Southern Grebo", MacrolanguageCode: "grb"},
 	"grm": {Part3: "grm", Scope: 'I', LanguageType: 'L', Name: "Kota Marudu Talantang"},
 	"grn": {Part3: "grn", Part2B: "grn", Part2T: "grn", Part1: "gn", Scope: 'M', LanguageType: 'L', Name: "Guarani"},
 	"gro": {Part3: "gro", Scope: 'I', LanguageType: 'L', Name: "Groma"},
@@ -2233,17 +2240,17 @@ var LanguagesPart3 = map[string]Language{
 	"grs": {Part3: "grs", Scope: 'I', LanguageType: 'L', Name: "Gresi"},
 	"grt": {Part3: "grt", Scope: 'I', LanguageType: 'L', Name: "Garo"},
 	"gru": {Part3: "gru", Scope: 'I', LanguageType: 'L', Name: "Kistane"},
-	"grv": {Part3: "grv", Scope: 'I', LanguageType: 'L', Name: "Central Grebo"},
+	"grv": {Part3: "grv", Scope: 'I', LanguageType: 'L', Name: "Central Grebo", MacrolanguageCode: "grb"},
 	"grw": {Part3: "grw", Scope: 'I', LanguageType: 'L', Name: "Gweda"},
 	"grx": {Part3: "grx", Scope: 'I', LanguageType: 'L', Name: "Guriaso"},
-	"gry": {Part3: "gry", Scope: 'I', LanguageType: 'L', Name: "Barclayville Grebo"},
+	"gry": {Part3: "gry", Scope: 'I', LanguageType: 'L', Name: "Barclayville Grebo", MacrolanguageCode: "grb"},
 	"grz": {Part3: "grz", Scope: 'I', LanguageType: 'L', Name: "Guramalum"},
 	"gse": {Part3: "gse", Scope: 'I', LanguageType: 'L', Name: "Ghanaian Sign Language"},
 	"gsg": {Part3: "gsg", Scope: 'I', LanguageType: 'L', Name: "German Sign Language"},
 	"gsl": {Part3: "gsl", Scope: 'I', LanguageType: 'L', Name: "Gusilay"},
 	"gsm": {Part3: "gsm", Scope: 'I', LanguageType: 'L', Name: "Guatemalan Sign Language"},
 	"gsn": {Part3: "gsn", Scope: 'I', LanguageType: 'L', Name: "Nema"},
-	"gso": {Part3: "gso", Scope: 'I', LanguageType: 'L', Name: "Southwest Gbaya"},
+	"gso": {Part3: "gso", Scope: 'I', LanguageType: 'L', Name: "Southwest Gbaya", MacrolanguageCode: "gba"},
 	"gsp": {Part3: "gsp", Scope: 'I', LanguageType: 'L', Name: "Wasembo"},
 	"gss": {Part3: "gss", Scope: 'I', LanguageType: 'L', Name: "Greek Sign Language"},
 	"gsw": {Part3: "gsw", Part2B: "gsw", Part2T: "gsw", Scope: 'I', LanguageType: 'L', Name: "Swiss German"},
@@ -2255,14 +2262,14 @@ var LanguagesPart3 = map[string]Language{
 	"gud": {Part3: "gud", Scope: 'I', LanguageType: 'L', Name: "Yocoboué Dida"},
 	"gue": {Part3: "gue", Scope: 'I', LanguageType: 'L', Name: "Gurindji"},
 	"guf": {Part3: "guf", Scope: 'I', LanguageType: 'L', Name: "Gupapuyngu"},
-	"gug": {Part3: "gug", Scope: 'I', LanguageType: 'L', Name: "Paraguayan Guaraní"},
+	"gug": {Part3: "gug", Scope: 'I', LanguageType: 'L', Name: "Paraguayan Guaraní", MacrolanguageCode: "grn"},
 	"guh": {Part3: "guh", Scope: 'I', LanguageType: 'L', Name: "Guahibo"},
-	"gui": {Part3: "gui", Scope: 'I', LanguageType: 'L', Name: "Eastern Bolivian Guaraní"},
+	"gui": {Part3: "gui", Scope: 'I', LanguageType: 'L', Name: "Eastern Bolivian Guaraní", MacrolanguageCode: "grn"},
 	"guj": {Part3: "guj", Part2B: "guj", Part2T: "guj", Part1: "gu", Scope: 'I', LanguageType: 'L', Name: "Gujarati"},
 	"guk": {Part3: "guk", Scope: 'I', LanguageType: 'L', Name: "Gumuz"},
 	"gul": {Part3: "gul", Scope: 'I', LanguageType: 'L', Name: "Sea Island Creole English"},
 	"gum": {Part3: "gum", Scope: 'I', LanguageType: 'L', Name: "Guambiano"},
-	"gun": {Part3: "gun", Scope: 'I', LanguageType: 'L', Name: "Mbyá Guaraní"},
+	"gun": {Part3: "gun", Scope: 'I', LanguageType: 'L', Name: "Mbyá Guaraní", MacrolanguageCode: "grn"},
 	"guo": {Part3: "guo", Scope: 'I', LanguageType: 'L', Name: "Guayabero"},
 	"gup": {Part3: "gup", Scope: 'I', LanguageType: 'L', Name: "Gunwinggu"},
 	"guq": {Part3: "guq", Scope: 'I', LanguageType: 'L', Name: "Aché"},
@@ -2303,7 +2310,7 @@ var LanguagesPart3 = map[string]Language{
 	"gww": {Part3: "gww", Scope: 'I', LanguageType: 'L', Name: "Kwini"},
 	"gwx": {Part3: "gwx", Scope: 'I', LanguageType: 'L', Name: "Gua"},
 	"gxx": {Part3: "gxx", Scope: 'I', LanguageType: 'L', Name: "Wè Southern"},
-	"gya": {Part3: "gya", Scope: 'I', LanguageType: 'L', Name: "Northwest Gbaya"},
+	"gya": {Part3: "gya", Scope: 'I', LanguageType: 'L', Name: "Northwest Gbaya", MacrolanguageCode: "gba"},
 	"gyb": {Part3: "gyb", Scope: 'I', LanguageType: 'L', Name: "Garus"},
 	"gyd": {Part3: "gyd", Scope: 'I', LanguageType: 'L', Name: "Kayardild"},
 	"gye": {Part3: "gye", Scope: 'I', LanguageType: 'L', Name: "Gyem"},
@@ -2324,13 +2331,13 @@ var LanguagesPart3 = map[string]Language{
 	"hab": {Part3: "hab", Scope: 'I', LanguageType: 'L', Name: "Hanoi Sign Language"},
 	"hac": {Part3: "hac", Scope: 'I', LanguageType: 'L', Name: "Gurani"},
 	"had": {Part3: "had", Scope: 'I', LanguageType: 'L', Name: "Hatam"},
-	"hae": {Part3: "hae", Scope: 'I', LanguageType: 'L', Name: "Eastern Oromo"},
+	"hae": {Part3: "hae", Scope: 'I', LanguageType: 'L', Name: "Eastern Oromo", MacrolanguageCode: "orm"},
 	"haf": {Part3: "haf", Scope: 'I', LanguageType: 'L', Name: "Haiphong Sign Language"},
 	"hag": {Part3: "hag", Scope: 'I', LanguageType: 'L', Name: "Hanga"},
 	"hah": {Part3: "hah", Scope: 'I', LanguageType: 'L', Name: "Hahon"},
 	"hai": {Part3: "hai", Part2B: "hai", Part2T: "hai", Scope: 'M', LanguageType: 'L', Name: "Haida"},
 	"haj": {Part3: "haj", Scope: 'I', LanguageType: 'L', Name: "Hajong"},
-	"hak": {Part3: "hak", Scope: 'I', LanguageType: 'L', Name: "Hakka Chinese"},
+	"hak": {Part3: "hak", Scope: 'I', LanguageType: 'L', Name: "Hakka Chinese", MacrolanguageCode: "zho"},
 	"hal": {Part3: "hal", Scope: 'I', LanguageType: 'L', Name: "Halang"},
 	"ham": {Part3: "ham", Scope: 'I', LanguageType: 'L', Name: "Hewa"},
 	"han": {Part3: "han", Scope: 'I', LanguageType: 'L', Name: "Hangaza"},
@@ -2343,7 +2350,7 @@ var LanguagesPart3 = map[string]Language{
 	"hau": {Part3: "hau", Part2B: "hau", Part2T: "hau", Part1: "ha", Scope: 'I', LanguageType: 'L', Name: "Hausa"},
 	"hav": {Part3: "hav", Scope: 'I', LanguageType: 'L', Name: "Havu"},
 	"haw": {Part3: "haw", Part2B: "haw", Part2T: "haw", Scope: 'I', LanguageType: 'L', Name: "Hawaiian"},
-	"hax": {Part3: "hax", Scope: 'I', LanguageType: 'L', Name: "Southern Haida"},
+	"hax": {Part3: "hax", Scope: 'I', LanguageType: 'L', Name: "Southern Haida", MacrolanguageCode: "hai"},
 	"hay": {Part3: "hay", Scope: 'I', LanguageType: 'L', Name: "Haya"},
 	"haz": {Part3: "haz", Scope: 'I', LanguageType: 'L', Name: "Hazaragi"},
 	"hba": {Part3: "hba", Scope: 'I', LanguageType: 'L', Name: "Hamba"},
@@ -2354,10 +2361,10 @@ var LanguagesPart3 = map[string]Language{
 	"hbu": {Part3: "hbu", Scope: 'I', LanguageType: 'L', Name: "Habu"},
 	"hca": {Part3: "hca", Scope: 'I', LanguageType: 'L', Name: "Andaman Creole Hindi"},
 	"hch": {Part3: "hch", Scope: 'I', LanguageType: 'L', Name: "Huichol"},
-	"hdn": {Part3: "hdn", Scope: 'I', LanguageType: 'L', Name: "Northern Haida"},
+	"hdn": {Part3: "hdn", Scope: 'I', LanguageType: 'L', Name: "Northern Haida", MacrolanguageCode: "hai"},
 	"hds": {Part3: "hds", Scope: 'I', LanguageType: 'L', Name: "Honduras Sign Language"},
 	"hdy": {Part3: "hdy", Scope: 'I', LanguageType: 'L', Name: "Hadiyya"},
-	"hea": {Part3: "hea", Scope: 'I', LanguageType: 'L', Name: "Northern Qiandong Miao"},
+	"hea": {Part3: "hea", Scope: 'I', LanguageType: 'L', Name: "Northern Qiandong Miao", MacrolanguageCode: "hmn"},
 	"heb": {Part3: "heb", Part2B: "heb", Part2T: "heb", Part1: "he", Scope: 'I', LanguageType: 'L', Name: "Hebrew"},
 	"hed": {Part3: "hed", Scope: 'I', LanguageType: 'L', Name: "Herdé"},
 	"heg": {Part3: "heg", Scope: 'I', LanguageType: 'L', Name: "Helong"},
@@ -2386,7 +2393,7 @@ var LanguagesPart3 = map[string]Language{
 	"hit": {Part3: "hit", Part2B: "hit", Part2T: "hit", Scope: 'I', LanguageType: 'A', Name: "Hittite"},
 	"hiw": {Part3: "hiw", Scope: 'I', LanguageType: 'L', Name: "Hiw"},
 	"hix": {Part3: "hix", Scope: 'I', LanguageType: 'L', Name: "Hixkaryána"},
-	"hji": {Part3: "hji", Scope: 'I', LanguageType: 'L', Name: "Haji"},
+	"hji": {Part3: "hji", Scope: 'I', LanguageType: 'L', Name: "Haji", MacrolanguageCode: "msa"},
 	"hka": {Part3: "hka", Scope: 'I', LanguageType: 'L', Name: "Kahe"},
 	"hke": {Part3: "hke", Scope: 'I', LanguageType: 'L', Name: "Hunde"},
 	"hkh": {Part3: "hkh", Scope: 'I', LanguageType: 'L', Name: "Khah"},
@@ -2399,40 +2406,40 @@ var LanguagesPart3 = map[string]Language{
 	"hle": {Part3: "hle", Scope: 'I', LanguageType: 'L', Name: "Hlersu"},
 	"hlt": {Part3: "hlt", Scope: 'I', LanguageType: 'L', Name: "Matu Chin"},
 	"hlu": {Part3: "hlu", Scope: 'I', LanguageType: 'A', Name: "Hieroglyphic Luwian"},
-	"hma": {Part3: "hma", Scope: 'I', LanguageType: 'L', Name: "Southern Mashan Hmong"},
+	"hma": {Part3: "hma", Scope: 'I', LanguageType: 'L', Name: "Southern Mashan Hmong", MacrolanguageCode: "hmn"},
 	"hmb": {Part3: "hmb", Scope: 'I', LanguageType: 'L', Name: "Humburi Senni Songhay"},
-	"hmc": {Part3: "hmc", Scope: 'I', LanguageType: 'L', Name: "Central Huishui Hmong"},
-	"hmd": {Part3: "hmd", Scope: 'I', LanguageType: 'L', Name: "Large Flowery Miao"},
-	"hme": {Part3: "hme", Scope: 'I', LanguageType: 'L', Name: "Eastern Huishui Hmong"},
+	"hmc": {Part3: "hmc", Scope: 'I', LanguageType: 'L', Name: "Central Huishui Hmong", MacrolanguageCode: "hmn"},
+	"hmd": {Part3: "hmd", Scope: 'I', LanguageType: 'L', Name: "Large Flowery Miao", MacrolanguageCode: "hmn"},
+	"hme": {Part3: "hme", Scope: 'I', LanguageType: 'L', Name: "Eastern Huishui Hmong", MacrolanguageCode: "hmn"},
 	"hmf": {Part3: "hmf", Scope: 'I', LanguageType: 'L', Name: "Hmong Don"},
-	"hmg": {Part3: "hmg", Scope: 'I', LanguageType: 'L', Name: "Southwestern Guiyang Hmong"},
-	"hmh": {Part3: "hmh", Scope: 'I', LanguageType: 'L', Name: "Southwestern Huishui Hmong"},
-	"hmi": {Part3: "hmi", Scope: 'I', LanguageType: 'L', Name: "Northern Huishui Hmong"},
-	"hmj": {Part3: "hmj", Scope: 'I', LanguageType: 'L', Name: "Ge"},
+	"hmg": {Part3: "hmg", Scope: 'I', LanguageType: 'L', Name: "Southwestern Guiyang Hmong", MacrolanguageCode: "hmn"},
+	"hmh": {Part3: "hmh", Scope: 'I', LanguageType: 'L', Name: "Southwestern Huishui Hmong", MacrolanguageCode: "hmn"},
+	"hmi": {Part3: "hmi", Scope: 'I', LanguageType: 'L', Name: "Northern Huishui Hmong", MacrolanguageCode: "hmn"},
+	"hmj": {Part3: "hmj", Scope: 'I', LanguageType: 'L', Name: "Ge", MacrolanguageCode: "hmn"},
 	"hmk": {Part3: "hmk", Scope: 'I', LanguageType: 'A', Name: "Maek"},
-	"hml": {Part3: "hml", Scope: 'I', LanguageType: 'L', Name: "Luopohe Hmong"},
-	"hmm": {Part3: "hmm", Scope: 'I', LanguageType: 'L', Name: "Central Mashan Hmong"},
+	"hml": {Part3: "hml", Scope: 'I', LanguageType: 'L', Name: "Luopohe Hmong", MacrolanguageCode: "hmn"},
+	"hmm": {Part3: "hmm", Scope: 'I', LanguageType: 'L', Name: "Central Mashan Hmong", MacrolanguageCode: "hmn"},
 	"hmn": {Part3: "hmn", Part2B: "hmn", Part2T: "hmn", Scope: 'M', LanguageType: 'L', Name: "Hmong"},
 	"hmo": {Part3: "hmo", Part2B: "hmo", Part2T: "hmo", Part1: "ho", Scope: 'I', LanguageType: 'L', Name: "Hiri Motu"},
-	"hmp": {Part3: "hmp", Scope: 'I', LanguageType: 'L', Name: "Northern Mashan Hmong"},
-	"hmq": {Part3: "hmq", Scope: 'I', LanguageType: 'L', Name: "Eastern Qiandong Miao"},
+	"hmp": {Part3: "hmp", Scope: 'I', LanguageType: 'L', Name: "Northern Mashan Hmong", MacrolanguageCode: "hmn"},
+	"hmq": {Part3: "hmq", Scope: 'I', LanguageType: 'L', Name: "Eastern Qiandong Miao", MacrolanguageCode: "hmn"},
 	"hmr": {Part3: "hmr", Scope: 'I', LanguageType: 'L', Name: "Hmar"},
-	"hms": {Part3: "hms", Scope: 'I', LanguageType: 'L', Name: "Southern Qiandong Miao"},
+	"hms": {Part3: "hms", Scope: 'I', LanguageType: 'L', Name: "Southern Qiandong Miao", MacrolanguageCode: "hmn"},
 	"hmt": {Part3: "hmt", Scope: 'I', LanguageType: 'L', Name: "Hamtai"},
 	"hmu": {Part3: "hmu", Scope: 'I', LanguageType: 'L', Name: "Hamap"},
 	"hmv": {Part3: "hmv", Scope: 'I', LanguageType: 'L', Name: "Hmong Dô"},
-	"hmw": {Part3: "hmw", Scope: 'I', LanguageType: 'L', Name: "Western Mashan Hmong"},
-	"hmy": {Part3: "hmy", Scope: 'I', LanguageType: 'L', Name: "Southern Guiyang Hmong"},
-	"hmz": {Part3: "hmz", Scope: 'I', LanguageType: 'L', Name: "Hmong Shua"},
+	"hmw": {Part3: "hmw", Scope: 'I', LanguageType: 'L', Name: "Western Mashan Hmong", MacrolanguageCode: "hmn"},
+	"hmy": {Part3: "hmy", Scope: 'I', LanguageType: 'L', Name: "Southern Guiyang Hmong", MacrolanguageCode: "hmn"},
+	"hmz": {Part3: "hmz", Scope: 'I', LanguageType: 'L', Name: "Hmong Shua", MacrolanguageCode: "hmn"},
 	"hna": {Part3: "hna", Scope: 'I', LanguageType: 'L', Name: "Mina (Cameroon)"},
-	"hnd": {Part3: "hnd", Scope: 'I', LanguageType: 'L', Name: "Southern Hindko"},
+	"hnd": {Part3: "hnd", Scope: 'I', LanguageType: 'L', Name: "Southern Hindko", MacrolanguageCode: "lah"},
 	"hne": {Part3: "hne", Scope: 'I', LanguageType: 'L', Name: "Chhattisgarhi"},
 	"hng": {Part3: "hng", Scope: 'I', LanguageType: 'L', Name: "Hungu"},
 	"hnh": {Part3: "hnh", Scope: 'I', LanguageType: 'L', Name: "ǁAni"},
 	"hni": {Part3: "hni", Scope: 'I', LanguageType: 'L', Name: "Hani"},
-	"hnj": {Part3: "hnj", Scope: 'I', LanguageType: 'L', Name: "Hmong Njua"},
+	"hnj": {Part3: "hnj", Scope: 'I', LanguageType: 'L', Name: "Hmong Njua", MacrolanguageCode: "hmn"},
 	"hnn": {Part3: "hnn", Scope: 'I', LanguageType: 'L', Name: "Hanunoo"},
-	"hno": {Part3: "hno", Scope: 'I', LanguageType: 'L', Name: "Northern Hindko"},
+	"hno": {Part3: "hno", Scope: 'I', LanguageType: 'L', Name: "Northern Hindko", MacrolanguageCode: "lah"},
 	"hns": {Part3: "hns", Scope: 'I', LanguageType: 'L', Name: "Caribbean Hindustani"},
 	"hnu": {Part3: "hnu", Scope: 'I', LanguageType: 'L', Name: "Hung"},
 	"hoa": {Part3: "hoa", Scope: 'I', LanguageType: 'L', Name: "Hoava"},
@@ -2442,7 +2449,7 @@ var LanguagesPart3 = map[string]Language{
 	"hoe": {Part3: "hoe", Scope: 'I', LanguageType: 'L', Name: "Horom"},
 	"hoh": {Part3: "hoh", Scope: 'I', LanguageType: 'L', Name: "Hobyót"},
 	"hoi": {Part3: "hoi", Scope: 'I', LanguageType: 'L', Name: "Holikachuk"},
-	"hoj": {Part3: "hoj", Scope: 'I', LanguageType: 'L', Name: "Hadothi"},
+	"hoj": {Part3: "hoj", Scope: 'I', LanguageType: 'L', Name: "Hadothi", MacrolanguageCode: "raj"},
 	"hol": {Part3: "hol", Scope: 'I', LanguageType: 'L', Name: "Holu"},
 	"hom": {Part3: "hom", Scope: 'I', LanguageType: 'E', Name: "Homa"},
 	"hoo": {Part3: "hoo", Scope: 'I', LanguageType: 'L', Name: "Holoholo"},
@@ -2460,19 +2467,19 @@ var LanguagesPart3 = map[string]Language{
 	"hrc": {Part3: "hrc", Scope: 'I', LanguageType: 'L', Name: "Niwer Mil"},
 	"hre": {Part3: "hre", Scope: 'I', LanguageType: 'L', Name: "Hre"},
 	"hrk": {Part3: "hrk", Scope: 'I', LanguageType: 'L', Name: "Haruku"},
-	"hrm": {Part3: "hrm", Scope: 'I', LanguageType: 'L', Name: "Horned Miao"},
+	"hrm": {Part3: "hrm", Scope: 'I', LanguageType: 'L', Name: "Horned Miao", MacrolanguageCode: "hmn"},
 	"hro": {Part3: "hro", Scope: 'I', LanguageType: 'L', Name: "Haroi"},
 	"hrp": {Part3: "hrp", Scope: 'I', LanguageType: 'E', Name: "Nhirrpi"},
 	"hrt": {Part3: "hrt", Scope: 'I', LanguageType: 'L', Name: "Hértevin"},
 	"hru": {Part3: "hru", Scope: 'I', LanguageType: 'L', Name: "Hruso"},
-	"hrv": {Part3: "hrv", Part2B: "hrv", Part2T: "hrv", Part1: "hr", Scope: 'I', LanguageType: 'L', Name: "Croatian"},
+	"hrv": {Part3: "hrv", Part2B: "hrv", Part2T: "hrv", Part1: "hr", Scope: 'I', LanguageType: 'L', Name: "Croatian", MacrolanguageCode: "hbs"},
 	"hrw": {Part3: "hrw", Scope: 'I', LanguageType: 'L', Name: "Warwar Feni"},
 	"hrx": {Part3: "hrx", Scope: 'I', LanguageType: 'L', Name: "Hunsrik"},
 	"hrz": {Part3: "hrz", Scope: 'I', LanguageType: 'L', Name: "Harzani"},
 	"hsb": {Part3: "hsb", Part2B: "hsb", Part2T: "hsb", Scope: 'I', LanguageType: 'L', Name: "Upper Sorbian"},
 	"hsh": {Part3: "hsh", Scope: 'I', LanguageType: 'L', Name: "Hungarian Sign Language"},
 	"hsl": {Part3: "hsl", Scope: 'I', LanguageType: 'L', Name: "Hausa Sign Language"},
-	"hsn": {Part3: "hsn", Scope: 'I', LanguageType: 'L', Name: "Xiang Chinese"},
+	"hsn": {Part3: "hsn", Scope: 'I', LanguageType: 'L', Name: "Xiang Chinese", MacrolanguageCode: "zho"},
 	"hss": {Part3: "hss", Scope: 'I', LanguageType: 'L', Name: "Harsusi"},
 	"hti": {Part3: "hti", Scope: 'I', LanguageType: 'E', Name: "Hoti"},
 	"hto": {Part3: "hto", Scope: 'I', LanguageType: 'L', Name: "Minica Huitoto"},
@@ -2487,7 +2494,7 @@ var LanguagesPart3 = map[string]Language{
 	"hug": {Part3: "hug", Scope: 'I', LanguageType: 'L', Name: "Huachipaeri"},
 	"huh": {Part3: "huh", Scope: 'I', LanguageType: 'L', Name: "Huilliche"},
 	"hui": {Part3: "hui", Scope: 'I', LanguageType: 'L', Name: "Huli"},
-	"huj": {Part3: "huj", Scope: 'I', LanguageType: 'L', Name: "Northern Guiyang Hmong"},
+	"huj": {Part3: "huj", Scope: 'I', LanguageType: 'L', Name: "Northern Guiyang Hmong", MacrolanguageCode: "hmn"},
 	"huk": {Part3: "huk", Scope: 'I', LanguageType: 'E', Name: "Hulung"},
 	"hul": {Part3: "hul", Scope: 'I', LanguageType: 'L', Name: "Hula"},
 	"hum": {Part3: "hum", Scope: 'I', LanguageType: 'L', Name: "Hungana"},
@@ -2535,7 +2542,7 @@ var LanguagesPart3 = map[string]Language{
 	"ich": {Part3: "ich", Scope: 'I', LanguageType: 'L', Name: "Etkywan"},
 	"icl": {Part3: "icl", Scope: 'I', LanguageType: 'L', Name: "Icelandic Sign Language"},
 	"icr": {Part3: "icr", Scope: 'I', LanguageType: 'L', Name: "Islander Creole English"},
-	"ida": {Part3: "ida", Scope: 'I', LanguageType: 'L', Name: "Idakho-Isukha-Tiriki"},
+	"ida": {Part3: "ida", Scope: 'I', LanguageType: 'L', Name: "Idakho-Isukha-Tiriki", MacrolanguageCode: "luy"},
 	"idb": {Part3: "idb", Scope: 'I', LanguageType: 'L', Name: "Indo-Portuguese"},
 	"idc": {Part3: "idc", Scope: 'I', LanguageType: 'L', Name: "Idon"},
 	"idd": {Part3: "idd", Scope: 'I', LanguageType: 'L', Name: "Ede Idaca"},
@@ -2574,7 +2581,7 @@ var LanguagesPart3 = map[string]Language{
 	"ijj": {Part3: "ijj", Scope: 'I', LanguageType: 'L', Name: "Ede Ije"},
 	"ijn": {Part3: "ijn", Scope: 'I', LanguageType: 'L', Name: "Kalabari"},
 	"ijs": {Part3: "ijs", Scope: 'I', LanguageType: 'L', Name: "Southeast Ijo"},
-	"ike": {Part3: "ike", Scope: 'I', LanguageType: 'L', Name: "Eastern Canadian Inuktitut"},
+	"ike": {Part3: "ike", Scope: 'I', LanguageType: 'L', Name: "Eastern Canadian Inuktitut", MacrolanguageCode: "iku"},
 	"iki": {Part3: "iki", Scope: 'I', LanguageType: 'L', Name: "Iko"},
 	"ikk": {Part3: "ikk", Scope: 'I', LanguageType: 'L', Name: "Ika"},
 	"ikl": {Part3: "ikl", Scope: 'I', LanguageType: 'L', Name: "Ikulu"},
@@ -2582,7 +2589,7 @@ var LanguagesPart3 = map[string]Language{
 	"ikp": {Part3: "ikp", Scope: 'I', LanguageType: 'L', Name: "Ikpeshi"},
 	"ikr": {Part3: "ikr", Scope: 'I', LanguageType: 'E', Name: "Ikaranggal"},
 	"iks": {Part3: "iks", Scope: 'I', LanguageType: 'L', Name: "Inuit Sign Language"},
-	"ikt": {Part3: "ikt", Scope: 'I', LanguageType: 'L', Name: "Inuinnaqtun"},
+	"ikt": {Part3: "ikt", Scope: 'I', LanguageType: 'L', Name: "Inuinnaqtun", MacrolanguageCode: "iku"},
 	"iku": {Part3: "iku", Part2B: "iku", Part2T: "iku", Part1: "iu", Scope: 'M', LanguageType: 'L', Name: "Inuktitut"},
 	"ikv": {Part3: "ikv", Scope: 'I', LanguageType: 'L', Name: "Iku-Gora-Ankwa"},
 	"ikw": {Part3: "ikw", Scope: 'I', LanguageType: 'L', Name: "Ikwere"},
@@ -2610,7 +2617,7 @@ var LanguagesPart3 = map[string]Language{
 	"imy": {Part3: "imy", Scope: 'I', LanguageType: 'A', Name: "Milyan"},
 	"ina": {Part3: "ina", Part2B: "ina", Part2T: "ina", Part1: "ia", Scope: 'I', LanguageType: 'C', Name: "Interlingua (International Auxiliary Language Association)"},
 	"inb": {Part3: "inb", Scope: 'I', LanguageType: 'L', Name: "Inga"},
-	"ind": {Part3: "ind", Part2B: "ind", Part2T: "ind", Part1: "id", Scope: 'I', LanguageType: 'L', Name: "Indonesian"},
+	"ind": {Part3: "ind", Part2B: "ind", Part2T: "ind", Part1: "id", Scope: 'I', LanguageType: 'L', Name: "Indonesian", MacrolanguageCode: "msa"},
 	"ing": {Part3: "ing", Scope: 'I', LanguageType: 'L', Name: "Degexit'an"},
 	"inh": {Part3: "inh", Part2B: "inh", Part2T: "inh", Scope: 'I', LanguageType: 'L', Name: "Ingush"},
 	"inj": {Part3: "inj", Scope: 'I', LanguageType: 'L', Name: "Jungle Inga"},
@@ -2694,17 +2701,17 @@ var LanguagesPart3 = map[string]Language{
 	"jaf": {Part3: "jaf", Scope: 'I', LanguageType: 'L', Name: "Jara"},
 	"jah": {Part3: "jah", Scope: 'I', LanguageType: 'L', Name: "Jah Hut"},
 	"jaj": {Part3: "jaj", Scope: 'I', LanguageType: 'L', Name: "Zazao"},
-	"jak": {Part3: "jak", Scope: 'I', LanguageType: 'L', Name: "Jakun"},
+	"jak": {Part3: "jak", Scope: 'I', LanguageType: 'L', Name: "Jakun", MacrolanguageCode: "msa"},
 	"jal": {Part3: "jal", Scope: 'I', LanguageType: 'L', Name: "Yalahatan"},
 	"jam": {Part3: "jam", Scope: 'I', LanguageType: 'L', Name: "Jamaican Creole English"},
 	"jan": {Part3: "jan", Scope: 'I', LanguageType: 'E', Name: "Jandai"},
 	"jao": {Part3: "jao", Scope: 'I', LanguageType: 'L', Name: "Yanyuwa"},
 	"jaq": {Part3: "jaq", Scope: 'I', LanguageType: 'L', Name: "Yaqay"},
 	"jas": {Part3: "jas", Scope: 'I', LanguageType: 'L', Name: "New Caledonian Javanese"},
-	"jat": {Part3: "jat", Scope: 'I', LanguageType: 'L', Name: "Jakati"},
+	"jat": {Part3: "jat", Scope: 'I', LanguageType: 'L', Name: "Jakati", MacrolanguageCode: "lah"},
 	"jau": {Part3: "jau", Scope: 'I', LanguageType: 'L', Name: "Yaur"},
 	"jav": {Part3: "jav", Part2B: "jav", Part2T: "jav", Part1: "jv", Scope: 'I', LanguageType: 'L', Name: "Javanese"},
-	"jax": {Part3: "jax", Scope: 'I', LanguageType: 'L', Name: "Jambi Malay"},
+	"jax": {Part3: "jax", Scope: 'I', LanguageType: 'L', Name: "Jambi Malay", MacrolanguageCode: "msa"},
 	"jay": {Part3: "jay", Scope: 'I', LanguageType: 'L', Name: "Yan-nhangu"},
 	"jaz": {Part3: "jaz", Scope: 'I', LanguageType: 'L', Name: "Jawe"},
 	"jbe": {Part3: "jbe", Scope: 'I', LanguageType: 'L', Name: "Judeo-Berber"},
@@ -2822,7 +2829,7 @@ var LanguagesPart3 = map[string]Language{
 	"jvn": {Part3: "jvn", Scope: 'I', LanguageType: 'L', Name: "Caribbean Javanese"},
 	"jwi": {Part3: "jwi", Scope: 'I', LanguageType: 'L', Name: "Jwira-Pepesa"},
 	"jya": {Part3: "jya", Scope: 'I', LanguageType: 'L', Name: "Jiarong"},
-	"jye": {Part3: "jye", Scope: 'I', LanguageType: 'L', Name: "Judeo-Yemeni Arabic"},
+	"jye": {Part3: "jye", Scope: 'I', LanguageType: 'L', Name: "Judeo-Yemeni Arabic", MacrolanguageCode: "jrb"},
 	"jyy": {Part3: "jyy", Scope: 'I', LanguageType: 'L', Name: "Jaya"},
 	"kaa": {Part3: "kaa", Part2B: "kaa", Part2T: "kaa", Scope: 'I', LanguageType: 'L', Name: "Kara-Kalpak"},
 	"kab": {Part3: "kab", Part2B: "kab", Part2T: "kab", Scope: 'I', LanguageType: 'L', Name: "Kabyle"},
@@ -2872,7 +2879,7 @@ var LanguagesPart3 = map[string]Language{
 	"kbv": {Part3: "kbv", Scope: 'I', LanguageType: 'L', Name: "Dera (Indonesia)"},
 	"kbw": {Part3: "kbw", Scope: 'I', LanguageType: 'L', Name: "Kaiep"},
 	"kbx": {Part3: "kbx", Scope: 'I', LanguageType: 'L', Name: "Ap Ma"},
-	"kby": {Part3: "kby", Scope: 'I', LanguageType: 'L', Name: "Manga Kanuri"},
+	"kby": {Part3: "kby", Scope: 'I', LanguageType: 'L', Name: "Manga Kanuri", MacrolanguageCode: "kau"},
 	"kbz": {Part3: "kbz", Scope: 'I', LanguageType: 'L', Name: "Duhwa"},
 	"kca": {Part3: "kca", Scope: 'I', LanguageType: 'L', Name: "Khanty"},
 	"kcb": {Part3: "kcb", Scope: 'I', LanguageType: 'L', Name: "Kawacha"},
@@ -3005,7 +3012,7 @@ var LanguagesPart3 = map[string]Language{
 	"khg": {Part3: "khg", Scope: 'I', LanguageType: 'L', Name: "Khams Tibetan"},
 	"khh": {Part3: "khh", Scope: 'I', LanguageType: 'L', Name: "Kehu"},
 	"khj": {Part3: "khj", Scope: 'I', LanguageType: 'L', Name: "Kuturmi"},
-	"khk": {Part3: "khk", Scope: 'I', LanguageType: 'L', Name: "Halh Mongolian"},
+	"khk": {Part3: "khk", Scope: 'I', LanguageType: 'L', Name: "Halh Mongolian", MacrolanguageCode: "mon"},
 	"khl": {Part3: "khl", Scope: 'I', LanguageType: 'L', Name: "Lusi"},
 	"khm": {Part3: "khm", Part2B: "khm", Part2T: "khm", Part1: "km", Scope: 'I', LanguageType: 'L', Name: "Khmer"},
 	"khn": {Part3: "khn", Scope: 'I', LanguageType: 'L', Name: "Khandesi"},
@@ -3041,7 +3048,7 @@ var LanguagesPart3 = map[string]Language{
 	"kir": {Part3: "kir", Part2B: "kir", Part2T: "kir", Part1: "ky", Scope: 'I', LanguageType: 'L', Name: "Kirghiz"},
 	"kis": {Part3: "kis", Scope: 'I', LanguageType: 'L', Name: "Kis"},
 	"kit": {Part3: "kit", Scope: 'I', LanguageType: 'L', Name: "Agob"},
-	"kiu": {Part3: "kiu", Scope: 'I', LanguageType: 'L', Name: "Kirmanjki (individual language)"},
+	"kiu": {Part3: "kiu", Scope: 'I', LanguageType: 'L', Name: "Kirmanjki (individual language)", MacrolanguageCode: "zza"},
 	"kiv": {Part3: "kiv", Scope: 'I', LanguageType: 'L', Name: "Kimbu"},
 	"kiw": {Part3: "kiw", Scope: 'I', LanguageType: 'L', Name: "Northeast Kiwai"},
 	"kix": {Part3: "kix", Scope: 'I', LanguageType: 'L', Name: "Khiamniungan Naga"},
@@ -3140,7 +3147,7 @@ var LanguagesPart3 = map[string]Language{
 	"kmo": {Part3: "kmo", Scope: 'I', LanguageType: 'L', Name: "Kwoma"},
 	"kmp": {Part3: "kmp", Scope: 'I', LanguageType: 'L', Name: "Gimme"},
 	"kmq": {Part3: "kmq", Scope: 'I', LanguageType: 'L', Name: "Kwama"},
-	"kmr": {Part3: "kmr", Scope: 'I', LanguageType: 'L', Name: "Northern Kurdish"},
+	"kmr": {Part3: "kmr", Scope: 'I', LanguageType: 'L', Name: "Northern Kurdish", MacrolanguageCode: "kur"},
 	"kms": {Part3: "kms", Scope: 'I', LanguageType: 'L', Name: "Kamasau"},
 	"kmt": {Part3: "kmt", Scope: 'I', LanguageType: 'L', Name: "Kemtuik"},
 	"kmu": {Part3: "kmu", Scope: 'I', LanguageType: 'L', Name: "Kanite"},
@@ -3151,17 +3158,17 @@ var LanguagesPart3 = map[string]Language{
 	"kmz": {Part3: "kmz", Scope: 'I', LanguageType: 'L', Name: "Khorasani Turkish"},
 	"kna": {Part3: "kna", Scope: 'I', LanguageType: 'L', Name: "Dera (Nigeria)"},
 	"knb": {Part3: "knb", Scope: 'I', LanguageType: 'L', Name: "Lubuagan Kalinga"},
-	"knc": {Part3: "knc", Scope: 'I', LanguageType: 'L', Name: "Central Kanuri"},
+	"knc": {Part3: "knc", Scope: 'I', LanguageType: 'L', Name: "Central Kanuri", MacrolanguageCode: "kau"},
 	"knd": {Part3: "knd", Scope: 'I', LanguageType: 'L', Name: "Konda"},
 	"kne": {Part3: "kne", Scope: 'I', LanguageType: 'L', Name: "Kankanaey"},
 	"knf": {Part3: "knf", Scope: 'I', LanguageType: 'L', Name: "Mankanya"},
-	"kng": {Part3: "kng", Scope: 'I', LanguageType: 'L', Name: "Koongo"},
+	"kng": {Part3: "kng", Scope: 'I', LanguageType: 'L', Name: "Koongo", MacrolanguageCode: "kon"},
 	"kni": {Part3: "kni", Scope: 'I', LanguageType: 'L', Name: "Kanufi"},
 	"knj": {Part3: "knj", Scope: 'I', LanguageType: 'L', Name: "Western Kanjobal"},
 	"knk": {Part3: "knk", Scope: 'I', LanguageType: 'L', Name: "Kuranko"},
 	"knl": {Part3: "knl", Scope: 'I', LanguageType: 'L', Name: "Keninjal"},
 	"knm": {Part3: "knm", Scope: 'I', LanguageType: 'L', Name: "Kanamarí"},
-	"knn": {Part3: "knn", Scope: 'I', LanguageType: 'L', Name: "Konkani (individual language)"},
+	"knn": {Part3: "knn", Scope: 'I', LanguageType: 'L', Name: "Konkani (individual language)", MacrolanguageCode: "kok"},
 	"kno": {Part3: "kno", Scope: 'I', LanguageType: 'L', Name: "Kono (Sierra Leone)"},
 	"knp": {Part3: "knp", Scope: 'I', LanguageType: 'L', Name: "Kwanja"},
 	"knq": {Part3: "knq", Scope: 'I', LanguageType: 'L', Name: "Kintaq"},
@@ -3181,7 +3188,7 @@ var LanguagesPart3 = map[string]Language{
 	"kof": {Part3: "kof", Scope: 'I', LanguageType: 'E', Name: "Kubi"},
 	"kog": {Part3: "kog", Scope: 'I', LanguageType: 'L', Name: "Cogui"},
 	"koh": {Part3: "koh", Scope: 'I', LanguageType: 'L', Name: "Koyo"},
-	"koi": {Part3: "koi", Scope: 'I', LanguageType: 'L', Name: "Komi-Permyak"},
+	"koi": {Part3: "koi", Scope: 'I', LanguageType: 'L', Name: "Komi-Permyak", MacrolanguageCode: "kom"},
 	"kok": {Part3: "kok", Part2B: "kok", Part2T: "kok", Scope: 'M', LanguageType: 'L', Name: "Konkani (macrolanguage)"},
 	"kol": {Part3: "kol", Scope: 'I', LanguageType: 'L', Name: "Kol (Papua New Guinea)"},
 	"kom": {Part3: "kom", Part2B: "kom", Part2T: "kom", Part1: "kv", Scope: 'M', LanguageType: 'L', Name: "Komi"},
@@ -3217,7 +3224,7 @@ var LanguagesPart3 = map[string]Language{
 	"kps": {Part3: "kps", Scope: 'I', LanguageType: 'L', Name: "Tehit"},
 	"kpt": {Part3: "kpt", Scope: 'I', LanguageType: 'L', Name: "Karata"},
 	"kpu": {Part3: "kpu", Scope: 'I', LanguageType: 'L', Name: "Kafoa"},
-	"kpv": {Part3: "kpv", Scope: 'I', LanguageType: 'L', Name: "Komi-Zyrian"},
+	"kpv": {Part3: "kpv", Scope: 'I', LanguageType: 'L', Name: "Komi-Zyrian", MacrolanguageCode: "kom"},
 	"kpw": {Part3: "kpw", Scope: 'I', LanguageType: 'L', Name: "Kobon"},
 	"kpx": {Part3: "kpx", Scope: 'I', LanguageType: 'L', Name: "Mountain Koiali"},
 	"kpy": {Part3: "kpy", Scope: 'I', LanguageType: 'L', Name: "Koryak"},
@@ -3263,7 +3270,7 @@ var LanguagesPart3 = map[string]Language{
 	"krp": {Part3: "krp", Scope: 'I', LanguageType: 'L', Name: "Korop"},
 	"krr": {Part3: "krr", Scope: 'I', LanguageType: 'L', Name: "Krung"},
 	"krs": {Part3: "krs", Scope: 'I', LanguageType: 'L', Name: "Gbaya (Sudan)"},
-	"krt": {Part3: "krt", Scope: 'I', LanguageType: 'L', Name: "Tumari Kanuri"},
+	"krt": {Part3: "krt", Scope: 'I', LanguageType: 'L', Name: "Tumari Kanuri", MacrolanguageCode: "kau"},
 	"kru": {Part3: "kru", Part2B: "kru", Part2T: "kru", Scope: 'I', LanguageType: 'L', Name: "Kurukh"},
 	"krv": {Part3: "krv", Scope: 'I', LanguageType: 'L', Name: "Kavet"},
 	"krw": {Part3: "krw", Scope: 'I', LanguageType: 'L', Name: "Western Krahn"},
@@ -3348,7 +3355,7 @@ var LanguagesPart3 = map[string]Language{
 	"kuy": {Part3: "kuy", Scope: 'I', LanguageType: 'L', Name: "Kuuku-Ya'u"},
 	"kuz": {Part3: "kuz", Scope: 'I', LanguageType: 'E', Name: "Kunza"},
 	"kva": {Part3: "kva", Scope: 'I', LanguageType: 'L', Name: "Bagvalal"},
-	"kvb": {Part3: "kvb", Scope: 'I', LanguageType: 'L', Name: "Kubu"},
+	"kvb": {Part3: "kvb", Scope: 'I', LanguageType: 'L', Name: "Kubu", MacrolanguageCode: "msa"},
 	"kvc": {Part3: "kvc", Scope: 'I', LanguageType: 'L', Name: "Kove"},
 	"kvd": {Part3: "kvd", Scope: 'I', LanguageType: 'L', Name: "Kui (Indonesia)"},
 	"kve": {Part3: "kve", Scope: 'I', LanguageType: 'L', Name: "Kalabakan"},
@@ -3364,7 +3371,7 @@ var LanguagesPart3 = map[string]Language{
 	"kvo": {Part3: "kvo", Scope: 'I', LanguageType: 'L', Name: "Dobel"},
 	"kvp": {Part3: "kvp", Scope: 'I', LanguageType: 'L', Name: "Kompane"},
 	"kvq": {Part3: "kvq", Scope: 'I', LanguageType: 'L', Name: "Geba Karen"},
-	"kvr": {Part3: "kvr", Scope: 'I', LanguageType: 'L', Name: "Kerinci"},
+	"kvr": {Part3: "kvr", Scope: 'I', LanguageType: 'L', Name: "Kerinci", MacrolanguageCode: "msa"},
 	"kvt": {Part3: "kvt", Scope: 'I', LanguageType: 'L', Name: "Lahta Karen"},
 	"kvu": {Part3: "kvu", Scope: 'I', LanguageType: 'L', Name: "Yinbaw Karen"},
 	"kvv": {Part3: "kvv", Scope: 'I', LanguageType: 'L', Name: "Kola"},
@@ -3395,12 +3402,12 @@ var LanguagesPart3 = map[string]Language{
 	"kwv": {Part3: "kwv", Scope: 'I', LanguageType: 'L', Name: "Sara Kaba Náà"},
 	"kww": {Part3: "kww", Scope: 'I', LanguageType: 'L', Name: "Kwinti"},
 	"kwx": {Part3: "kwx", Scope: 'I', LanguageType: 'L', Name: "Khirwar"},
-	"kwy": {Part3: "kwy", Scope: 'I', LanguageType: 'L', Name: "San Salvador Kongo"},
+	"kwy": {Part3: "kwy", Scope: 'I', LanguageType: 'L', Name: "San Salvador Kongo", MacrolanguageCode: "kon"},
 	"kwz": {Part3: "kwz", Scope: 'I', LanguageType: 'E', Name: "Kwadi"},
 	"kxa": {Part3: "kxa", Scope: 'I', LanguageType: 'L', Name: "Kairiru"},
 	"kxb": {Part3: "kxb", Scope: 'I', LanguageType: 'L', Name: "Krobu"},
 	"kxc": {Part3: "kxc", Scope: 'I', LanguageType: 'L', Name: "Konso"},
-	"kxd": {Part3: "kxd", Scope: 'I', LanguageType: 'L', Name: "Brunei"},
+	"kxd": {Part3: "kxd", Scope: 'I', LanguageType: 'L', Name: "Brunei", MacrolanguageCode: "msa"},
 	"kxf": {Part3: "kxf", Scope: 'I', LanguageType: 'L', Name: "Manumanaw Karen"},
 	"kxh": {Part3: "kxh", Scope: 'I', LanguageType: 'L', Name: "Karo (Ethiopia)"},
 	"kxi": {Part3: "kxi", Scope: 'I', LanguageType: 'L', Name: "Keningau Murut"},
@@ -3501,8 +3508,8 @@ var LanguagesPart3 = map[string]Language{
 	"lbg": {Part3: "lbg", Scope: 'I', LanguageType: 'L', Name: "Laopang"},
 	"lbi": {Part3: "lbi", Scope: 'I', LanguageType: 'L', Name: "La'bi"},
 	"lbj": {Part3: "lbj", Scope: 'I', LanguageType: 'L', Name: "Ladakhi"},
-	"lbk": {Part3: "lbk", Scope: 'I', LanguageType: 'L', Name: "Central Bontok"},
-	"lbl": {Part3: "lbl", Scope: 'I', LanguageType: 'L', Name: "Libon Bikol"},
+	"lbk": {Part3: "lbk", Scope: 'I', LanguageType: 'L', Name: "Central Bontok", MacrolanguageCode: "bnc"},
+	"lbl": {Part3: "lbl", Scope: 'I', LanguageType: 'L', Name: "Libon Bikol", MacrolanguageCode: "bik"},
 	"lbm": {Part3: "lbm", Scope: 'I', LanguageType: 'L', Name: "Lodhi"},
 	"lbn": {Part3: "lbn", Scope: 'I', LanguageType: 'L', Name: "Rmeet"},
 	"lbo": {Part3: "lbo", Scope: 'I', LanguageType: 'L', Name: "Laven"},
@@ -3518,8 +3525,8 @@ var LanguagesPart3 = map[string]Language{
 	"lbz": {Part3: "lbz", Scope: 'I', LanguageType: 'L', Name: "Lardil"},
 	"lcc": {Part3: "lcc", Scope: 'I', LanguageType: 'L', Name: "Legenyem"},
 	"lcd": {Part3: "lcd", Scope: 'I', LanguageType: 'L', Name: "Lola"},
-	"lce": {Part3: "lce", Scope: 'I', LanguageType: 'L', Name: "Loncong"},
-	"lcf": {Part3: "lcf", Scope: 'I', LanguageType: 'L', Name: "Lubu"},
+	"lce": {Part3: "lce", Scope: 'I', LanguageType: 'L', Name: "Loncong", MacrolanguageCode: "msa"},
+	"lcf": {Part3: "lcf", Scope: 'I', LanguageType: 'L', Name: "Lubu", MacrolanguageCode: "msa"},
 	"lch": {Part3: "lch", Scope: 'I', LanguageType: 'L', Name: "Luchazi"},
 	"lcl": {Part3: "lcl", Scope: 'I', LanguageType: 'L', Name: "Lisela"},
 	"lcm": {Part3: "lcm", Scope: 'I', LanguageType: 'L', Name: "Tungag"},
@@ -3531,7 +3538,7 @@ var LanguagesPart3 = map[string]Language{
 	"ldd": {Part3: "ldd", Scope: 'I', LanguageType: 'L', Name: "Luri"},
 	"ldg": {Part3: "ldg", Scope: 'I', LanguageType: 'L', Name: "Lenyima"},
 	"ldh": {Part3: "ldh", Scope: 'I', LanguageType: 'L', Name: "Lamja-Dengsa-Tola"},
-	"ldi": {Part3: "ldi", Scope: 'I', LanguageType: 'L', Name: "Laari"},
+	"ldi": {Part3: "ldi", Scope: 'I', LanguageType: 'L', Name: "Laari", MacrolanguageCode: "kon"},
 	"ldj": {Part3: "ldj", Scope: 'I', LanguageType: 'L', Name: "Lemoro"},
 	"ldk": {Part3: "ldk", Scope: 'I', LanguageType: 'L', Name: "Leelau"},
 	"ldl": {Part3: "ldl", Scope: 'I', LanguageType: 'L', Name: "Kaan"},
@@ -3612,7 +3619,7 @@ var LanguagesPart3 = map[string]Language{
 	"lit": {Part3: "lit", Part2B: "lit", Part2T: "lit", Part1: "lt", Scope: 'I', LanguageType: 'L', Name: "Lithuanian"},
 	"liu": {Part3: "liu", Scope: 'I', LanguageType: 'L', Name: "Logorik"},
 	"liv": {Part3: "liv", Scope: 'I', LanguageType: 'L', Name: "Liv"},
-	"liw": {Part3: "liw", Scope: 'I', LanguageType: 'L', Name: "Col"},
+	"liw": {Part3: "liw", Scope: 'I', LanguageType: 'L', Name: "Col", MacrolanguageCode: "msa"},
 	"lix": {Part3: "lix", Scope: 'I', LanguageType: 'L', Name: "Liabuku"},
 	"liy": {Part3: "liy", Scope: 'I', LanguageType: 'L', Name: "Banda-Bambari"},
 	"liz": {Part3: "liz", Scope: 'I', LanguageType: 'L', Name: "Libinza"},
@@ -3624,7 +3631,7 @@ var LanguagesPart3 = map[string]Language{
 	"ljw": {Part3: "ljw", Scope: 'I', LanguageType: 'L', Name: "Yirandali"},
 	"ljx": {Part3: "ljx", Scope: 'I', LanguageType: 'E', Name: "Yuru"},
 	"lka": {Part3: "lka", Scope: 'I', LanguageType: 'L', Name: "Lakalei"},
-	"lkb": {Part3: "lkb", Scope: 'I', LanguageType: 'L', Name: "Kabras"},
+	"lkb": {Part3: "lkb", Scope: 'I', LanguageType: 'L', Name: "Kabras", MacrolanguageCode: "luy"},
 	"lkc": {Part3: "lkc", Scope: 'I', LanguageType: 'L', Name: "Kucong"},
 	"lkd": {Part3: "lkd", Scope: 'I', LanguageType: 'L', Name: "Lakondê"},
 	"lke": {Part3: "lke", Scope: 'I', LanguageType: 'L', Name: "Kenyi"},
@@ -3634,9 +3641,9 @@ var LanguagesPart3 = map[string]Language{
 	"lkl": {Part3: "lkl", Scope: 'I', LanguageType: 'L', Name: "Laeko-Libuat"},
 	"lkm": {Part3: "lkm", Scope: 'I', LanguageType: 'E', Name: "Kalaamaya"},
 	"lkn": {Part3: "lkn", Scope: 'I', LanguageType: 'L', Name: "Lakon"},
-	"lko": {Part3: "lko", Scope: 'I', LanguageType: 'L', Name: "Khayo"},
+	"lko": {Part3: "lko", Scope: 'I', LanguageType: 'L', Name: "Khayo", MacrolanguageCode: "luy"},
 	"lkr": {Part3: "lkr", Scope: 'I', LanguageType: 'L', Name: "Päri"},
-	"lks": {Part3: "lks", Scope: 'I', LanguageType: 'L', Name: "Kisa"},
+	"lks": {Part3: "lks", Scope: 'I', LanguageType: 'L', Name: "Kisa", MacrolanguageCode: "luy"},
 	"lkt": {Part3: "lkt", Scope: 'I', LanguageType: 'L', Name: "Lakota"},
 	"lku": {Part3: "lku", Scope: 'I', LanguageType: 'E', Name: "Kungkari"},
 	"lky": {Part3: "lky", Scope: 'I', LanguageType: 'L', Name: "Lokoya"},
@@ -3730,10 +3737,10 @@ var LanguagesPart3 = map[string]Language{
 	"lrc": {Part3: "lrc", Scope: 'I', LanguageType: 'L', Name: "Northern Luri"},
 	"lre": {Part3: "lre", Scope: 'I', LanguageType: 'E', Name: "Laurentian"},
 	"lrg": {Part3: "lrg", Scope: 'I', LanguageType: 'E', Name: "Laragia"},
-	"lri": {Part3: "lri", Scope: 'I', LanguageType: 'L', Name: "Marachi"},
+	"lri": {Part3: "lri", Scope: 'I', LanguageType: 'L', Name: "Marachi", MacrolanguageCode: "luy"},
 	"lrk": {Part3: "lrk", Scope: 'I', LanguageType: 'L', Name: "Loarki"},
 	"lrl": {Part3: "lrl", Scope: 'I', LanguageType: 'L', Name: "Lari"},
-	"lrm": {Part3: "lrm", Scope: 'I', LanguageType: 'L', Name: "Marama"},
+	"lrm": {Part3: "lrm", Scope: 'I', LanguageType: 'L', Name: "Marama", MacrolanguageCode: "luy"},
 	"lrn": {Part3: "lrn", Scope: 'I', LanguageType: 'L', Name: "Lorang"},
 	"lro": {Part3: "lro", Scope: 'I', LanguageType: 'L', Name: "Laro"},
 	"lrr": {Part3: "lrr", Scope: 'I', LanguageType: 'L', Name: "Southern Yamphu"},
@@ -3747,7 +3754,7 @@ var LanguagesPart3 = map[string]Language{
 	"lsh": {Part3: "lsh", Scope: 'I', LanguageType: 'L', Name: "Lish"},
 	"lsi": {Part3: "lsi", Scope: 'I', LanguageType: 'L', Name: "Lashi"},
 	"lsl": {Part3: "lsl", Scope: 'I', LanguageType: 'L', Name: "Latvian Sign Language"},
-	"lsm": {Part3: "lsm", Scope: 'I', LanguageType: 'L', Name: "Saamia"},
+	"lsm": {Part3: "lsm", Scope: 'I', LanguageType: 'L', Name: "Saamia", MacrolanguageCode: "luy"},
 	"lsn": {Part3: "lsn", Scope: 'I', LanguageType: 'L', Name: "Tibetan Sign Language"},
 	"lso": {Part3: "lso", Scope: 'I', LanguageType: 'L', Name: "Laos Sign Language"},
 	"lsp": {Part3: "lsp", Scope: 'I', LanguageType: 'L', Name: "Panamanian Sign Language"},
@@ -3757,12 +3764,12 @@ var LanguagesPart3 = map[string]Language{
 	"lsv": {Part3: "lsv", Scope: 'I', LanguageType: 'L', Name: "Sivia Sign Language"},
 	"lsy": {Part3: "lsy", Scope: 'I', LanguageType: 'L', Name: "Mauritian Sign Language"},
 	"ltc": {Part3: "ltc", Scope: 'I', LanguageType: 'H', Name: "Late Middle Chinese"},
-	"ltg": {Part3: "ltg", Scope: 'I', LanguageType: 'L', Name: "Latgalian"},
+	"ltg": {Part3: "ltg", Scope: 'I', LanguageType: 'L', Name: "Latgalian", MacrolanguageCode: "lav"},
 	"lth": {Part3: "lth", Scope: 'I', LanguageType: 'L', Name: "Thur"},
 	"lti": {Part3: "lti", Scope: 'I', LanguageType: 'L', Name: "Leti (Indonesia)"},
 	"ltn": {Part3: "ltn", Scope: 'I', LanguageType: 'L', Name: "Latundê"},
-	"lto": {Part3: "lto", Scope: 'I', LanguageType: 'L', Name: "Tsotso"},
-	"lts": {Part3: "lts", Scope: 'I', LanguageType: 'L', Name: "Tachoni"},
+	"lto": {Part3: "lto", Scope: 'I', LanguageType: 'L', Name: "Tsotso", MacrolanguageCode: "luy"},
+	"lts": {Part3: "lts", Scope: 'I', LanguageType: 'L', Name: "Tachoni", MacrolanguageCode: "luy"},
 	"ltu": {Part3: "ltu", Scope: 'I', LanguageType: 'L', Name: "Latu"},
 	"ltz": {Part3: "ltz", Part2B: "ltz", Part2T: "ltz", Part1: "lb", Scope: 'I', LanguageType: 'L', Name: "Luxembourgish"},
 	"lua": {Part3: "lua", Part2B: "lua", Part2T: "lua", Scope: 'I', LanguageType: 'L', Name: "Luba-Lulua"},
@@ -3792,11 +3799,11 @@ var LanguagesPart3 = map[string]Language{
 	"lva": {Part3: "lva", Scope: 'I', LanguageType: 'L', Name: "Maku'a"},
 	"lvi": {Part3: "lvi", Scope: 'I', LanguageType: 'L', Name: "Lavi"},
 	"lvk": {Part3: "lvk", Scope: 'I', LanguageType: 'L', Name: "Lavukaleve"},
-	"lvs": {Part3: "lvs", Scope: 'I', LanguageType: 'L', Name: "Standard Latvian"},
+	"lvs": {Part3: "lvs", Scope: 'I', LanguageType: 'L', Name: "Standard Latvian", MacrolanguageCode: "lav"},
 	"lvu": {Part3: "lvu", Scope: 'I', LanguageType: 'L', Name: "Levuka"},
 	"lwa": {Part3: "lwa", Scope: 'I', LanguageType: 'L', Name: "Lwalu"},
 	"lwe": {Part3: "lwe", Scope: 'I', LanguageType: 'L', Name: "Lewo Eleng"},
-	"lwg": {Part3: "lwg", Scope: 'I', LanguageType: 'L', Name: "Wanga"},
+	"lwg": {Part3: "lwg", Scope: 'I', LanguageType: 'L', Name: "Wanga", MacrolanguageCode: "luy"},
 	"lwh": {Part3: "lwh", Scope: 'I', LanguageType: 'L', Name: "White Lachi"},
 	"lwl": {Part3: "lwl", Scope: 'I', LanguageType: 'L', Name: "Eastern Lawa"},
 	"lwm": {Part3: "lwm", Scope: 'I', LanguageType: 'L', Name: "Laomian"},
@@ -3809,7 +3816,7 @@ var LanguagesPart3 = map[string]Language{
 	"lya": {Part3: "lya", Scope: 'I', LanguageType: 'L', Name: "Layakha"},
 	"lyg": {Part3: "lyg", Scope: 'I', LanguageType: 'L', Name: "Lyngngam"},
 	"lyn": {Part3: "lyn", Scope: 'I', LanguageType: 'L', Name: "Luyana"},
-	"lzh": {Part3: "lzh", Scope: 'I', LanguageType: 'H', Name: "Literary Chinese"},
+	"lzh": {Part3: "lzh", Scope: 'I', LanguageType: 'H', Name: "Literary Chinese", MacrolanguageCode: "zho"},
 	"lzl": {Part3: "lzl", Scope: 'I', LanguageType: 'L', Name: "Litzlitz"},
 	"lzn": {Part3: "lzn", Scope: 'I', LanguageType: 'L', Name: "Leinong Naga"},
 	"lzz": {Part3: "lzz", Scope: 'I', LanguageType: 'L', Name: "Laz"},
@@ -3833,7 +3840,7 @@ var LanguagesPart3 = map[string]Language{
 	"mau": {Part3: "mau", Scope: 'I', LanguageType: 'L', Name: "Huautla Mazatec"},
 	"mav": {Part3: "mav", Scope: 'I', LanguageType: 'L', Name: "Sateré-Mawé"},
 	"maw": {Part3: "maw", Scope: 'I', LanguageType: 'L', Name: "Mampruli"},
-	"max": {Part3: "max", Scope: 'I', LanguageType: 'L', Name: "North Moluccan Malay"},
+	"max": {Part3: "max", Scope: 'I', LanguageType: 'L', Name: "North Moluccan Malay", MacrolanguageCode: "msa"},
 	"maz": {Part3: "maz", Scope: 'I', LanguageType: 'L', Name: "Central Mazahua"},
 	"mba": {Part3: "mba", Scope: 'I', LanguageType: 'L', Name: "Higaonon"},
 	"mbb": {Part3: "mbb", Scope: 'I', LanguageType: 'L', Name: "Western Bukidnon Manobo"},
@@ -3924,7 +3931,7 @@ var LanguagesPart3 = map[string]Language{
 	"mel": {Part3: "mel", Scope: 'I', LanguageType: 'L', Name: "Central Melanau"},
 	"mem": {Part3: "mem", Scope: 'I', LanguageType: 'E', Name: "Mangala"},
 	"men": {Part3: "men", Part2B: "men", Part2T: "men", Scope: 'I', LanguageType: 'L', Name: "Mende (Sierra Leone)"},
-	"meo": {Part3: "meo", Scope: 'I', LanguageType: 'L', Name: "Kedah Malay"},
+	"meo": {Part3: "meo", Scope: 'I', LanguageType: 'L', Name: "Kedah Malay", MacrolanguageCode: "msa"},
 	"mep": {Part3: "mep", Scope: 'I', LanguageType: 'L', Name: "Miriwoong"},
 	"meq": {Part3: "meq", Scope: 'I', LanguageType: 'L', Name: "Merey"},
 	"mer": {Part3: "mer", Scope: 'I', LanguageType: 'L', Name: "Meru"},
@@ -3935,8 +3942,8 @@ var LanguagesPart3 = map[string]Language{
 	"mew": {Part3: "mew", Scope: 'I', LanguageType: 'L', Name: "Maaka"},
 	"mey": {Part3: "mey", Scope: 'I', LanguageType: 'L', Name: "Hassaniyya"},
 	"mez": {Part3: "mez", Scope: 'I', LanguageType: 'L', Name: "Menominee"},
-	"mfa": {Part3: "mfa", Scope: 'I', LanguageType: 'L', Name: "Pattani Malay"},
-	"mfb": {Part3: "mfb", Scope: 'I', LanguageType: 'L', Name: "Bangka"},
+	"mfa": {Part3: "mfa", Scope: 'I', LanguageType: 'L', Name: "Pattani Malay", MacrolanguageCode: "msa"},
+	"mfb": {Part3: "mfb", Scope: 'I', LanguageType: 'L', Name: "Bangka", MacrolanguageCode: "msa"},
 	"mfc": {Part3: "mfc", Scope: 'I', LanguageType: 'L', Name: "Mba"},
 	"mfd": {Part3: "mfd", Scope: 'I', LanguageType: 'L', Name: "Mendankwe-Nkwen"},
 	"mfe": {Part3: "mfe", Scope: 'I', LanguageType: 'L', Name: "Morisyen"},
@@ -4002,7 +4009,7 @@ var LanguagesPart3 = map[string]Language{
 	"mho": {Part3: "mho", Scope: 'I', LanguageType: 'L', Name: "Mashi (Zambia)"},
 	"mhp": {Part3: "mhp", Scope: 'I', LanguageType: 'L', Name: "Balinese Malay"},
 	"mhq": {Part3: "mhq", Scope: 'I', LanguageType: 'L', Name: "Mandan"},
-	"mhr": {Part3: "mhr", Scope: 'I', LanguageType: 'L', Name: "Eastern Mari"},
+	"mhr": {Part3: "mhr", Scope: 'I', LanguageType: 'L', Name: "Eastern Mari", MacrolanguageCode: "chm"},
 	"mhs": {Part3: "mhs", Scope: 'I', LanguageType: 'L', Name: "Buru (Indonesia)"},
 	"mht": {Part3: "mht", Scope: 'I', LanguageType: 'L', Name: "Mandahuaca"},
 	"mhu": {Part3: "mhu", Scope: 'I', LanguageType: 'L', Name: "Digaro-Mishmi"},
@@ -4023,7 +4030,7 @@ var LanguagesPart3 = map[string]Language{
 	"mik": {Part3: "mik", Scope: 'I', LanguageType: 'L', Name: "Mikasuki"},
 	"mil": {Part3: "mil", Scope: 'I', LanguageType: 'L', Name: "Peñoles Mixtec"},
 	"mim": {Part3: "mim", Scope: 'I', LanguageType: 'L', Name: "Alacatlatzala Mixtec"},
-	"min": {Part3: "min", Part2B: "min", Part2T: "min", Scope: 'I', LanguageType: 'L', Name: "Minangkabau"},
+	"min": {Part3: "min", Part2B: "min", Part2T: "min", Scope: 'I', LanguageType: 'L', Name: "Minangkabau", MacrolanguageCode: "msa"},
 	"mio": {Part3: "mio", Scope: 'I', LanguageType: 'L', Name: "Pinotepa Nacional Mixtec"},
 	"mip": {Part3: "mip", Scope: 'I', LanguageType: 'L', Name: "Apasco-Apoala Mixtec"},
 	"miq": {Part3: "miq", Scope: 'I', LanguageType: 'L', Name: "Mískito"},
@@ -4078,7 +4085,7 @@ var LanguagesPart3 = map[string]Language{
 	"mkr": {Part3: "mkr", Scope: 'I', LanguageType: 'L', Name: "Malas"},
 	"mks": {Part3: "mks", Scope: 'I', LanguageType: 'L', Name: "Silacayoapan Mixtec"},
 	"mkt": {Part3: "mkt", Scope: 'I', LanguageType: 'L', Name: "Vamale"},
-	"mku": {Part3: "mku", Scope: 'I', LanguageType: 'L', Name: "Konyanka Maninka"},
+	"mku": {Part3: "mku", Scope: 'I', LanguageType: 'L', Name: "Konyanka Maninka", MacrolanguageCode: "man"},
 	"mkv": {Part3: "mkv", Scope: 'I', LanguageType: 'L', Name: "Mafea"},
 	"mkw": {Part3: "mkw", Scope: 'I', LanguageType: 'L', Name: "Kituba (Congo)"},
 	"mkx": {Part3: "mkx", Scope: 'I', LanguageType: 'L', Name: "Kinamiging Manobo"},
@@ -4099,7 +4106,7 @@ var LanguagesPart3 = map[string]Language{
 	"mln": {Part3: "mln", Scope: 'I', LanguageType: 'L', Name: "Malango"},
 	"mlo": {Part3: "mlo", Scope: 'I', LanguageType: 'L', Name: "Mlomp"},
 	"mlp": {Part3: "mlp", Scope: 'I', LanguageType: 'L', Name: "Bargam"},
-	"mlq": {Part3: "mlq", Scope: 'I', LanguageType: 'L', Name: "Western Maninkakan"},
+	"mlq": {Part3: "mlq", Scope: 'I', LanguageType: 'L', Name: "Western Maninkakan", MacrolanguageCode: "man"},
 	"mlr": {Part3: "mlr", Scope: 'I', LanguageType: 'L', Name: "Vame"},
 	"mls": {Part3: "mls", Scope: 'I', LanguageType: 'L', Name: "Masalit"},
 	"mlt": {Part3: "mlt", Part2B: "mlt", Part2T: "mlt", Part1: "mt", Scope: 'I', LanguageType: 'L', Name: "Maltese"},
@@ -4125,7 +4132,7 @@ var LanguagesPart3 = map[string]Language{
 	"mmo": {Part3: "mmo", Scope: 'I', LanguageType: 'L', Name: "Mangga Buang"},
 	"mmp": {Part3: "mmp", Scope: 'I', LanguageType: 'L', Name: "Siawi"},
 	"mmq": {Part3: "mmq", Scope: 'I', LanguageType: 'L', Name: "Musak"},
-	"mmr": {Part3: "mmr", Scope: 'I', LanguageType: 'L', Name: "Western Xiangxi Miao"},
+	"mmr": {Part3: "mmr", Scope: 'I', LanguageType: 'L', Name: "Western Xiangxi Miao", MacrolanguageCode: "hmn"},
 	"mmt": {Part3: "mmt", Scope: 'I', LanguageType: 'L', Name: "Malalamai"},
 	"mmu": {Part3: "mmu", Scope: 'I', LanguageType: 'L', Name: "Mmaala"},
 	"mmv": {Part3: "mmv", Scope: 'I', LanguageType: 'E', Name: "Miriti"},
@@ -4143,11 +4150,11 @@ var LanguagesPart3 = map[string]Language{
 	"mnh": {Part3: "mnh", Scope: 'I', LanguageType: 'L', Name: "Mono (Democratic Republic of Congo)"},
 	"mni": {Part3: "mni", Part2B: "mni", Part2T: "mni", Scope: 'I', LanguageType: 'L', Name: "Manipuri"},
 	"mnj": {Part3: "mnj", Scope: 'I', LanguageType: 'L', Name: "Munji"},
-	"mnk": {Part3: "mnk", Scope: 'I', LanguageType: 'L', Name: "Mandinka"},
+	"mnk": {Part3: "mnk", Scope: 'I', LanguageType: 'L', Name: "Mandinka", MacrolanguageCode: "man"},
 	"mnl": {Part3: "mnl", Scope: 'I', LanguageType: 'L', Name: "Tiale"},
 	"mnm": {Part3: "mnm", Scope: 'I', LanguageType: 'L', Name: "Mapena"},
 	"mnn": {Part3: "mnn", Scope: 'I', LanguageType: 'L', Name: "Southern Mnong"},
-	"mnp": {Part3: "mnp", Scope: 'I', LanguageType: 'L', Name: "Min Bei Chinese"},
+	"mnp": {Part3: "mnp", Scope: 'I', LanguageType: 'L', Name: "Min Bei Chinese", MacrolanguageCode: "zho"},
 	"mnq": {Part3: "mnq", Scope: 'I', LanguageType: 'L', Name: "Minriq"},
 	"mnr": {Part3: "mnr", Scope: 'I', LanguageType: 'L', Name: "Mono (USA)"},
 	"mns": {Part3: "mns", Scope: 'I', LanguageType: 'L', Name: "Mansi"},
@@ -4210,7 +4217,7 @@ var LanguagesPart3 = map[string]Language{
 	"mqc": {Part3: "mqc", Scope: 'I', LanguageType: 'L', Name: "Mangole"},
 	"mqe": {Part3: "mqe", Scope: 'I', LanguageType: 'L', Name: "Matepi"},
 	"mqf": {Part3: "mqf", Scope: 'I', LanguageType: 'L', Name: "Momuna"},
-	"mqg": {Part3: "mqg", Scope: 'I', LanguageType: 'L', Name: "Kota Bangun Kutai Malay"},
+	"mqg": {Part3: "mqg", Scope: 'I', LanguageType: 'L', Name: "Kota Bangun Kutai Malay", MacrolanguageCode: "msa"},
 	"mqh": {Part3: "mqh", Scope: 'I', LanguageType: 'L', Name: "Tlazoyaltepec Mixtec"},
 	"mqi": {Part3: "mqi", Scope: 'I', LanguageType: 'L', Name: "Mariri"},
 	"mqj": {Part3: "mqj", Scope: 'I', LanguageType: 'L', Name: "Mamasa"},
@@ -4239,7 +4246,7 @@ var LanguagesPart3 = map[string]Language{
 	"mrg": {Part3: "mrg", Scope: 'I', LanguageType: 'L', Name: "Mising"},
 	"mrh": {Part3: "mrh", Scope: 'I', LanguageType: 'L', Name: "Mara Chin"},
 	"mri": {Part3: "mri", Part2B: "mao", Part2T: "mri", Part1: "mi", Scope: 'I', LanguageType: 'L', Name: "Maori"},
-	"mrj": {Part3: "mrj", Scope: 'I', LanguageType: 'L', Name: "Western Mari"},
+	"mrj": {Part3: "mrj", Scope: 'I', LanguageType: 'L', Name: "Western Mari", MacrolanguageCode: "chm"},
 	"mrk": {Part3: "mrk", Scope: 'I', LanguageType: 'L', Name: "Hmwaveke"},
 	"mrl": {Part3: "mrl", Scope: 'I', LanguageType: 'L', Name: "Mortlockese"},
 	"mrm": {Part3: "mrm", Scope: 'I', LanguageType: 'L', Name: "Merlav"},
@@ -4258,13 +4265,13 @@ var LanguagesPart3 = map[string]Language{
 	"mrz": {Part3: "mrz", Scope: 'I', LanguageType: 'L', Name: "Marind"},
 	"msa": {Part3: "msa", Part2B: "may", Part2T: "msa", Part1: "ms", Scope: 'M', LanguageType: 'L', Name: "Malay (macrolanguage)"},
 	"msb": {Part3: "msb", Scope: 'I', LanguageType: 'L', Name: "Masbatenyo"},
-	"msc": {Part3: "msc", Scope: 'I', LanguageType: 'L', Name: "Sankaran Maninka"},
+	"msc": {Part3: "msc", Scope: 'I', LanguageType: 'L', Name: "Sankaran Maninka", MacrolanguageCode: "man"},
 	"msd": {Part3: "msd", Scope: 'I', LanguageType: 'L', Name: "Yucatec Maya Sign Language"},
 	"mse": {Part3: "mse", Scope: 'I', LanguageType: 'L', Name: "Musey"},
 	"msf": {Part3: "msf", Scope: 'I', LanguageType: 'L', Name: "Mekwei"},
 	"msg": {Part3: "msg", Scope: 'I', LanguageType: 'L', Name: "Moraid"},
-	"msh": {Part3: "msh", Scope: 'I', LanguageType: 'L', Name: "Masikoro Malagasy"},
-	"msi": {Part3: "msi", Scope: 'I', LanguageType: 'L', Name: "Sabah Malay"},
+	"msh": {Part3: "msh", Scope: 'I', LanguageType: 'L', Name: "Masikoro Malagasy", MacrolanguageCode: "mlg"},
+	"msi": {Part3: "msi", Scope: 'I', LanguageType: 'L', Name: "Sabah Malay", MacrolanguageCode: "msa"},
 	"msj": {Part3: "msj", Scope: 'I', LanguageType: 'L', Name: "Ma (Democratic Republic of Congo)"},
 	"msk": {Part3: "msk", Scope: 'I', LanguageType: 'L', Name: "Mansaka"},
 	"msl": {Part3: "msl", Scope: 'I', LanguageType: 'L', Name: "Molof"},
@@ -4298,7 +4305,7 @@ var LanguagesPart3 = map[string]Language{
 	"mto": {Part3: "mto", Scope: 'I', LanguageType: 'L', Name: "Totontepec Mixe"},
 	"mtp": {Part3: "mtp", Scope: 'I', LanguageType: 'L', Name: "Wichí Lhamtés Nocten"},
 	"mtq": {Part3: "mtq", Scope: 'I', LanguageType: 'L', Name: "Muong"},
-	"mtr": {Part3: "mtr", Scope: 'I', LanguageType: 'L', Name: "Mewari"},
+	"mtr": {Part3: "mtr", Scope: 'I', LanguageType: 'L', Name: "Mewari", MacrolanguageCode: "mwr"},
 	"mts": {Part3: "mts", Scope: 'I', LanguageType: 'L', Name: "Yora"},
 	"mtt": {Part3: "mtt", Scope: 'I', LanguageType: 'L', Name: "Mota"},
 	"mtu": {Part3: "mtu", Scope: 'I', LanguageType: 'L', Name: "Tututepec Mixtec"},
@@ -4313,14 +4320,14 @@ var LanguagesPart3 = map[string]Language{
 	"mue": {Part3: "mue", Scope: 'I', LanguageType: 'L', Name: "Media Lengua"},
 	"mug": {Part3: "mug", Scope: 'I', LanguageType: 'L', Name: "Musgu"},
 	"muh": {Part3: "muh", Scope: 'I', LanguageType: 'L', Name: "Mündü"},
-	"mui": {Part3: "mui", Scope: 'I', LanguageType: 'L', Name: "Musi"},
+	"mui": {Part3: "mui", Scope: 'I', LanguageType: 'L', Name: "Musi", MacrolanguageCode: "msa"},
 	"muj": {Part3: "muj", Scope: 'I', LanguageType: 'L', Name: "Mabire"},
 	"muk": {Part3: "muk", Scope: 'I', LanguageType: 'L', Name: "Mugom"},
 	"mul": {Part3: "mul", Part2B: "mul", Part2T: "mul", Scope: 'S', LanguageType: 'S', Name: "Multiple languages"},
 	"mum": {Part3: "mum", Scope: 'I', LanguageType: 'L', Name: "Maiwala"},
 	"muo": {Part3: "muo", Scope: 'I', LanguageType: 'L', Name: "Nyong"},
-	"mup": {Part3: "mup", Scope: 'I', LanguageType: 'L', Name: "Malvi"},
-	"muq": {Part3: "muq", Scope: 'I', LanguageType: 'L', Name: "Eastern Xiangxi Miao"},
+	"mup": {Part3: "mup", Scope: 'I', LanguageType: 'L', Name: "Malvi", MacrolanguageCode: "raj"},
+	"muq": {Part3: "muq", Scope: 'I', LanguageType: 'L', Name: "Eastern Xiangxi Miao", MacrolanguageCode: "hmn"},
 	"mur": {Part3: "mur", Scope: 'I', LanguageType: 'L', Name: "Murle"},
 	"mus": {Part3: "mus", Part2B: "mus", Part2T: "mus", Scope: 'I', LanguageType: 'L', Name: "Creek"},
 	"mut": {Part3: "mut", Scope: 'I', LanguageType: 'L', Name: "Western Muria"},
@@ -4332,8 +4339,8 @@ var LanguagesPart3 = map[string]Language{
 	"mva": {Part3: "mva", Scope: 'I', LanguageType: 'L', Name: "Manam"},
 	"mvb": {Part3: "mvb", Scope: 'I', LanguageType: 'E', Name: "Mattole"},
 	"mvd": {Part3: "mvd", Scope: 'I', LanguageType: 'L', Name: "Mamboru"},
-	"mve": {Part3: "mve", Scope: 'I', LanguageType: 'L', Name: "Marwari (Pakistan)"},
-	"mvf": {Part3: "mvf", Scope: 'I', LanguageType: 'L', Name: "Peripheral Mongolian"},
+	"mve": {Part3: "mve", Scope: 'I', LanguageType: 'L', Name: "Marwari (Pakistan)", MacrolanguageCode: "mwr"},
+	"mvf": {Part3: "mvf", Scope: 'I', LanguageType: 'L', Name: "Peripheral Mongolian", MacrolanguageCode: "mon"},
 	"mvg": {Part3: "mvg", Scope: 'I', LanguageType: 'L', Name: "Yucuañe Mixtec"},
 	"mvh": {Part3: "mvh", Scope: 'I', LanguageType: 'L', Name: "Mulgi"},
 	"mvi": {Part3: "mvi", Scope: 'I', LanguageType: 'L', Name: "Miyako"},
@@ -4360,7 +4367,7 @@ var LanguagesPart3 = map[string]Language{
 	"mwg": {Part3: "mwg", Scope: 'I', LanguageType: 'L', Name: "Aiklep"},
 	"mwh": {Part3: "mwh", Scope: 'I', LanguageType: 'L', Name: "Mouk-Aria"},
 	"mwi": {Part3: "mwi", Scope: 'I', LanguageType: 'L', Name: "Labo"},
-	"mwk": {Part3: "mwk", Scope: 'I', LanguageType: 'L', Name: "Kita Maninkakan"},
+	"mwk": {Part3: "mwk", Scope: 'I', LanguageType: 'L', Name: "Kita Maninkakan", MacrolanguageCode: "man"},
 	"mwl": {Part3: "mwl", Part2B: "mwl", Part2T: "mwl", Scope: 'I', LanguageType: 'L', Name: "Mirandese"},
 	"mwm": {Part3: "mwm", Scope: 'I', LanguageType: 'L', Name: "Sar"},
 	"mwn": {Part3: "mwn", Scope: 'I', LanguageType: 'L', Name: "Nyamwanga"},
@@ -4372,7 +4379,7 @@ var LanguagesPart3 = map[string]Language{
 	"mwt": {Part3: "mwt", Scope: 'I', LanguageType: 'L', Name: "Moken"},
 	"mwu": {Part3: "mwu", Scope: 'I', LanguageType: 'E', Name: "Mittu"},
 	"mwv": {Part3: "mwv", Scope: 'I', LanguageType: 'L', Name: "Mentawai"},
-	"mww": {Part3: "mww", Scope: 'I', LanguageType: 'L', Name: "Hmong Daw"},
+	"mww": {Part3: "mww", Scope: 'I', LanguageType: 'L', Name: "Hmong Daw", MacrolanguageCode: "hmn"},
 	"mwz": {Part3: "mwz", Scope: 'I', LanguageType: 'L', Name: "Moingi"},
 	"mxa": {Part3: "mxa", Scope: 'I', LanguageType: 'L', Name: "Northwest Oaxaca Mixtec"},
 	"mxb": {Part3: "mxb", Scope: 'I', LanguageType: 'L', Name: "Tezoatlán Mixtec"},
@@ -4456,7 +4463,7 @@ var LanguagesPart3 = map[string]Language{
 	"nak": {Part3: "nak", Scope: 'I', LanguageType: 'L', Name: "Nakanai"},
 	"nal": {Part3: "nal", Scope: 'I', LanguageType: 'L', Name: "Nalik"},
 	"nam": {Part3: "nam", Scope: 'I', LanguageType: 'L', Name: "Ngan'gityemerri"},
-	"nan": {Part3: "nan", Scope: 'I', LanguageType: 'L', Name: "Min Nan Chinese"},
+	"nan": {Part3: "nan", Scope: 'I', LanguageType: 'L', Name: "Min Nan Chinese", MacrolanguageCode: "zho"},
 	"nao": {Part3: "nao", Scope: 'I', LanguageType: 'L', Name: "Naaba"},
 	"nap": {Part3: "nap", Part2B: "nap", Part2T: "nap", Scope: 'I', LanguageType: 'L', Name: "Neapolitan"},
 	"naq": {Part3: "naq", Scope: 'I', LanguageType: 'L', Name: "Khoekhoe"},
@@ -4597,7 +4604,7 @@ var LanguagesPart3 = map[string]Language{
 	"nha": {Part3: "nha", Scope: 'I', LanguageType: 'L', Name: "Nhanda"},
 	"nhb": {Part3: "nhb", Scope: 'I', LanguageType: 'L', Name: "Beng"},
 	"nhc": {Part3: "nhc", Scope: 'I', LanguageType: 'E', Name: "Tabasco Nahuatl"},
-	"nhd": {Part3: "nhd", Scope: 'I', LanguageType: 'L', Name: "Chiripá"},
+	"nhd": {Part3: "nhd", Scope: 'I', LanguageType: 'L', Name: "Chiripá", MacrolanguageCode: "grn"},
 	"nhe": {Part3: "nhe", Scope: 'I', LanguageType: 'L', Name: "Eastern Huasteca Nahuatl"},
 	"nhf": {Part3: "nhf", Scope: 'I', LanguageType: 'L', Name: "Nhuwala"},
 	"nhg": {Part3: "nhg", Scope: 'I', LanguageType: 'L', Name: "Tetelcingo Nahuatl"},
@@ -4631,7 +4638,7 @@ var LanguagesPart3 = map[string]Language{
 	"nim": {Part3: "nim", Scope: 'I', LanguageType: 'L', Name: "Nilamba"},
 	"nin": {Part3: "nin", Scope: 'I', LanguageType: 'L', Name: "Ninzo"},
 	"nio": {Part3: "nio", Scope: 'I', LanguageType: 'L', Name: "Nganasan"},
-	"niq": {Part3: "niq", Scope: 'I', LanguageType: 'L', Name: "Nandi"},
+	"niq": {Part3: "niq", Scope: 'I', LanguageType: 'L', Name: "Nandi", MacrolanguageCode: "kln"},
 	"nir": {Part3: "nir", Scope: 'I', LanguageType: 'L', Name: "Nimboran"},
 	"nis": {Part3: "nis", Scope: 'I', LanguageType: 'L', Name: "Nimi"},
 	"nit": {Part3: "nit", Scope: 'I', LanguageType: 'L', Name: "Southeastern Kolami"},
@@ -4685,7 +4692,7 @@ var LanguagesPart3 = map[string]Language{
 	"nla": {Part3: "nla", Scope: 'I', LanguageType: 'L', Name: "Ngombale"},
 	"nlc": {Part3: "nlc", Scope: 'I', LanguageType: 'L', Name: "Nalca"},
 	"nld": {Part3: "nld", Part2B: "dut", Part2T: "nld", Part1: "nl", Scope: 'I', LanguageType: 'L', Name: "Dutch"},
-	"nle": {Part3: "nle", Scope: 'I', LanguageType: 'L', Name: "East Nyala"},
+	"nle": {Part3: "nle", Scope: 'I', LanguageType: 'L', Name: "East Nyala", MacrolanguageCode: "luy"},
 	"nlg": {Part3: "nlg", Scope: 'I', LanguageType: 'L', Name: "Gela"},
 	"nli": {Part3: "nli", Scope: 'I', LanguageType: 'L', Name: "Grangali"},
 	"nlj": {Part3: "nlj", Scope: 'I', LanguageType: 'L', Name: "Nyali"},
@@ -4740,7 +4747,7 @@ var LanguagesPart3 = map[string]Language{
 	"nnl": {Part3: "nnl", Scope: 'I', LanguageType: 'L', Name: "Northern Rengma Naga"},
 	"nnm": {Part3: "nnm", Scope: 'I', LanguageType: 'L', Name: "Namia"},
 	"nnn": {Part3: "nnn", Scope: 'I', LanguageType: 'L', Name: "Ngete"},
-	"nno": {Part3: "nno", Part2B: "nno", Part2T: "nno", Part1: "nn", Scope: 'I', LanguageType: 'L', Name: "Norwegian Nynorsk"},
+	"nno": {Part3: "nno", Part2B: "nno", Part2T: "nno", Part1: "nn", Scope: 'I', LanguageType: 'L', Name: "Norwegian Nynorsk", MacrolanguageCode: "nor"},
 	"nnp": {Part3: "nnp", Scope: 'I', LanguageType: 'L', Name: "Wancho Naga"},
 	"nnq": {Part3: "nnq", Scope: 'I', LanguageType: 'L', Name: "Ngindo"},
 	"nnr": {Part3: "nnr", Scope: 'I', LanguageType: 'E', Name: "Narungga"},
@@ -4751,7 +4758,7 @@ var LanguagesPart3 = map[string]Language{
 	"nny": {Part3: "nny", Scope: 'I', LanguageType: 'E', Name: "Nyangga"},
 	"nnz": {Part3: "nnz", Scope: 'I', LanguageType: 'L', Name: "Nda'nda'"},
 	"noa": {Part3: "noa", Scope: 'I', LanguageType: 'L', Name: "Woun Meu"},
-	"nob": {Part3: "nob", Part2B: "nob", Part2T: "nob", Part1: "nb", Scope: 'I', LanguageType: 'L', Name: "Norwegian Bokmål"},
+	"nob": {Part3: "nob", Part2B: "nob", Part2T: "nob", Part1: "nb", Scope: 'I', LanguageType: 'L', Name: "Norwegian Bokmål", MacrolanguageCode: "nor"},
 	"noc": {Part3: "noc", Scope: 'I', LanguageType: 'L', Name: "Nuk"},
 	"nod": {Part3: "nod", Scope: 'I', LanguageType: 'L', Name: "Northern Thai"},
 	"noe": {Part3: "noe", Scope: 'I', LanguageType: 'L', Name: "Nimadi"},
@@ -4778,7 +4785,7 @@ var LanguagesPart3 = map[string]Language{
 	"npb": {Part3: "npb", Scope: 'I', LanguageType: 'L', Name: "Nupbikha"},
 	"npg": {Part3: "npg", Scope: 'I', LanguageType: 'L', Name: "Ponyo-Gongwang Naga"},
 	"nph": {Part3: "nph", Scope: 'I', LanguageType: 'L', Name: "Phom Naga"},
-	"npi": {Part3: "npi", Scope: 'I', LanguageType: 'L', Name: "Nepali (individual language)"},
+	"npi": {Part3: "npi", Scope: 'I', LanguageType: 'L', Name: "Nepali (individual language)", MacrolanguageCode: "nep"},
 	"npl": {Part3: "npl", Scope: 'I', LanguageType: 'L', Name: "Southeastern Puebla Nahuatl"},
 	"npn": {Part3: "npn", Scope: 'I', LanguageType: 'L', Name: "Mondropolon"},
 	"npo": {Part3: "npo", Scope: 'I', LanguageType: 'L', Name: "Pochuri Naga"},
@@ -4907,7 +4914,7 @@ var LanguagesPart3 = map[string]Language{
 	"nya": {Part3: "nya", Part2B: "nya", Part2T: "nya", Part1: "ny", Scope: 'I', LanguageType: 'L', Name: "Nyanja"},
 	"nyb": {Part3: "nyb", Scope: 'I', LanguageType: 'L', Name: "Nyangbo"},
 	"nyc": {Part3: "nyc", Scope: 'I', LanguageType: 'L', Name: "Nyanga-li"},
-	"nyd": {Part3: "nyd", Scope: 'I', LanguageType: 'L', Name: "Nyore"},
+	"nyd": {Part3: "nyd", Scope: 'I', LanguageType: 'L', Name: "Nyore", MacrolanguageCode: "luy"},
 	"nye": {Part3: "nye", Scope: 'I', LanguageType: 'L', Name: "Nyengo"},
 	"nyf": {Part3: "nyf", Scope: 'I', LanguageType: 'L', Name: "Giryama"},
 	"nyg": {Part3: "nyg", Scope: 'I', LanguageType: 'L', Name: "Nyindu"},
@@ -4944,7 +4951,7 @@ var LanguagesPart3 = map[string]Language{
 	"oar": {Part3: "oar", Scope: 'I', LanguageType: 'A', Name: "Old Aramaic (up to 700 BCE)"},
 	"oav": {Part3: "oav", Scope: 'I', LanguageType: 'H', Name: "Old Avar"},
 	"obi": {Part3: "obi", Scope: 'I', LanguageType: 'E', Name: "Obispeño"},
-	"obk": {Part3: "obk", Scope: 'I', LanguageType: 'L', Name: "Southern Bontok"},
+	"obk": {Part3: "obk", Scope: 'I', LanguageType: 'L', Name: "Southern Bontok", MacrolanguageCode: "bnc"},
 	"obl": {Part3: "obl", Scope: 'I', LanguageType: 'L', Name: "Oblo"},
 	"obm": {Part3: "obm", Scope: 'I', LanguageType: 'A', Name: "Moabite"},
 	"obo": {Part3: "obo", Scope: 'I', LanguageType: 'L', Name: "Obo Manobo"},
@@ -4974,14 +4981,14 @@ var LanguagesPart3 = map[string]Language{
 	"ohu": {Part3: "ohu", Scope: 'I', LanguageType: 'H', Name: "Old Hungarian"},
 	"oia": {Part3: "oia", Scope: 'I', LanguageType: 'L', Name: "Oirata"},
 	"oin": {Part3: "oin", Scope: 'I', LanguageType: 'L', Name: "Inebu One"},
-	"ojb": {Part3: "ojb", Scope: 'I', LanguageType: 'L', Name: "Northwestern Ojibwa"},
-	"ojc": {Part3: "ojc", Scope: 'I', LanguageType: 'L', Name: "Central Ojibwa"},
-	"ojg": {Part3: "ojg", Scope: 'I', LanguageType: 'L', Name: "Eastern Ojibwa"},
+	"ojb": {Part3: "ojb", Scope: 'I', LanguageType: 'L', Name: "Northwestern Ojibwa", MacrolanguageCode: "oji"},
+	"ojc": {Part3: "ojc", Scope: 'I', LanguageType: 'L', Name: "Central Ojibwa", MacrolanguageCode: "oji"},
+	"ojg": {Part3: "ojg", Scope: 'I', LanguageType: 'L', Name: "Eastern Ojibwa", MacrolanguageCode: "oji"},
 	"oji": {Part3: "oji", Part2B: "oji", Part2T: "oji", Part1: "oj", Scope: 'M', LanguageType: 'L', Name: "Ojibwa"},
 	"ojp": {Part3: "ojp", Scope: 'I', LanguageType: 'H', Name: "Old Japanese"},
-	"ojs": {Part3: "ojs", Scope: 'I', LanguageType: 'L', Name: "Severn Ojibwa"},
+	"ojs": {Part3: "ojs", Scope: 'I', LanguageType: 'L', Name: "Severn Ojibwa", MacrolanguageCode: "oji"},
 	"ojv": {Part3: "ojv", Scope: 'I', LanguageType: 'L', Name: "Ontong Java"},
-	"ojw": {Part3: "ojw", Scope: 'I', LanguageType: 'L', Name: "Western Ojibwa"},
+	"ojw": {Part3: "ojw", Scope: 'I', LanguageType: 'L', Name: "Western Ojibwa", MacrolanguageCode: "oji"},
 	"oka": {Part3: "oka", Scope: 'I', LanguageType: 'L', Name: "Okanagan"},
 	"okb": {Part3: "okb", Scope: 'I', LanguageType: 'L', Name: "Okobo"},
 	"okc": {Part3: "okc", Scope: 'I', LanguageType: 'L', Name: "Kobo"},
@@ -4989,7 +4996,7 @@ var LanguagesPart3 = map[string]Language{
 	"oke": {Part3: "oke", Scope: 'I', LanguageType: 'L', Name: "Okpe (Southwestern Edo)"},
 	"okg": {Part3: "okg", Scope: 'I', LanguageType: 'E', Name: "Koko Babangk"},
 	"okh": {Part3: "okh", Scope: 'I', LanguageType: 'L', Name: "Koresh-e Rostam"},
-	"oki": {Part3: "oki", Scope: 'I', LanguageType: 'L', Name: "Okiek"},
+	"oki": {Part3: "oki", Scope: 'I', LanguageType: 'L', Name: "Okiek", MacrolanguageCode: "kln"},
 	"okj": {Part3: "okj", Scope: 'I', LanguageType: 'E', Name: "Oko-Juwoi"},
 	"okk": {Part3: "okk", Scope: 'I', LanguageType: 'L', Name: "Kwamtim One"},
 	"okl": {Part3: "okl", Scope: 'I', LanguageType: 'E', Name: "Old Kentish Sign Language"},
@@ -5055,22 +5062,22 @@ var LanguagesPart3 = map[string]Language{
 	"opt": {Part3: "opt", Scope: 'I', LanguageType: 'E', Name: "Opata"},
 	"opy": {Part3: "opy", Scope: 'I', LanguageType: 'L', Name: "Ofayé"},
 	"ora": {Part3: "ora", Scope: 'I', LanguageType: 'L', Name: "Oroha"},
-	"orc": {Part3: "orc", Scope: 'I', LanguageType: 'L', Name: "Orma"},
+	"orc": {Part3: "orc", Scope: 'I', LanguageType: 'L', Name: "Orma", MacrolanguageCode: "orm"},
 	"ore": {Part3: "ore", Scope: 'I', LanguageType: 'L', Name: "Orejón"},
 	"org": {Part3: "org", Scope: 'I', LanguageType: 'L', Name: "Oring"},
 	"orh": {Part3: "orh", Scope: 'I', LanguageType: 'L', Name: "Oroqen"},
 	"ori": {Part3: "ori", Part2B: "ori", Part2T: "ori", Part1: "or", Scope: 'M', LanguageType: 'L', Name: "Oriya (macrolanguage)"},
 	"orm": {Part3: "orm", Part2B: "orm", Part2T: "orm", Part1: "om", Scope: 'M', LanguageType: 'L', Name: "Oromo"},
-	"orn": {Part3: "orn", Scope: 'I', LanguageType: 'L', Name: "Orang Kanaq"},
+	"orn": {Part3: "orn", Scope: 'I', LanguageType: 'L', Name: "Orang Kanaq", MacrolanguageCode: "msa"},
 	"oro": {Part3: "oro", Scope: 'I', LanguageType: 'L', Name: "Orokolo"},
 	"orr": {Part3: "orr", Scope: 'I', LanguageType: 'L', Name: "Oruma"},
-	"ors": {Part3: "ors", Scope: 'I', LanguageType: 'L', Name: "Orang Seletar"},
+	"ors": {Part3: "ors", Scope: 'I', LanguageType: 'L', Name: "Orang Seletar", MacrolanguageCode: "msa"},
 	"ort": {Part3: "ort", Scope: 'I', LanguageType: 'L', Name: "Adivasi Oriya"},
 	"oru": {Part3: "oru", Scope: 'I', LanguageType: 'L', Name: "Ormuri"},
 	"orv": {Part3: "orv", Scope: 'I', LanguageType: 'H', Name: "Old Russian"},
 	"orw": {Part3: "orw", Scope: 'I', LanguageType: 'L', Name: "Oro Win"},
 	"orx": {Part3: "orx", Scope: 'I', LanguageType: 'L', Name: "Oro"},
-	"ory": {Part3: "ory", Scope: 'I', LanguageType: 'L', Name: "Odia"},
+	"ory": {Part3: "ory", Scope: 'I', LanguageType: 'L', Name: "Odia", MacrolanguageCode: "ori"},
 	"orz": {Part3: "orz", Scope: 'I', LanguageType: 'L', Name: "Ormu"},
 	"osa": {Part3: "osa", Part2B: "osa", Part2T: "osa", Scope: 'I', LanguageType: 'L', Name: "Osage"},
 	"osc": {Part3: "osc", Scope: 'I', LanguageType: 'A', Name: "Oscan"},
@@ -5096,7 +5103,7 @@ var LanguagesPart3 = map[string]Language{
 	"ots": {Part3: "ots", Scope: 'I', LanguageType: 'L', Name: "Estado de México Otomi"},
 	"ott": {Part3: "ott", Scope: 'I', LanguageType: 'L', Name: "Temoaya Otomi"},
 	"otu": {Part3: "otu", Scope: 'I', LanguageType: 'E', Name: "Otuke"},
-	"otw": {Part3: "otw", Scope: 'I', LanguageType: 'L', Name: "Ottawa"},
+	"otw": {Part3: "otw", Scope: 'I', LanguageType: 'L', Name: "Ottawa", MacrolanguageCode: "oji"},
 	"otx": {Part3: "otx", Scope: 'I', LanguageType: 'L', Name: "Texcatepec Otomi"},
 	"oty": {Part3: "oty", Scope: 'I', LanguageType: 'A', Name: "Old Tamil"},
 	"otz": {Part3: "otz", Scope: 'I', LanguageType: 'L', Name: "Ixtenco Otomi"},
@@ -5150,8 +5157,8 @@ var LanguagesPart3 = map[string]Language{
 	"pbp": {Part3: "pbp", Scope: 'I', LanguageType: 'L', Name: "Badyara"},
 	"pbr": {Part3: "pbr", Scope: 'I', LanguageType: 'L', Name: "Pangwa"},
 	"pbs": {Part3: "pbs", Scope: 'I', LanguageType: 'L', Name: "Central Pame"},
-	"pbt": {Part3: "pbt", Scope: 'I', LanguageType: 'L', Name: "Southern Pashto"},
-	"pbu": {Part3: "pbu", Scope: 'I', LanguageType: 'L', Name: "Northern Pashto"},
+	"pbt": {Part3: "pbt", Scope: 'I', LanguageType: 'L', Name: "Southern Pashto", MacrolanguageCode: "pus"},
+	"pbu": {Part3: "pbu", Scope: 'I', LanguageType: 'L', Name: "Northern Pashto", MacrolanguageCode: "pus"},
 	"pbv": {Part3: "pbv", Scope: 'I', LanguageType: 'L', Name: "Pnar"},
 	"pby": {Part3: "pby", Scope: 'I', LanguageType: 'L', Name: "Pyu (Papua New Guinea)"},
 	"pca": {Part3: "pca", Scope: 'I', LanguageType: 'L', Name: "Santa Inés Ahuatempan Popoloca"},
@@ -5187,12 +5194,12 @@ var LanguagesPart3 = map[string]Language{
 	"pei": {Part3: "pei", Scope: 'I', LanguageType: 'L', Name: "Chichimeca-Jonaz"},
 	"pej": {Part3: "pej", Scope: 'I', LanguageType: 'E', Name: "Northern Pomo"},
 	"pek": {Part3: "pek", Scope: 'I', LanguageType: 'L', Name: "Penchal"},
-	"pel": {Part3: "pel", Scope: 'I', LanguageType: 'L', Name: "Pekal"},
+	"pel": {Part3: "pel", Scope: 'I', LanguageType: 'L', Name: "Pekal", MacrolanguageCode: "msa"},
 	"pem": {Part3: "pem", Scope: 'I', LanguageType: 'L', Name: "Phende"},
 	"peo": {Part3: "peo", Part2B: "peo", Part2T: "peo", Scope: 'I', LanguageType: 'H', Name: "Old Persian (ca. 600-400 B.C.)"},
 	"pep": {Part3: "pep", Scope: 'I', LanguageType: 'L', Name: "Kunja"},
 	"peq": {Part3: "peq", Scope: 'I', LanguageType: 'L', Name: "Southern Pomo"},
-	"pes": {Part3: "pes", Scope: 'I', LanguageType: 'L', Name: "Iranian Persian"},
+	"pes": {Part3: "pes", Scope: 'I', LanguageType: 'L', Name: "Iranian Persian", MacrolanguageCode: "fas"},
 	"pev": {Part3: "pev", Scope: 'I', LanguageType: 'L', Name: "Pémono"},
 	"pex": {Part3: "pex", Scope: 'I', LanguageType: 'L', Name: "Petats"},
 	"pey": {Part3: "pey", Scope: 'I', LanguageType: 'L', Name: "Petjo"},
@@ -5200,7 +5207,7 @@ var LanguagesPart3 = map[string]Language{
 	"pfa": {Part3: "pfa", Scope: 'I', LanguageType: 'L', Name: "Pááfang"},
 	"pfe": {Part3: "pfe", Scope: 'I', LanguageType: 'L', Name: "Pere"},
 	"pfl": {Part3: "pfl", Scope: 'I', LanguageType: 'L', Name: "Pfaelzisch"},
-	"pga": {Part3: "pga", Scope: 'I', LanguageType: 'L', Name: "Sudanese Creole Arabic"},
+	"pga": {Part3: "pga", Scope: 'I', LanguageType: 'L', Name: "Sudanese Creole Arabic", MacrolanguageCode: "ara"},
 	"pgd": {Part3: "pgd", Scope: 'I', LanguageType: 'H', Name: "Gāndhārī"},
 	"pgg": {Part3: "pgg", Scope: 'I', LanguageType: 'L', Name: "Pangwali"},
 	"pgi": {Part3: "pgi", Scope: 'I', LanguageType: 'L', Name: "Pagi"},
@@ -5220,7 +5227,7 @@ var LanguagesPart3 = map[string]Language{
 	"phn": {Part3: "phn", Part2B: "phn", Part2T: "phn", Scope: 'I', LanguageType: 'A', Name: "Phoenician"},
 	"pho": {Part3: "pho", Scope: 'I', LanguageType: 'L', Name: "Phunoi"},
 	"phq": {Part3: "phq", Scope: 'I', LanguageType: 'L', Name: "Phana'"},
-	"phr": {Part3: "phr", Scope: 'I', LanguageType: 'L', Name: "Pahari-Potwari"},
+	"phr": {Part3: "phr", Scope: 'I', LanguageType: 'L', Name: "Pahari-Potwari", MacrolanguageCode: "lah"},
 	"pht": {Part3: "pht", Scope: 'I', LanguageType: 'L', Name: "Phu Thai"},
 	"phu": {Part3: "phu", Scope: 'I', LanguageType: 'L', Name: "Phuan"},
 	"phv": {Part3: "phv", Scope: 'I', LanguageType: 'L', Name: "Pahlavani"},
@@ -5256,7 +5263,7 @@ var LanguagesPart3 = map[string]Language{
 	"pkg": {Part3: "pkg", Scope: 'I', LanguageType: 'L', Name: "Pak-Tong"},
 	"pkh": {Part3: "pkh", Scope: 'I', LanguageType: 'L', Name: "Pankhu"},
 	"pkn": {Part3: "pkn", Scope: 'I', LanguageType: 'L', Name: "Pakanha"},
-	"pko": {Part3: "pko", Scope: 'I', LanguageType: 'L', Name: "Pökoot"},
+	"pko": {Part3: "pko", Scope: 'I', LanguageType: 'L', Name: "Pökoot", MacrolanguageCode: "kln"},
 	"pkp": {Part3: "pkp", Scope: 'I', LanguageType: 'L', Name: "Pukapuka"},
 	"pkr": {Part3: "pkr", Scope: 'I', LanguageType: 'L', Name: "Attapady Kurumba"},
 	"pks": {Part3: "pks", Scope: 'I', LanguageType: 'L', Name: "Pakistan Sign Language"},
@@ -5278,7 +5285,7 @@ var LanguagesPart3 = map[string]Language{
 	"plq": {Part3: "plq", Scope: 'I', LanguageType: 'A', Name: "Palaic"},
 	"plr": {Part3: "plr", Scope: 'I', LanguageType: 'L', Name: "Palaka Senoufo"},
 	"pls": {Part3: "pls", Scope: 'I', LanguageType: 'L', Name: "San Marcos Tlacoyalco Popoloca"},
-	"plt": {Part3: "plt", Scope: 'I', LanguageType: 'L', Name: "Plateau Malagasy"},
+	"plt": {Part3: "plt", Scope: 'I', LanguageType: 'L', Name: "Plateau Malagasy", MacrolanguageCode: "mlg"},
 	"plu": {Part3: "plu", Scope: 'I', LanguageType: 'L', Name: "Palikúr"},
 	"plv": {Part3: "plv", Scope: 'I', LanguageType: 'L', Name: "Southwest Palawano"},
 	"plw": {Part3: "plw", Scope: 'I', LanguageType: 'L', Name: "Brooke's Point Palawano"},
@@ -5306,7 +5313,7 @@ var LanguagesPart3 = map[string]Language{
 	"pmy": {Part3: "pmy", Scope: 'I', LanguageType: 'L', Name: "Papuan Malay"},
 	"pmz": {Part3: "pmz", Scope: 'I', LanguageType: 'E', Name: "Southern Pame"},
 	"pna": {Part3: "pna", Scope: 'I', LanguageType: 'L', Name: "Punan Bah-Biau"},
-	"pnb": {Part3: "pnb", Scope: 'I', LanguageType: 'L', Name: "Western Panjabi"},
+	"pnb": {Part3: "pnb", Scope: 'I', LanguageType: 'L', Name: "Western Panjabi", MacrolanguageCode: "lah"},
 	"pnc": {Part3: "pnc", Scope: 'I', LanguageType: 'L', Name: "Pannei"},
 	"pnd": {Part3: "pnd", Scope: 'I', LanguageType: 'L', Name: "Mpinda"},
 	"pne": {Part3: "pne", Scope: 'I', LanguageType: 'L', Name: "Western Penan"},
@@ -5379,7 +5386,7 @@ var LanguagesPart3 = map[string]Language{
 	"prp": {Part3: "prp", Scope: 'I', LanguageType: 'L', Name: "Parsi"},
 	"prq": {Part3: "prq", Scope: 'I', LanguageType: 'L', Name: "Ashéninka Perené"},
 	"prr": {Part3: "prr", Scope: 'I', LanguageType: 'E', Name: "Puri"},
-	"prs": {Part3: "prs", Scope: 'I', LanguageType: 'L', Name: "Dari"},
+	"prs": {Part3: "prs", Scope: 'I', LanguageType: 'L', Name: "Dari", MacrolanguageCode: "fas"},
 	"prt": {Part3: "prt", Scope: 'I', LanguageType: 'L', Name: "Phai"},
 	"pru": {Part3: "pru", Scope: 'I', LanguageType: 'L', Name: "Puragi"},
 	"prw": {Part3: "prw", Scope: 'I', LanguageType: 'L', Name: "Parawen"},
@@ -5388,7 +5395,7 @@ var LanguagesPart3 = map[string]Language{
 	"psa": {Part3: "psa", Scope: 'I', LanguageType: 'L', Name: "Asue Awyu"},
 	"psc": {Part3: "psc", Scope: 'I', LanguageType: 'L', Name: "Persian Sign Language"},
 	"psd": {Part3: "psd", Scope: 'I', LanguageType: 'L', Name: "Plains Indian Sign Language"},
-	"pse": {Part3: "pse", Scope: 'I', LanguageType: 'L', Name: "Central Malay"},
+	"pse": {Part3: "pse", Scope: 'I', LanguageType: 'L', Name: "Central Malay", MacrolanguageCode: "msa"},
 	"psg": {Part3: "psg", Scope: 'I', LanguageType: 'L', Name: "Penang Sign Language"},
 	"psh": {Part3: "psh", Scope: 'I', LanguageType: 'L', Name: "Southwest Pashai"},
 	"psi": {Part3: "psi", Scope: 'I', LanguageType: 'L', Name: "Southeast Pashai"},
@@ -5400,7 +5407,7 @@ var LanguagesPart3 = map[string]Language{
 	"psq": {Part3: "psq", Scope: 'I', LanguageType: 'L', Name: "Pasi"},
 	"psr": {Part3: "psr", Scope: 'I', LanguageType: 'L', Name: "Portuguese Sign Language"},
 	"pss": {Part3: "pss", Scope: 'I', LanguageType: 'L', Name: "Kaulong"},
-	"pst": {Part3: "pst", Scope: 'I', LanguageType: 'L', Name: "Central Pashto"},
+	"pst": {Part3: "pst", Scope: 'I', LanguageType: 'L', Name: "Central Pashto", MacrolanguageCode: "pus"},
 	"psu": {Part3: "psu", Scope: 'I', LanguageType: 'H', Name: "Sauraseni Prākrit"},
 	"psw": {Part3: "psw", Scope: 'I', LanguageType: 'L', Name: "Port Sandwich"},
 	"psy": {Part3: "psy", Scope: 'I', LanguageType: 'E', Name: "Piscataway"},
@@ -5456,61 +5463,61 @@ var LanguagesPart3 = map[string]Language{
 	"pyy": {Part3: "pyy", Scope: 'I', LanguageType: 'L', Name: "Pyen"},
 	"pzn": {Part3: "pzn", Scope: 'I', LanguageType: 'L', Name: "Para Naga"},
 	"qua": {Part3: "qua", Scope: 'I', LanguageType: 'L', Name: "Quapaw"},
-	"qub": {Part3: "qub", Scope: 'I', LanguageType: 'L', Name: "Huallaga Huánuco Quechua"},
+	"qub": {Part3: "qub", Scope: 'I', LanguageType: 'L', Name: "Huallaga Huánuco Quechua", MacrolanguageCode: "que"},
 	"quc": {Part3: "quc", Scope: 'I', LanguageType: 'L', Name: "K'iche'"},
-	"qud": {Part3: "qud", Scope: 'I', LanguageType: 'L', Name: "Calderón Highland Quichua"},
+	"qud": {Part3: "qud", Scope: 'I', LanguageType: 'L', Name: "Calderón Highland Quichua", MacrolanguageCode: "que"},
 	"que": {Part3: "que", Part2B: "que", Part2T: "que", Part1: "qu", Scope: 'M', LanguageType: 'L', Name: "Quechua"},
-	"quf": {Part3: "quf", Scope: 'I', LanguageType: 'L', Name: "Lambayeque Quechua"},
-	"qug": {Part3: "qug", Scope: 'I', LanguageType: 'L', Name: "Chimborazo Highland Quichua"},
-	"quh": {Part3: "quh", Scope: 'I', LanguageType: 'L', Name: "South Bolivian Quechua"},
+	"quf": {Part3: "quf", Scope: 'I', LanguageType: 'L', Name: "Lambayeque Quechua", MacrolanguageCode: "que"},
+	"qug": {Part3: "qug", Scope: 'I', LanguageType: 'L', Name: "Chimborazo Highland Quichua", MacrolanguageCode: "que"},
+	"quh": {Part3: "quh", Scope: 'I', LanguageType: 'L', Name: "South Bolivian Quechua", MacrolanguageCode: "que"},
 	"qui": {Part3: "qui", Scope: 'I', LanguageType: 'L', Name: "Quileute"},
-	"quk": {Part3: "quk", Scope: 'I', LanguageType: 'L', Name: "Chachapoyas Quechua"},
-	"qul": {Part3: "qul", Scope: 'I', LanguageType: 'L', Name: "North Bolivian Quechua"},
+	"quk": {Part3: "quk", Scope: 'I', LanguageType: 'L', Name: "Chachapoyas Quechua", MacrolanguageCode: "que"},
+	"qul": {Part3: "qul", Scope: 'I', LanguageType: 'L', Name: "North Bolivian Quechua", MacrolanguageCode: "que"},
 	"qum": {Part3: "qum", Scope: 'I', LanguageType: 'L', Name: "Sipacapense"},
 	"qun": {Part3: "qun", Scope: 'I', LanguageType: 'E', Name: "Quinault"},
-	"qup": {Part3: "qup", Scope: 'I', LanguageType: 'L', Name: "Southern Pastaza Quechua"},
+	"qup": {Part3: "qup", Scope: 'I', LanguageType: 'L', Name: "Southern Pastaza Quechua", MacrolanguageCode: "que"},
 	"quq": {Part3: "quq", Scope: 'I', LanguageType: 'L', Name: "Quinqui"},
-	"qur": {Part3: "qur", Scope: 'I', LanguageType: 'L', Name: "Yanahuanca Pasco Quechua"},
-	"qus": {Part3: "qus", Scope: 'I', LanguageType: 'L', Name: "Santiago del Estero Quichua"},
+	"qur": {Part3: "qur", Scope: 'I', LanguageType: 'L', Name: "Yanahuanca Pasco Quechua", MacrolanguageCode: "que"},
+	"qus": {Part3: "qus", Scope: 'I', LanguageType: 'L', Name: "Santiago del Estero Quichua", MacrolanguageCode: "que"},
 	"quv": {Part3: "quv", Scope: 'I', LanguageType: 'L', Name: "Sacapulteco"},
-	"quw": {Part3: "quw", Scope: 'I', LanguageType: 'L', Name: "Tena Lowland Quichua"},
-	"qux": {Part3: "qux", Scope: 'I', LanguageType: 'L', Name: "Yauyos Quechua"},
-	"quy": {Part3: "quy", Scope: 'I', LanguageType: 'L', Name: "Ayacucho Quechua"},
-	"quz": {Part3: "quz", Scope: 'I', LanguageType: 'L', Name: "Cusco Quechua"},
-	"qva": {Part3: "qva", Scope: 'I', LanguageType: 'L', Name: "Ambo-Pasco Quechua"},
-	"qvc": {Part3: "qvc", Scope: 'I', LanguageType: 'L', Name: "Cajamarca Quechua"},
-	"qve": {Part3: "qve", Scope: 'I', LanguageType: 'L', Name: "Eastern Apurímac Quechua"},
-	"qvh": {Part3: "qvh", Scope: 'I', LanguageType: 'L', Name: "Huamalíes-Dos de Mayo Huánuco Quechua"},
-	"qvi": {Part3: "qvi", Scope: 'I', LanguageType: 'L', Name: "Imbabura Highland Quichua"},
-	"qvj": {Part3: "qvj", Scope: 'I', LanguageType: 'L', Name: "Loja Highland Quichua"},
-	"qvl": {Part3: "qvl", Scope: 'I', LanguageType: 'L', Name: "Cajatambo North Lima Quechua"},
-	"qvm": {Part3: "qvm", Scope: 'I', LanguageType: 'L', Name: "Margos-Yarowilca-Lauricocha Quechua"},
-	"qvn": {Part3: "qvn", Scope: 'I', LanguageType: 'L', Name: "North Junín Quechua"},
-	"qvo": {Part3: "qvo", Scope: 'I', LanguageType: 'L', Name: "Napo Lowland Quechua"},
-	"qvp": {Part3: "qvp", Scope: 'I', LanguageType: 'L', Name: "Pacaraos Quechua"},
-	"qvs": {Part3: "qvs", Scope: 'I', LanguageType: 'L', Name: "San Martín Quechua"},
-	"qvw": {Part3: "qvw", Scope: 'I', LanguageType: 'L', Name: "Huaylla Wanca Quechua"},
+	"quw": {Part3: "quw", Scope: 'I', LanguageType: 'L', Name: "Tena Lowland Quichua", MacrolanguageCode: "que"},
+	"qux": {Part3: "qux", Scope: 'I', LanguageType: 'L', Name: "Yauyos Quechua", MacrolanguageCode: "que"},
+	"quy": {Part3: "quy", Scope: 'I', LanguageType: 'L', Name: "Ayacucho Quechua", MacrolanguageCode: "que"},
+	"quz": {Part3: "quz", Scope: 'I', LanguageType: 'L', Name: "Cusco Quechua", MacrolanguageCode: "que"},
+	"qva": {Part3: "qva", Scope: 'I', LanguageType: 'L', Name: "Ambo-Pasco Quechua", MacrolanguageCode: "que"},
+	"qvc": {Part3: "qvc", Scope: 'I', LanguageType: 'L', Name: "Cajamarca Quechua", MacrolanguageCode: "que"},
+	"qve": {Part3: "qve", Scope: 'I', LanguageType: 'L', Name: "Eastern Apurímac Quechua", MacrolanguageCode: "que"},
+	"qvh": {Part3: "qvh", Scope: 'I', LanguageType: 'L', Name: "Huamalíes-Dos de Mayo Huánuco Quechua", MacrolanguageCode: "que"},
+	"qvi": {Part3: "qvi", Scope: 'I', LanguageType: 'L', Name: "Imbabura Highland Quichua", MacrolanguageCode: "que"},
+	"qvj": {Part3: "qvj", Scope: 'I', LanguageType: 'L', Name: "Loja Highland Quichua", MacrolanguageCode: "que"},
+	"qvl": {Part3: "qvl", Scope: 'I', LanguageType: 'L', Name: "Cajatambo North Lima Quechua", MacrolanguageCode: "que"},
+	"qvm": {Part3: "qvm", Scope: 'I', LanguageType: 'L', Name: "Margos-Yarowilca-Lauricocha Quechua", MacrolanguageCode: "que"},
+	"qvn": {Part3: "qvn", Scope: 'I', LanguageType: 'L', Name: "North Junín Quechua", MacrolanguageCode: "que"},
+	"qvo": {Part3: "qvo", Scope: 'I', LanguageType: 'L', Name: "Napo Lowland Quechua", MacrolanguageCode: "que"},
+	"qvp": {Part3: "qvp", Scope: 'I', LanguageType: 'L', Name: "Pacaraos Quechua", MacrolanguageCode: "que"},
+	"qvs": {Part3: "qvs", Scope: 'I', LanguageType: 'L', Name: "San Martín Quechua", MacrolanguageCode: "que"},
+	"qvw": {Part3: "qvw", Scope: 'I', LanguageType: 'L', Name: "Huaylla Wanca Quechua", MacrolanguageCode: "que"},
 	"qvy": {Part3: "qvy", Scope: 'I', LanguageType: 'L', Name: "Queyu"},
-	"qvz": {Part3: "qvz", Scope: 'I', LanguageType: 'L', Name: "Northern Pastaza Quichua"},
-	"qwa": {Part3: "qwa", Scope: 'I', LanguageType: 'L', Name: "Corongo Ancash Quechua"},
+	"qvz": {Part3: "qvz", Scope: 'I', LanguageType: 'L', Name: "Northern Pastaza Quichua", MacrolanguageCode: "que"},
+	"qwa": {Part3: "qwa", Scope: 'I', LanguageType: 'L', Name: "Corongo Ancash Quechua", MacrolanguageCode: "que"},
 	"qwc": {Part3: "qwc", Scope: 'I', LanguageType: 'H', Name: "Classical Quechua"},
 	"qwh": {Part3: "qwh", Scope: 'I', LanguageType: 'L', Name: "Huaylas Ancash Quechua"},
 	"qwm": {Part3: "qwm", Scope: 'I', LanguageType: 'E', Name: "Kuman (Russia)"},
-	"qws": {Part3: "qws", Scope: 'I', LanguageType: 'L', Name: "Sihuas Ancash Quechua"},
+	"qws": {Part3: "qws", Scope: 'I', LanguageType: 'L', Name: "Sihuas Ancash Quechua", MacrolanguageCode: "que"},
 	"qwt": {Part3: "qwt", Scope: 'I', LanguageType: 'E', Name: "Kwalhioqua-Tlatskanai"},
-	"qxa": {Part3: "qxa", Scope: 'I', LanguageType: 'L', Name: "Chiquián Ancash Quechua"},
-	"qxc": {Part3: "qxc", Scope: 'I', LanguageType: 'L', Name: "Chincha Quechua"},
-	"qxh": {Part3: "qxh", Scope: 'I', LanguageType: 'L', Name: "Panao Huánuco Quechua"},
-	"qxl": {Part3: "qxl", Scope: 'I', LanguageType: 'L', Name: "Salasaca Highland Quichua"},
-	"qxn": {Part3: "qxn", Scope: 'I', LanguageType: 'L', Name: "Northern Conchucos Ancash Quechua"},
-	"qxo": {Part3: "qxo", Scope: 'I', LanguageType: 'L', Name: "Southern Conchucos Ancash Quechua"},
-	"qxp": {Part3: "qxp", Scope: 'I', LanguageType: 'L', Name: "Puno Quechua"},
+	"qxa": {Part3: "qxa", Scope: 'I', LanguageType: 'L', Name: "Chiquián Ancash Quechua", MacrolanguageCode: "que"},
+	"qxc": {Part3: "qxc", Scope: 'I', LanguageType: 'L', Name: "Chincha Quechua", MacrolanguageCode: "que"},
+	"qxh": {Part3: "qxh", Scope: 'I', LanguageType: 'L', Name: "Panao Huánuco Quechua", MacrolanguageCode: "que"},
+	"qxl": {Part3: "qxl", Scope: 'I', LanguageType: 'L', Name: "Salasaca Highland Quichua", MacrolanguageCode: "que"},
+	"qxn": {Part3: "qxn", Scope: 'I', LanguageType: 'L', Name: "Northern Conchucos Ancash Quechua", MacrolanguageCode: "que"},
+	"qxo": {Part3: "qxo", Scope: 'I', LanguageType: 'L', Name: "Southern Conchucos Ancash Quechua", MacrolanguageCode: "que"},
+	"qxp": {Part3: "qxp", Scope: 'I', LanguageType: 'L', Name: "Puno Quechua", MacrolanguageCode: "que"},
 	"qxq": {Part3: "qxq", Scope: 'I', LanguageType: 'L', Name: "Qashqa'i"},
-	"qxr": {Part3: "qxr", Scope: 'I', LanguageType: 'L', Name: "Cañar Highland Quichua"},
+	"qxr": {Part3: "qxr", Scope: 'I', LanguageType: 'L', Name: "Cañar Highland Quichua", MacrolanguageCode: "que"},
 	"qxs": {Part3: "qxs", Scope: 'I', LanguageType: 'L', Name: "Southern Qiang"},
-	"qxt": {Part3: "qxt", Scope: 'I', LanguageType: 'L', Name: "Santa Ana de Tusi Pasco Quechua"},
-	"qxu": {Part3: "qxu", Scope: 'I', LanguageType: 'L', Name: "Arequipa-La Unión Quechua"},
-	"qxw": {Part3: "qxw", Scope: 'I', LanguageType: 'L', Name: "Jauja Wanca Quechua"},
+	"qxt": {Part3: "qxt", Scope: 'I', LanguageType: 'L', Name: "Santa Ana de Tusi Pasco Quechua", MacrolanguageCode: "que"},
+	"qxu": {Part3: "qxu", Scope: 'I', LanguageType: 'L', Name: "Arequipa-La Unión Quechua", MacrolanguageCode: "que"},
+	"qxw": {Part3: "qxw", Scope: 'I', LanguageType: 'L', Name: "Jauja Wanca Quechua", MacrolanguageCode: "que"},
 	"qya": {Part3: "qya", Scope: 'I', LanguageType: 'C', Name: "Quenya"},
 	"qyp": {Part3: "qyp", Scope: 'I', LanguageType: 'E', Name: "Quiripi"},
 	"raa": {Part3: "raa", Scope: 'I', LanguageType: 'L', Name: "Dungmali"},
@@ -5518,7 +5525,7 @@ var LanguagesPart3 = map[string]Language{
 	"rac": {Part3: "rac", Scope: 'I', LanguageType: 'L', Name: "Rasawa"},
 	"rad": {Part3: "rad", Scope: 'I', LanguageType: 'L', Name: "Rade"},
 	"raf": {Part3: "raf", Scope: 'I', LanguageType: 'L', Name: "Western Meohang"},
-	"rag": {Part3: "rag", Scope: 'I', LanguageType: 'L', Name: "Logooli"},
+	"rag": {Part3: "rag", Scope: 'I', LanguageType: 'L', Name: "Logooli", MacrolanguageCode: "luy"},
 	"rah": {Part3: "rah", Scope: 'I', LanguageType: 'L', Name: "Rabha"},
 	"rai": {Part3: "rai", Scope: 'I', LanguageType: 'L', Name: "Ramoaaina"},
 	"raj": {Part3: "raj", Part2B: "raj", Part2T: "raj", Scope: 'M', LanguageType: 'L', Name: "Rajasthani"},
@@ -5539,8 +5546,8 @@ var LanguagesPart3 = map[string]Language{
 	"ray": {Part3: "ray", Scope: 'I', LanguageType: 'L', Name: "Rapa"},
 	"raz": {Part3: "raz", Scope: 'I', LanguageType: 'L', Name: "Rahambuu"},
 	"rbb": {Part3: "rbb", Scope: 'I', LanguageType: 'L', Name: "Rumai Palaung"},
-	"rbk": {Part3: "rbk", Scope: 'I', LanguageType: 'L', Name: "Northern Bontok"},
-	"rbl": {Part3: "rbl", Scope: 'I', LanguageType: 'L', Name: "Miraya Bikol"},
+	"rbk": {Part3: "rbk", Scope: 'I', LanguageType: 'L', Name: "Northern Bontok", MacrolanguageCode: "bnc"},
+	"rbl": {Part3: "rbl", Scope: 'I', LanguageType: 'L', Name: "Miraya Bikol", MacrolanguageCode: "bik"},
 	"rbp": {Part3: "rbp", Scope: 'I', LanguageType: 'E', Name: "Barababaraba"},
 	"rcf": {Part3: "rcf", Scope: 'I', LanguageType: 'L', Name: "Réunion Creole French"},
 	"rdb": {Part3: "rdb", Scope: 'I', LanguageType: 'L', Name: "Rudbari"},
@@ -5586,27 +5593,27 @@ var LanguagesPart3 = map[string]Language{
 	"rkw": {Part3: "rkw", Scope: 'I', LanguageType: 'E', Name: "Arakwal"},
 	"rma": {Part3: "rma", Scope: 'I', LanguageType: 'L', Name: "Rama"},
 	"rmb": {Part3: "rmb", Scope: 'I', LanguageType: 'L', Name: "Rembarrnga"},
-	"rmc": {Part3: "rmc", Scope: 'I', LanguageType: 'L', Name: "Carpathian Romani"},
+	"rmc": {Part3: "rmc", Scope: 'I', LanguageType: 'L', Name: "Carpathian Romani", MacrolanguageCode: "rom"},
 	"rmd": {Part3: "rmd", Scope: 'I', LanguageType: 'E', Name: "Traveller Danish"},
 	"rme": {Part3: "rme", Scope: 'I', LanguageType: 'L', Name: "Angloromani"},
-	"rmf": {Part3: "rmf", Scope: 'I', LanguageType: 'L', Name: "Kalo Finnish Romani"},
+	"rmf": {Part3: "rmf", Scope: 'I', LanguageType: 'L', Name: "Kalo Finnish Romani", MacrolanguageCode: "rom"},
 	"rmg": {Part3: "rmg", Scope: 'I', LanguageType: 'L', Name: "Traveller Norwegian"},
 	"rmh": {Part3: "rmh", Scope: 'I', LanguageType: 'L', Name: "Murkim"},
 	"rmi": {Part3: "rmi", Scope: 'I', LanguageType: 'L', Name: "Lomavren"},
 	"rmk": {Part3: "rmk", Scope: 'I', LanguageType: 'L', Name: "Romkun"},
-	"rml": {Part3: "rml", Scope: 'I', LanguageType: 'L', Name: "Baltic Romani"},
+	"rml": {Part3: "rml", Scope: 'I', LanguageType: 'L', Name: "Baltic Romani", MacrolanguageCode: "rom"},
 	"rmm": {Part3: "rmm", Scope: 'I', LanguageType: 'L', Name: "Roma"},
-	"rmn": {Part3: "rmn", Scope: 'I', LanguageType: 'L', Name: "Balkan Romani"},
-	"rmo": {Part3: "rmo", Scope: 'I', LanguageType: 'L', Name: "Sinte Romani"},
+	"rmn": {Part3: "rmn", Scope: 'I', LanguageType: 'L', Name: "Balkan Romani", MacrolanguageCode: "rom"},
+	"rmo": {Part3: "rmo", Scope: 'I', LanguageType: 'L', Name: "Sinte Romani", MacrolanguageCode: "rom"},
 	"rmp": {Part3: "rmp", Scope: 'I', LanguageType: 'L', Name: "Rempi"},
 	"rmq": {Part3: "rmq", Scope: 'I', LanguageType: 'L', Name: "Caló"},
 	"rms": {Part3: "rms", Scope: 'I', LanguageType: 'L', Name: "Romanian Sign Language"},
 	"rmt": {Part3: "rmt", Scope: 'I', LanguageType: 'L', Name: "Domari"},
 	"rmu": {Part3: "rmu", Scope: 'I', LanguageType: 'L', Name: "Tavringer Romani"},
 	"rmv": {Part3: "rmv", Scope: 'I', LanguageType: 'C', Name: "Romanova"},
-	"rmw": {Part3: "rmw", Scope: 'I', LanguageType: 'L', Name: "Welsh Romani"},
+	"rmw": {Part3: "rmw", Scope: 'I', LanguageType: 'L', Name: "Welsh Romani", MacrolanguageCode: "rom"},
 	"rmx": {Part3: "rmx", Scope: 'I', LanguageType: 'L', Name: "Romam"},
-	"rmy": {Part3: "rmy", Scope: 'I', LanguageType: 'L', Name: "Vlax Romani"},
+	"rmy": {Part3: "rmy", Scope: 'I', LanguageType: 'L', Name: "Vlax Romani", MacrolanguageCode: "rom"},
 	"rmz": {Part3: "rmz", Scope: 'I', LanguageType: 'L', Name: "Marma"},
 	"rnd": {Part3: "rnd", Scope: 'I', LanguageType: 'L', Name: "Ruund"},
 	"rng": {Part3: "rng", Scope: 'I', LanguageType: 'L', Name: "Ronga"},
@@ -5665,7 +5672,7 @@ var LanguagesPart3 = map[string]Language{
 	"rwl": {Part3: "rwl", Scope: 'I', LanguageType: 'L', Name: "Ruwila"},
 	"rwm": {Part3: "rwm", Scope: 'I', LanguageType: 'L', Name: "Amba (Uganda)"},
 	"rwo": {Part3: "rwo", Scope: 'I', LanguageType: 'L', Name: "Rawa"},
-	"rwr": {Part3: "rwr", Scope: 'I', LanguageType: 'L', Name: "Marwari (India)"},
+	"rwr": {Part3: "rwr", Scope: 'I', LanguageType: 'L', Name: "Marwari (India)", MacrolanguageCode: "mwr"},
 	"rxd": {Part3: "rxd", Scope: 'I', LanguageType: 'L', Name: "Ngardi"},
 	"rxw": {Part3: "rxw", Scope: 'I', LanguageType: 'E', Name: "Karuwali"},
 	"ryn": {Part3: "ryn", Scope: 'I', LanguageType: 'L', Name: "Northern Amami-Oshima"},
@@ -5733,7 +5740,7 @@ var LanguagesPart3 = map[string]Language{
 	"sco": {Part3: "sco", Part2B: "sco", Part2T: "sco", Scope: 'I', LanguageType: 'L', Name: "Scots"},
 	"scp": {Part3: "scp", Scope: 'I', LanguageType: 'L', Name: "Hyolmo"},
 	"scq": {Part3: "scq", Scope: 'I', LanguageType: 'L', Name: "Sa'och"},
-	"scs": {Part3: "scs", Scope: 'I', LanguageType: 'L', Name: "North Slavey"},
+	"scs": {Part3: "scs", Scope: 'I', LanguageType: 'L', Name: "North Slavey", MacrolanguageCode: "den"},
 	"sct": {Part3: "sct", Scope: 'I', LanguageType: 'L', Name: "Southern Katang"},
 	"scu": {Part3: "scu", Scope: 'I', LanguageType: 'L', Name: "Shumcho"},
 	"scv": {Part3: "scv", Scope: 'I', LanguageType: 'L', Name: "Sheni"},
@@ -5741,15 +5748,15 @@ var LanguagesPart3 = map[string]Language{
 	"scx": {Part3: "scx", Scope: 'I', LanguageType: 'A', Name: "Sicel"},
 	"sda": {Part3: "sda", Scope: 'I', LanguageType: 'L', Name: "Toraja-Sa'dan"},
 	"sdb": {Part3: "sdb", Scope: 'I', LanguageType: 'L', Name: "Shabak"},
-	"sdc": {Part3: "sdc", Scope: 'I', LanguageType: 'L', Name: "Sassarese Sardinian"},
+	"sdc": {Part3: "sdc", Scope: 'I', LanguageType: 'L', Name: "Sassarese Sardinian", MacrolanguageCode: "srd"},
 	"sde": {Part3: "sde", Scope: 'I', LanguageType: 'L', Name: "Surubu"},
 	"sdf": {Part3: "sdf", Scope: 'I', LanguageType: 'L', Name: "Sarli"},
 	"sdg": {Part3: "sdg", Scope: 'I', LanguageType: 'L', Name: "Savi"},
-	"sdh": {Part3: "sdh", Scope: 'I', LanguageType: 'L', Name: "Southern Kurdish"},
+	"sdh": {Part3: "sdh", Scope: 'I', LanguageType: 'L', Name: "Southern Kurdish", MacrolanguageCode: "kur"},
 	"sdj": {Part3: "sdj", Scope: 'I', LanguageType: 'L', Name: "Suundi"},
 	"sdk": {Part3: "sdk", Scope: 'I', LanguageType: 'L', Name: "Sos Kundi"},
 	"sdl": {Part3: "sdl", Scope: 'I', LanguageType: 'L', Name: "Saudi Arabian Sign Language"},
-	"sdn": {Part3: "sdn", Scope: 'I', LanguageType: 'L', Name: "Gallurese Sardinian"},
+	"sdn": {Part3: "sdn", Scope: 'I', LanguageType: 'L', Name: "Gallurese Sardinian", MacrolanguageCode: "srd"},
 	"sdo": {Part3: "sdo", Scope: 'I', LanguageType: 'L', Name: "Bukar-Sadung Bidayuh"},
 	"sdp": {Part3: "sdp", Scope: 'I', LanguageType: 'L', Name: "Sherdukpen"},
 	"sdq": {Part3: "sdq", Scope: 'I', LanguageType: 'L', Name: "Semandang"},
@@ -5785,12 +5792,12 @@ var LanguagesPart3 = map[string]Language{
 	"sez": {Part3: "sez", Scope: 'I', LanguageType: 'L', Name: "Senthang Chin"},
 	"sfb": {Part3: "sfb", Scope: 'I', LanguageType: 'L', Name: "Langue des signes de Belgique Francophone"},
 	"sfe": {Part3: "sfe", Scope: 'I', LanguageType: 'L', Name: "Eastern Subanen"},
-	"sfm": {Part3: "sfm", Scope: 'I', LanguageType: 'L', Name: "Small Flowery Miao"},
+	"sfm": {Part3: "sfm", Scope: 'I', LanguageType: 'L', Name: "Small Flowery Miao", MacrolanguageCode: "hmn"},
 	"sfs": {Part3: "sfs", Scope: 'I', LanguageType: 'L', Name: "South African Sign Language"},
 	"sfw": {Part3: "sfw", Scope: 'I', LanguageType: 'L', Name: "Sehwi"},
 	"sga": {Part3: "sga", Part2B: "sga", Part2T: "sga", Scope: 'I', LanguageType: 'H', Name: "Old Irish (to 900)"},
 	"sgb": {Part3: "sgb", Scope: 'I', LanguageType: 'L', Name: "Mag-antsi Ayta"},
-	"sgc": {Part3: "sgc", Scope: 'I', LanguageType: 'L', Name: "Kipsigis"},
+	"sgc": {Part3: "sgc", Scope: 'I', LanguageType: 'L', Name: "Kipsigis", MacrolanguageCode: "kln"},
 	"sgd": {Part3: "sgd", Scope: 'I', LanguageType: 'L', Name: "Surigaonon"},
 	"sge": {Part3: "sge", Scope: 'I', LanguageType: 'L', Name: "Segai"},
 	"sgg": {Part3: "sgg", Scope: 'I', LanguageType: 'L', Name: "Swiss-German Sign Language"},
@@ -5827,7 +5834,7 @@ var LanguagesPart3 = map[string]Language{
 	"shr": {Part3: "shr", Scope: 'I', LanguageType: 'L', Name: "Shi"},
 	"shs": {Part3: "shs", Scope: 'I', LanguageType: 'L', Name: "Shuswap"},
 	"sht": {Part3: "sht", Scope: 'I', LanguageType: 'E', Name: "Shasta"},
-	"shu": {Part3: "shu", Scope: 'I', LanguageType: 'L', Name: "Chadian Arabic"},
+	"shu": {Part3: "shu", Scope: 'I', LanguageType: 'L', Name: "Chadian Arabic", MacrolanguageCode: "ara"},
 	"shv": {Part3: "shv", Scope: 'I', LanguageType: 'L', Name: "Shehri"},
 	"shw": {Part3: "shw", Scope: 'I', LanguageType: 'L', Name: "Shwai"},
 	"shx": {Part3: "shx", Scope: 'I', LanguageType: 'L', Name: "She"},
@@ -5878,7 +5885,7 @@ var LanguagesPart3 = map[string]Language{
 	"skd": {Part3: "skd", Scope: 'I', LanguageType: 'L', Name: "Southern Sierra Miwok"},
 	"ske": {Part3: "ske", Scope: 'I', LanguageType: 'L', Name: "Seke (Vanuatu)"},
 	"skf": {Part3: "skf", Scope: 'I', LanguageType: 'L', Name: "Sakirabiá"},
-	"skg": {Part3: "skg", Scope: 'I', LanguageType: 'L', Name: "Sakalava Malagasy"},
+	"skg": {Part3: "skg", Scope: 'I', LanguageType: 'L', Name: "Sakalava Malagasy", MacrolanguageCode: "mlg"},
 	"skh": {Part3: "skh", Scope: 'I', LanguageType: 'L', Name: "Sikule"},
 	"ski": {Part3: "ski", Scope: 'I', LanguageType: 'L', Name: "Sika"},
 	"skj": {Part3: "skj", Scope: 'I', LanguageType: 'L', Name: "Seke (Nepal)"},
@@ -5887,7 +5894,7 @@ var LanguagesPart3 = map[string]Language{
 	"sko": {Part3: "sko", Scope: 'I', LanguageType: 'L', Name: "Seko Tengah"},
 	"skp": {Part3: "skp", Scope: 'I', LanguageType: 'L', Name: "Sekapan"},
 	"skq": {Part3: "skq", Scope: 'I', LanguageType: 'L', Name: "Sininkere"},
-	"skr": {Part3: "skr", Scope: 'I', LanguageType: 'L', Name: "Saraiki"},
+	"skr": {Part3: "skr", Scope: 'I', LanguageType: 'L', Name: "Saraiki", MacrolanguageCode: "lah"},
 	"sks": {Part3: "sks", Scope: 'I', LanguageType: 'L', Name: "Maia"},
 	"skt": {Part3: "skt", Scope: 'I', LanguageType: 'L', Name: "Sakata"},
 	"sku": {Part3: "sku", Scope: 'I', LanguageType: 'L', Name: "Sakao"},
@@ -6010,9 +6017,9 @@ var LanguagesPart3 = map[string]Language{
 	"sps": {Part3: "sps", Scope: 'I', LanguageType: 'L', Name: "Saposa"},
 	"spt": {Part3: "spt", Scope: 'I', LanguageType: 'L', Name: "Spiti Bhoti"},
 	"spu": {Part3: "spu", Scope: 'I', LanguageType: 'L', Name: "Sapuan"},
-	"spv": {Part3: "spv", Scope: 'I', LanguageType: 'L', Name: "Sambalpuri"},
+	"spv": {Part3: "spv", Scope: 'I', LanguageType: 'L', Name: "Sambalpuri", MacrolanguageCode: "ori"},
 	"spx": {Part3: "spx", Scope: 'I', LanguageType: 'A', Name: "South Picene"},
-	"spy": {Part3: "spy", Scope: 'I', LanguageType: 'L', Name: "Sabaot"},
+	"spy": {Part3: "spy", Scope: 'I', LanguageType: 'L', Name: "Sabaot", MacrolanguageCode: "kln"},
 	"sqa": {Part3: "sqa", Scope: 'I', LanguageType: 'L', Name: "Shama-Sambuga"},
 	"sqh": {Part3: "sqh", Scope: 'I', LanguageType: 'L', Name: "Shau"},
 	"sqi": {Part3: "sqi", Part2B: "alb", Part2T: "sqi", Part1: "sq", Scope: 'M', LanguageType: 'L', Name: "Albanian"},
@@ -6028,7 +6035,7 @@ var LanguagesPart3 = map[string]Language{
 	"sqx": {Part3: "sqx", Scope: 'I', LanguageType: 'L', Name: "Kufr Qassem Sign Language (KQSL)"},
 	"sra": {Part3: "sra", Scope: 'I', LanguageType: 'L', Name: "Saruga"},
 	"srb": {Part3: "srb", Scope: 'I', LanguageType: 'L', Name: "Sora"},
-	"src": {Part3: "src", Scope: 'I', LanguageType: 'L', Name: "Logudorese Sardinian"},
+	"src": {Part3: "src", Scope: 'I', LanguageType: 'L', Name: "Logudorese Sardinian", MacrolanguageCode: "srd"},
 	"srd": {Part3: "srd", Part2B: "srd", Part2T: "srd", Part1: "sc", Scope: 'M', LanguageType: 'L', Name: "Sardinian"},
 	"sre": {Part3: "sre", Scope: 'I', LanguageType: 'L', Name: "Sara"},
 	"srf": {Part3: "srf", Scope: 'I', LanguageType: 'L', Name: "Nafi"},
@@ -6039,8 +6046,8 @@ var LanguagesPart3 = map[string]Language{
 	"srl": {Part3: "srl", Scope: 'I', LanguageType: 'L', Name: "Isirawa"},
 	"srm": {Part3: "srm", Scope: 'I', LanguageType: 'L', Name: "Saramaccan"},
 	"srn": {Part3: "srn", Part2B: "srn", Part2T: "srn", Scope: 'I', LanguageType: 'L', Name: "Sranan Tongo"},
-	"sro": {Part3: "sro", Scope: 'I', LanguageType: 'L', Name: "Campidanese Sardinian"},
-	"srp": {Part3: "srp", Part2B: "srp", Part2T: "srp", Part1: "sr", Scope: 'I', LanguageType: 'L', Name: "Serbian"},
+	"sro": {Part3: "sro", Scope: 'I', LanguageType: 'L', Name: "Campidanese Sardinian", MacrolanguageCode: "srd"},
+	"srp": {Part3: "srp", Part2B: "srp", Part2T: "srp", Part1: "sr", Scope: 'I', LanguageType: 'L', Name: "Serbian", MacrolanguageCode: "hbs"},
 	"srq": {Part3: "srq", Scope: 'I', LanguageType: 'L', Name: "Sirionó"},
 	"srr": {Part3: "srr", Part2B: "srr", Part2T: "srr", Scope: 'I', LanguageType: 'L', Name: "Serer"},
 	"srs": {Part3: "srs", Scope: 'I', LanguageType: 'L', Name: "Sarsi"},
@@ -6057,7 +6064,7 @@ var LanguagesPart3 = map[string]Language{
 	"sse": {Part3: "sse", Scope: 'I', LanguageType: 'L', Name: "Balangingi"},
 	"ssf": {Part3: "ssf", Scope: 'I', LanguageType: 'E', Name: "Thao"},
 	"ssg": {Part3: "ssg", Scope: 'I', LanguageType: 'L', Name: "Seimat"},
-	"ssh": {Part3: "ssh", Scope: 'I', LanguageType: 'L', Name: "Shihhi Arabic"},
+	"ssh": {Part3: "ssh", Scope: 'I', LanguageType: 'L', Name: "Shihhi Arabic", MacrolanguageCode: "ara"},
 	"ssi": {Part3: "ssi", Scope: 'I', LanguageType: 'L', Name: "Sansi"},
 	"ssj": {Part3: "ssj", Scope: 'I', LanguageType: 'L', Name: "Sausi"},
 	"ssk": {Part3: "ssk", Scope: 'I', LanguageType: 'L', Name: "Sunam"},
@@ -6128,11 +6135,11 @@ var LanguagesPart3 = map[string]Language{
 	"svx": {Part3: "svx", Scope: 'I', LanguageType: 'H', Name: "Skalvian"},
 	"swa": {Part3: "swa", Part2B: "swa", Part2T: "swa", Part1: "sw", Scope: 'M', LanguageType: 'L', Name: "Swahili (macrolanguage)"},
 	"swb": {Part3: "swb", Scope: 'I', LanguageType: 'L', Name: "Maore Comorian"},
-	"swc": {Part3: "swc", Scope: 'I', LanguageType: 'L', Name: "Congo Swahili"},
+	"swc": {Part3: "swc", Scope: 'I', LanguageType: 'L', Name: "Congo Swahili", MacrolanguageCode: "swa"},
 	"swe": {Part3: "swe", Part2B: "swe", Part2T: "swe", Part1: "sv", Scope: 'I', LanguageType: 'L', Name: "Swedish"},
 	"swf": {Part3: "swf", Scope: 'I', LanguageType: 'L', Name: "Sere"},
 	"swg": {Part3: "swg", Scope: 'I', LanguageType: 'L', Name: "Swabian"},
-	"swh": {Part3: "swh", Scope: 'I', LanguageType: 'L', Name: "Swahili (individual language)"},
+	"swh": {Part3: "swh", Scope: 'I', LanguageType: 'L', Name: "Swahili (individual language)", MacrolanguageCode: "swa"},
 	"swi": {Part3: "swi", Scope: 'I', LanguageType: 'L', Name: "Sui"},
 	"swj": {Part3: "swj", Scope: 'I', LanguageType: 'L', Name: "Sira"},
 	"swk": {Part3: "swk", Scope: 'I', LanguageType: 'L', Name: "Malawi Sena"},
@@ -6146,7 +6153,7 @@ var LanguagesPart3 = map[string]Language{
 	"sws": {Part3: "sws", Scope: 'I', LanguageType: 'L', Name: "Seluwasan"},
 	"swt": {Part3: "swt", Scope: 'I', LanguageType: 'L', Name: "Sawila"},
 	"swu": {Part3: "swu", Scope: 'I', LanguageType: 'L', Name: "Suwawa"},
-	"swv": {Part3: "swv", Scope: 'I', LanguageType: 'L', Name: "Shekhawati"},
+	"swv": {Part3: "swv", Scope: 'I', LanguageType: 'L', Name: "Shekhawati", MacrolanguageCode: "mwr"},
 	"sww": {Part3: "sww", Scope: 'I', LanguageType: 'E', Name: "Sowa"},
 	"swx": {Part3: "swx", Scope: 'I', LanguageType: 'L', Name: "Suruahá"},
 	"swy": {Part3: "swy", Scope: 'I', LanguageType: 'L', Name: "Sarua"},
@@ -6205,7 +6212,7 @@ var LanguagesPart3 = map[string]Language{
 	"tan": {Part3: "tan", Scope: 'I', LanguageType: 'L', Name: "Tangale"},
 	"tao": {Part3: "tao", Scope: 'I', LanguageType: 'L', Name: "Yami"},
 	"tap": {Part3: "tap", Scope: 'I', LanguageType: 'L', Name: "Taabwa"},
-	"taq": {Part3: "taq", Scope: 'I', LanguageType: 'L', Name: "Tamasheq"},
+	"taq": {Part3: "taq", Scope: 'I', LanguageType: 'L', Name: "Tamasheq", MacrolanguageCode: "tmh"},
 	"tar": {Part3: "tar", Scope: 'I', LanguageType: 'L', Name: "Central Tarahumara"},
 	"tas": {Part3: "tas", Scope: 'I', LanguageType: 'E', Name: "Tay Boi"},
 	"tat": {Part3: "tat", Part2B: "tat", Part2T: "tat", Part1: "tt", Scope: 'I', LanguageType: 'L', Name: "Tatar"},
@@ -6282,11 +6289,11 @@ var LanguagesPart3 = map[string]Language{
 	"tds": {Part3: "tds", Scope: 'I', LanguageType: 'L', Name: "Doutai"},
 	"tdt": {Part3: "tdt", Scope: 'I', LanguageType: 'L', Name: "Tetun Dili"},
 	"tdv": {Part3: "tdv", Scope: 'I', LanguageType: 'L', Name: "Toro"},
-	"tdx": {Part3: "tdx", Scope: 'I', LanguageType: 'L', Name: "Tandroy-Mahafaly Malagasy"},
+	"tdx": {Part3: "tdx", Scope: 'I', LanguageType: 'L', Name: "Tandroy-Mahafaly Malagasy", MacrolanguageCode: "mlg"},
 	"tdy": {Part3: "tdy", Scope: 'I', LanguageType: 'L', Name: "Tadyawan"},
 	"tea": {Part3: "tea", Scope: 'I', LanguageType: 'L', Name: "Temiar"},
 	"teb": {Part3: "teb", Scope: 'I', LanguageType: 'E', Name: "Tetete"},
-	"tec": {Part3: "tec", Scope: 'I', LanguageType: 'L', Name: "Terik"},
+	"tec": {Part3: "tec", Scope: 'I', LanguageType: 'L', Name: "Terik", MacrolanguageCode: "kln"},
 	"ted": {Part3: "ted", Scope: 'I', LanguageType: 'L', Name: "Tepo Krumen"},
 	"tee": {Part3: "tee", Scope: 'I', LanguageType: 'L', Name: "Huehuetla Tepehua"},
 	"tef": {Part3: "tef", Scope: 'I', LanguageType: 'L', Name: "Teressa"},
@@ -6354,9 +6361,9 @@ var LanguagesPart3 = map[string]Language{
 	"ths": {Part3: "ths", Scope: 'I', LanguageType: 'L', Name: "Thakali"},
 	"tht": {Part3: "tht", Scope: 'I', LanguageType: 'L', Name: "Tahltan"},
 	"thu": {Part3: "thu", Scope: 'I', LanguageType: 'L', Name: "Thuri"},
-	"thv": {Part3: "thv", Scope: 'I', LanguageType: 'L', Name: "Tahaggart Tamahaq"},
+	"thv": {Part3: "thv", Scope: 'I', LanguageType: 'L', Name: "Tahaggart Tamahaq", MacrolanguageCode: "tmh"},
 	"thy": {Part3: "thy", Scope: 'I', LanguageType: 'L', Name: "Tha"},
-	"thz": {Part3: "thz", Scope: 'I', LanguageType: 'L', Name: "Tayart Tamajeq"},
+	"thz": {Part3: "thz", Scope: 'I', LanguageType: 'L', Name: "Tayart Tamajeq", MacrolanguageCode: "tmh"},
 	"tia": {Part3: "tia", Scope: 'I', LanguageType: 'L', Name: "Tidikelt Tamazight"},
 	"tic": {Part3: "tic", Scope: 'I', LanguageType: 'L', Name: "Tira"},
 	"tif": {Part3: "tif", Scope: 'I', LanguageType: 'L', Name: "Tifal"},
@@ -6397,7 +6404,7 @@ var LanguagesPart3 = map[string]Language{
 	"tkd": {Part3: "tkd", Scope: 'I', LanguageType: 'L', Name: "Tukudede"},
 	"tke": {Part3: "tke", Scope: 'I', LanguageType: 'L', Name: "Takwane"},
 	"tkf": {Part3: "tkf", Scope: 'I', LanguageType: 'E', Name: "Tukumanféd"},
-	"tkg": {Part3: "tkg", Scope: 'I', LanguageType: 'L', Name: "Tesaka Malagasy"},
+	"tkg": {Part3: "tkg", Scope: 'I', LanguageType: 'L', Name: "Tesaka Malagasy", MacrolanguageCode: "mlg"},
 	"tkl": {Part3: "tkl", Part2B: "tkl", Part2T: "tkl", Scope: 'I', LanguageType: 'L', Name: "Tokelau"},
 	"tkm": {Part3: "tkm", Scope: 'I', LanguageType: 'E', Name: "Takelma"},
 	"tkn": {Part3: "tkn", Scope: 'I', LanguageType: 'L', Name: "Toku-No-Shima"},
@@ -6455,7 +6462,7 @@ var LanguagesPart3 = map[string]Language{
 	"tmt": {Part3: "tmt", Scope: 'I', LanguageType: 'L', Name: "Tasmate"},
 	"tmu": {Part3: "tmu", Scope: 'I', LanguageType: 'L', Name: "Iau"},
 	"tmv": {Part3: "tmv", Scope: 'I', LanguageType: 'L', Name: "Tembo (Motembo)"},
-	"tmw": {Part3: "tmw", Scope: 'I', LanguageType: 'L', Name: "Temuan"},
+	"tmw": {Part3: "tmw", Scope: 'I', LanguageType: 'L', Name: "Temuan", MacrolanguageCode: "msa"},
 	"tmy": {Part3: "tmy", Scope: 'I', LanguageType: 'L', Name: "Tami"},
 	"tmz": {Part3: "tmz", Scope: 'I', LanguageType: 'E', Name: "Tamanaku"},
 	"tna": {Part3: "tna", Scope: 'I', LanguageType: 'L', Name: "Tacana"},
@@ -6602,7 +6609,7 @@ var LanguagesPart3 = map[string]Language{
 	"ttn": {Part3: "ttn", Scope: 'I', LanguageType: 'L', Name: "Towei"},
 	"tto": {Part3: "tto", Scope: 'I', LanguageType: 'L', Name: "Lower Ta'oih"},
 	"ttp": {Part3: "ttp", Scope: 'I', LanguageType: 'L', Name: "Tombelala"},
-	"ttq": {Part3: "ttq", Scope: 'I', LanguageType: 'L', Name: "Tawallammat Tamajaq"},
+	"ttq": {Part3: "ttq", Scope: 'I', LanguageType: 'L', Name: "Tawallammat Tamajaq", MacrolanguageCode: "tmh"},
 	"ttr": {Part3: "ttr", Scope: 'I', LanguageType: 'L', Name: "Tera"},
 	"tts": {Part3: "tts", Scope: 'I', LanguageType: 'L', Name: "Northeastern Thai"},
 	"ttt": {Part3: "ttt", Scope: 'I', LanguageType: 'L', Name: "Muslim Tat"},
@@ -6632,7 +6639,7 @@ var LanguagesPart3 = map[string]Language{
 	"tuu": {Part3: "tuu", Scope: 'I', LanguageType: 'L', Name: "Tututni"},
 	"tuv": {Part3: "tuv", Scope: 'I', LanguageType: 'L', Name: "Turkana"},
 	"tux": {Part3: "tux", Scope: 'I', LanguageType: 'E', Name: "Tuxináwa"},
-	"tuy": {Part3: "tuy", Scope: 'I', LanguageType: 'L', Name: "Tugen"},
+	"tuy": {Part3: "tuy", Scope: 'I', LanguageType: 'L', Name: "Tugen", MacrolanguageCode: "kln"},
 	"tuz": {Part3: "tuz", Scope: 'I', LanguageType: 'L', Name: "Turka"},
 	"tva": {Part3: "tva", Scope: 'I', LanguageType: 'L', Name: "Vaghua"},
 	"tvd": {Part3: "tvd", Scope: 'I', LanguageType: 'L', Name: "Tsuvadi"},
@@ -6656,7 +6663,7 @@ var LanguagesPart3 = map[string]Language{
 	"twf": {Part3: "twf", Scope: 'I', LanguageType: 'L', Name: "Northern Tiwa"},
 	"twg": {Part3: "twg", Scope: 'I', LanguageType: 'L', Name: "Tereweng"},
 	"twh": {Part3: "twh", Scope: 'I', LanguageType: 'L', Name: "Tai Dón"},
-	"twi": {Part3: "twi", Part2B: "twi", Part2T: "twi", Part1: "tw", Scope: 'I', LanguageType: 'L', Name: "Twi"},
+	"twi": {Part3: "twi", Part2B: "twi", Part2T: "twi", Part1: "tw", Scope: 'I', LanguageType: 'L', Name: "Twi", MacrolanguageCode: "aka"},
 	"twl": {Part3: "twl", Scope: 'I', LanguageType: 'L', Name: "Tawara"},
 	"twm": {Part3: "twm", Scope: 'I', LanguageType: 'L', Name: "Tawang Monpa"},
 	"twn": {Part3: "twn", Scope: 'I', LanguageType: 'L', Name: "Twendi"},
@@ -6686,7 +6693,7 @@ var LanguagesPart3 = map[string]Language{
 	"txt": {Part3: "txt", Scope: 'I', LanguageType: 'L', Name: "Citak"},
 	"txu": {Part3: "txu", Scope: 'I', LanguageType: 'L', Name: "Kayapó"},
 	"txx": {Part3: "txx", Scope: 'I', LanguageType: 'L', Name: "Tatana"},
-	"txy": {Part3: "txy", Scope: 'I', LanguageType: 'L', Name: "Tanosy Malagasy"},
+	"txy": {Part3: "txy", Scope: 'I', LanguageType: 'L', Name: "Tanosy Malagasy", MacrolanguageCode: "mlg"},
 	"tya": {Part3: "tya", Scope: 'I', LanguageType: 'L', Name: "Tauya"},
 	"tye": {Part3: "tye", Scope: 'I', LanguageType: 'L', Name: "Kyanga"},
 	"tyh": {Part3: "tyh", Scope: 'I', LanguageType: 'L', Name: "O'du"},
@@ -6716,7 +6723,7 @@ var LanguagesPart3 = map[string]Language{
 	"uar": {Part3: "uar", Scope: 'I', LanguageType: 'L', Name: "Tairuma"},
 	"uba": {Part3: "uba", Scope: 'I', LanguageType: 'L', Name: "Ubang"},
 	"ubi": {Part3: "ubi", Scope: 'I', LanguageType: 'L', Name: "Ubi"},
-	"ubl": {Part3: "ubl", Scope: 'I', LanguageType: 'L', Name: "Buhi'non Bikol"},
+	"ubl": {Part3: "ubl", Scope: 'I', LanguageType: 'L', Name: "Buhi'non Bikol", MacrolanguageCode: "bik"},
 	"ubr": {Part3: "ubr", Scope: 'I', LanguageType: 'L', Name: "Ubir"},
 	"ubu": {Part3: "ubu", Scope: 'I', LanguageType: 'L', Name: "Umbu-Ungu"},
 	"uby": {Part3: "uby", Scope: 'I', LanguageType: 'E', Name: "Ubykh"},
@@ -6780,14 +6787,14 @@ var LanguagesPart3 = map[string]Language{
 	"ump": {Part3: "ump", Scope: 'I', LanguageType: 'L', Name: "Umpila"},
 	"umr": {Part3: "umr", Scope: 'I', LanguageType: 'E', Name: "Umbugarla"},
 	"ums": {Part3: "ums", Scope: 'I', LanguageType: 'L', Name: "Pendau"},
-	"umu": {Part3: "umu", Scope: 'I', LanguageType: 'L', Name: "Munsee"},
+	"umu": {Part3: "umu", Scope: 'I', LanguageType: 'L', Name: "Munsee", MacrolanguageCode: "del"},
 	"una": {Part3: "una", Scope: 'I', LanguageType: 'L', Name: "North Watut"},
 	"und": {Part3: "und", Part2B: "und", Part2T: "und", Scope: 'S', LanguageType: 'S', Name: "Undetermined"},
 	"une": {Part3: "une", Scope: 'I', LanguageType: 'L', Name: "Uneme"},
 	"ung": {Part3: "ung", Scope: 'I', LanguageType: 'L', Name: "Ngarinyin"},
 	"uni": {Part3: "uni", Scope: 'I', LanguageType: 'L', Name: "Uni"},
 	"unk": {Part3: "unk", Scope: 'I', LanguageType: 'L', Name: "Enawené-Nawé"},
-	"unm": {Part3: "unm", Scope: 'I', LanguageType: 'E', Name: "Unami"},
+	"unm": {Part3: "unm", Scope: 'I', LanguageType: 'E', Name: "Unami", MacrolanguageCode: "del"},
 	"unn": {Part3: "unn", Scope: 'I', LanguageType: 'L', Name: "Kurnai"},
 	"unr": {Part3: "unr", Scope: 'I', LanguageType: 'L', Name: "Mundari"},
 	"unu": {Part3: "unu", Scope: 'I', LanguageType: 'L', Name: "Unubahe"},
@@ -6804,7 +6811,7 @@ var LanguagesPart3 = map[string]Language{
 	"urg": {Part3: "urg", Scope: 'I', LanguageType: 'L', Name: "Urigina"},
 	"urh": {Part3: "urh", Scope: 'I', LanguageType: 'L', Name: "Urhobo"},
 	"uri": {Part3: "uri", Scope: 'I', LanguageType: 'L', Name: "Urim"},
-	"urk": {Part3: "urk", Scope: 'I', LanguageType: 'L', Name: "Urak Lawoi'"},
+	"urk": {Part3: "urk", Scope: 'I', LanguageType: 'L', Name: "Urak Lawoi'", MacrolanguageCode: "msa"},
 	"url": {Part3: "url", Scope: 'I', LanguageType: 'L', Name: "Urali"},
 	"urm": {Part3: "urm", Scope: 'I', LanguageType: 'L', Name: "Urapmin"},
 	"urn": {Part3: "urn", Scope: 'I', LanguageType: 'L', Name: "Uruangnirin"},
@@ -6841,8 +6848,8 @@ var LanguagesPart3 = map[string]Language{
 	"uwa": {Part3: "uwa", Scope: 'I', LanguageType: 'L', Name: "Kuku-Uwanh"},
 	"uya": {Part3: "uya", Scope: 'I', LanguageType: 'L', Name: "Doko-Uyanga"},
 	"uzb": {Part3: "uzb", Part2B: "uzb", Part2T: "uzb", Part1: "uz", Scope: 'M', LanguageType: 'L', Name: "Uzbek"},
-	"uzn": {Part3: "uzn", Scope: 'I', LanguageType: 'L', Name: "Northern Uzbek"},
-	"uzs": {Part3: "uzs", Scope: 'I', LanguageType: 'L', Name: "Southern Uzbek"},
+	"uzn": {Part3: "uzn", Scope: 'I', LanguageType: 'L', Name: "Northern Uzbek", MacrolanguageCode: "uzb"},
+	"uzs": {Part3: "uzs", Scope: 'I', LanguageType: 'L', Name: "Southern Uzbek", MacrolanguageCode: "uzb"},
 	"vaa": {Part3: "vaa", Scope: 'I', LanguageType: 'L', Name: "Vaagri Booli"},
 	"vae": {Part3: "vae", Scope: 'I', LanguageType: 'L', Name: "Vale"},
 	"vaf": {Part3: "vaf", Scope: 'I', LanguageType: 'L', Name: "Vafsi"},
@@ -6861,7 +6868,7 @@ var LanguagesPart3 = map[string]Language{
 	"vav": {Part3: "vav", Scope: 'I', LanguageType: 'L', Name: "Varli"},
 	"vay": {Part3: "vay", Scope: 'I', LanguageType: 'L', Name: "Wayu"},
 	"vbb": {Part3: "vbb", Scope: 'I', LanguageType: 'L', Name: "Southeast Babar"},
-	"vbk": {Part3: "vbk", Scope: 'I', LanguageType: 'L', Name: "Southwestern Bontok"},
+	"vbk": {Part3: "vbk", Scope: 'I', LanguageType: 'L', Name: "Southwestern Bontok", MacrolanguageCode: "bnc"},
 	"vec": {Part3: "vec", Scope: 'I', LanguageType: 'L', Name: "Venetian"},
 	"ved": {Part3: "ved", Scope: 'I', LanguageType: 'L', Name: "Veddah"},
 	"vel": {Part3: "vel", Scope: 'I', LanguageType: 'L', Name: "Veluws"},
@@ -6884,13 +6891,13 @@ var LanguagesPart3 = map[string]Language{
 	"viv": {Part3: "viv", Scope: 'I', LanguageType: 'L', Name: "Iduna"},
 	"vka": {Part3: "vka", Scope: 'I', LanguageType: 'E', Name: "Kariyarra"},
 	"vkj": {Part3: "vkj", Scope: 'I', LanguageType: 'L', Name: "Kujarge"},
-	"vkk": {Part3: "vkk", Scope: 'I', LanguageType: 'L', Name: "Kaur"},
+	"vkk": {Part3: "vkk", Scope: 'I', LanguageType: 'L', Name: "Kaur", MacrolanguageCode: "msa"},
 	"vkl": {Part3: "vkl", Scope: 'I', LanguageType: 'L', Name: "Kulisusu"},
 	"vkm": {Part3: "vkm", Scope: 'I', LanguageType: 'E', Name: "Kamakan"},
 	"vkn": {Part3: "vkn", Scope: 'I', LanguageType: 'L', Name: "Koro Nulu"},
 	"vko": {Part3: "vko", Scope: 'I', LanguageType: 'L', Name: "Kodeoha"},
 	"vkp": {Part3: "vkp", Scope: 'I', LanguageType: 'L', Name: "Korlai Creole Portuguese"},
-	"vkt": {Part3: "vkt", Scope: 'I', LanguageType: 'L', Name: "Tenggarong Kutai Malay"},
+	"vkt": {Part3: "vkt", Scope: 'I', LanguageType: 'L', Name: "Tenggarong Kutai Malay", MacrolanguageCode: "msa"},
 	"vku": {Part3: "vku", Scope: 'I', LanguageType: 'L', Name: "Kurrama"},
 	"vkz": {Part3: "vkz", Scope: 'I', LanguageType: 'L', Name: "Koro Zuba"},
 	"vlp": {Part3: "vlp", Scope: 'I', LanguageType: 'L', Name: "Valpei"},
@@ -6925,7 +6932,7 @@ var LanguagesPart3 = map[string]Language{
 	"vor": {Part3: "vor", Scope: 'I', LanguageType: 'L', Name: "Voro"},
 	"vot": {Part3: "vot", Part2B: "vot", Part2T: "vot", Scope: 'I', LanguageType: 'L', Name: "Votic"},
 	"vra": {Part3: "vra", Scope: 'I', LanguageType: 'L', Name: "Vera'a"},
-	"vro": {Part3: "vro", Scope: 'I', LanguageType: 'L', Name: "Võro"},
+	"vro": {Part3: "vro", Scope: 'I', LanguageType: 'L', Name: "Võro", MacrolanguageCode: "est"},
 	"vrs": {Part3: "vrs", Scope: 'I', LanguageType: 'L', Name: "Varisi"},
 	"vrt": {Part3: "vrt", Scope: 'I', LanguageType: 'L', Name: "Burmbar"},
 	"vsi": {Part3: "vsi", Scope: 'I', LanguageType: 'L', Name: "Moldova Sign Language"},
@@ -6973,7 +6980,7 @@ var LanguagesPart3 = map[string]Language{
 	"wbm": {Part3: "wbm", Scope: 'I', LanguageType: 'L', Name: "Wa"},
 	"wbp": {Part3: "wbp", Scope: 'I', LanguageType: 'L', Name: "Warlpiri"},
 	"wbq": {Part3: "wbq", Scope: 'I', LanguageType: 'L', Name: "Waddar"},
-	"wbr": {Part3: "wbr", Scope: 'I', LanguageType: 'L', Name: "Wagdi"},
+	"wbr": {Part3: "wbr", Scope: 'I', LanguageType: 'L', Name: "Wagdi", MacrolanguageCode: "raj"},
 	"wbs": {Part3: "wbs", Scope: 'I', LanguageType: 'L', Name: "West Bengal Sign Language"},
 	"wbt": {Part3: "wbt", Scope: 'I', LanguageType: 'L', Name: "Warnman"},
 	"wbv": {Part3: "wbv", Scope: 'I', LanguageType: 'L', Name: "Wajarri"},
@@ -7120,10 +7127,10 @@ var LanguagesPart3 = map[string]Language{
 	"wrv": {Part3: "wrv", Scope: 'I', LanguageType: 'L', Name: "Waruna"},
 	"wrw": {Part3: "wrw", Scope: 'I', LanguageType: 'E', Name: "Gugu Warra"},
 	"wrx": {Part3: "wrx", Scope: 'I', LanguageType: 'L', Name: "Wae Rana"},
-	"wry": {Part3: "wry", Scope: 'I', LanguageType: 'L', Name: "Merwari"},
+	"wry": {Part3: "wry", Scope: 'I', LanguageType: 'L', Name: "Merwari", MacrolanguageCode: "mwr"},
 	"wrz": {Part3: "wrz", Scope: 'I', LanguageType: 'E', Name: "Waray (Australia)"},
 	"wsa": {Part3: "wsa", Scope: 'I', LanguageType: 'L', Name: "Warembori"},
-	"wsg": {Part3: "wsg", Scope: 'I', LanguageType: 'L', Name: "Adilabad Gondi"},
+	"wsg": {Part3: "wsg", Scope: 'I', LanguageType: 'L', Name: "Adilabad Gondi", MacrolanguageCode: "gon"},
 	"wsi": {Part3: "wsi", Scope: 'I', LanguageType: 'L', Name: "Wusi"},
 	"wsk": {Part3: "wsk", Scope: 'I', LanguageType: 'L', Name: "Waskia"},
 	"wsr": {Part3: "wsr", Scope: 'I', LanguageType: 'L', Name: "Owenia"},
@@ -7145,7 +7152,7 @@ var LanguagesPart3 = map[string]Language{
 	"wun": {Part3: "wun", Scope: 'I', LanguageType: 'L', Name: "Bungu"},
 	"wur": {Part3: "wur", Scope: 'I', LanguageType: 'E', Name: "Wurrugu"},
 	"wut": {Part3: "wut", Scope: 'I', LanguageType: 'L', Name: "Wutung"},
-	"wuu": {Part3: "wuu", Scope: 'I', LanguageType: 'L', Name: "Wu Chinese"},
+	"wuu": {Part3: "wuu", Scope: 'I', LanguageType: 'L', Name: "Wu Chinese", MacrolanguageCode: "zho"},
 	"wuv": {Part3: "wuv", Scope: 'I', LanguageType: 'L', Name: "Wuvulu-Aua"},
 	"wux": {Part3: "wux", Scope: 'I', LanguageType: 'L', Name: "Wulna"},
 	"wuy": {Part3: "wuy", Scope: 'I', LanguageType: 'L', Name: "Wauyai"},
@@ -7244,7 +7251,7 @@ var LanguagesPart3 = map[string]Language{
 	"xha": {Part3: "xha", Scope: 'I', LanguageType: 'A', Name: "Harami"},
 	"xhc": {Part3: "xhc", Scope: 'I', LanguageType: 'A', Name: "Hunnic"},
 	"xhd": {Part3: "xhd", Scope: 'I', LanguageType: 'A', Name: "Hadrami"},
-	"xhe": {Part3: "xhe", Scope: 'I', LanguageType: 'L', Name: "Khetrani"},
+	"xhe": {Part3: "xhe", Scope: 'I', LanguageType: 'L', Name: "Khetrani", MacrolanguageCode: "lah"},
 	"xho": {Part3: "xho", Part2B: "xho", Part2T: "xho", Part1: "xh", Scope: 'I', LanguageType: 'L', Name: "Xhosa"},
 	"xhr": {Part3: "xhr", Scope: 'I', LanguageType: 'A', Name: "Hernican"},
 	"xht": {Part3: "xht", Scope: 'I', LanguageType: 'A', Name: "Hattic"},
@@ -7308,7 +7315,7 @@ var LanguagesPart3 = map[string]Language{
 	"xmj": {Part3: "xmj", Scope: 'I', LanguageType: 'L', Name: "Majera"},
 	"xmk": {Part3: "xmk", Scope: 'I', LanguageType: 'A', Name: "Ancient Macedonian"},
 	"xml": {Part3: "xml", Scope: 'I', LanguageType: 'L', Name: "Malaysian Sign Language"},
-	"xmm": {Part3: "xmm", Scope: 'I', LanguageType: 'L', Name: "Manado Malay"},
+	"xmm": {Part3: "xmm", Scope: 'I', LanguageType: 'L', Name: "Manado Malay", MacrolanguageCode: "msa"},
 	"xmn": {Part3: "xmn", Scope: 'I', LanguageType: 'H', Name: "Manichaean Middle Persian"},
 	"xmo": {Part3: "xmo", Scope: 'I', LanguageType: 'L', Name: "Morerebi"},
 	"xmp": {Part3: "xmp", Scope: 'I', LanguageType: 'E', Name: "Kuku-Mu'inh"},
@@ -7317,8 +7324,8 @@ var LanguagesPart3 = map[string]Language{
 	"xms": {Part3: "xms", Scope: 'I', LanguageType: 'L', Name: "Moroccan Sign Language"},
 	"xmt": {Part3: "xmt", Scope: 'I', LanguageType: 'L', Name: "Matbat"},
 	"xmu": {Part3: "xmu", Scope: 'I', LanguageType: 'E', Name: "Kamu"},
-	"xmv": {Part3: "xmv", Scope: 'I', LanguageType: 'L', Name: "Antankarana Malagasy"},
-	"xmw": {Part3: "xmw", Scope: 'I', LanguageType: 'L', Name: "Tsimihety Malagasy"},
+	"xmv": {Part3: "xmv", Scope: 'I', LanguageType: 'L', Name: "Antankarana Malagasy", MacrolanguageCode: "mlg"},
+	"xmw": {Part3: "xmw", Scope: 'I', LanguageType: 'L', Name: "Tsimihety Malagasy", MacrolanguageCode: "mlg"},
 	"xmx": {Part3: "xmx", Scope: 'I', LanguageType: 'L', Name: "Maden"},
 	"xmy": {Part3: "xmy", Scope: 'I', LanguageType: 'L', Name: "Mayaguduna"},
 	"xmz": {Part3: "xmz", Scope: 'I', LanguageType: 'L', Name: "Mori Bawah"},
@@ -7333,7 +7340,7 @@ var LanguagesPart3 = map[string]Language{
 	"xnn": {Part3: "xnn", Scope: 'I', LanguageType: 'L', Name: "Northern Kankanay"},
 	"xno": {Part3: "xno", Scope: 'I', LanguageType: 'H', Name: "Anglo-Norman"},
 	"xnq": {Part3: "xnq", Scope: 'I', LanguageType: 'L', Name: "Ngoni (Mozambique)"},
-	"xnr": {Part3: "xnr", Scope: 'I', LanguageType: 'L', Name: "Kangri"},
+	"xnr": {Part3: "xnr", Scope: 'I', LanguageType: 'L', Name: "Kangri", MacrolanguageCode: "doi"},
 	"xns": {Part3: "xns", Scope: 'I', LanguageType: 'L', Name: "Kanashi"},
 	"xnt": {Part3: "xnt", Scope: 'I', LanguageType: 'E', Name: "Narragansett"},
 	"xnu": {Part3: "xnu", Scope: 'I', LanguageType: 'E', Name: "Nukunul"},
@@ -7354,7 +7361,7 @@ var LanguagesPart3 = map[string]Language{
 	"xpb": {Part3: "xpb", Scope: 'I', LanguageType: 'E', Name: "Northeastern Tasmanian"},
 	"xpc": {Part3: "xpc", Scope: 'I', LanguageType: 'H', Name: "Pecheneg"},
 	"xpd": {Part3: "xpd", Scope: 'I', LanguageType: 'E', Name: "Oyster Bay Tasmanian"},
-	"xpe": {Part3: "xpe", Scope: 'I', LanguageType: 'L', Name: "Liberia Kpelle"},
+	"xpe": {Part3: "xpe", Scope: 'I', LanguageType: 'L', Name: "Liberia Kpelle", MacrolanguageCode: "kpe"},
 	"xpf": {Part3: "xpf", Scope: 'I', LanguageType: 'E', Name: "Southeast Tasmanian"},
 	"xpg": {Part3: "xpg", Scope: 'I', LanguageType: 'A', Name: "Phrygian"},
 	"xph": {Part3: "xph", Scope: 'I', LanguageType: 'E', Name: "North Midlands Tasmanian"},
@@ -7398,7 +7405,7 @@ var LanguagesPart3 = map[string]Language{
 	"xsh": {Part3: "xsh", Scope: 'I', LanguageType: 'L', Name: "Shamang"},
 	"xsi": {Part3: "xsi", Scope: 'I', LanguageType: 'L', Name: "Sio"},
 	"xsj": {Part3: "xsj", Scope: 'I', LanguageType: 'L', Name: "Subi"},
-	"xsl": {Part3: "xsl", Scope: 'I', LanguageType: 'L', Name: "South Slavey"},
+	"xsl": {Part3: "xsl", Scope: 'I', LanguageType: 'L', Name: "South Slavey", MacrolanguageCode: "den"},
 	"xsm": {Part3: "xsm", Scope: 'I', LanguageType: 'L', Name: "Kasem"},
 	"xsn": {Part3: "xsn", Scope: 'I', LanguageType: 'L', Name: "Sanga (Nigeria)"},
 	"xso": {Part3: "xso", Scope: 'I', LanguageType: 'E', Name: "Solano"},
@@ -7520,7 +7527,7 @@ var LanguagesPart3 = map[string]Language{
 	"ycn": {Part3: "ycn", Scope: 'I', LanguageType: 'L', Name: "Yucuna"},
 	"ycp": {Part3: "ycp", Scope: 'I', LanguageType: 'L', Name: "Chepya"},
 	"yda": {Part3: "yda", Scope: 'I', LanguageType: 'E', Name: "Yanda"},
-	"ydd": {Part3: "ydd", Scope: 'I', LanguageType: 'L', Name: "Eastern Yiddish"},
+	"ydd": {Part3: "ydd", Scope: 'I', LanguageType: 'L', Name: "Eastern Yiddish", MacrolanguageCode: "yid"},
 	"yde": {Part3: "yde", Scope: 'I', LanguageType: 'L', Name: "Yangum Dey"},
 	"ydg": {Part3: "ydg", Scope: 'I', LanguageType: 'L', Name: "Yidgha"},
 	"ydk": {Part3: "ydk", Scope: 'I', LanguageType: 'L', Name: "Yoidik"},
@@ -7546,14 +7553,14 @@ var LanguagesPart3 = map[string]Language{
 	"ygu": {Part3: "ygu", Scope: 'I', LanguageType: 'L', Name: "Yugul"},
 	"ygw": {Part3: "ygw", Scope: 'I', LanguageType: 'L', Name: "Yagwoia"},
 	"yha": {Part3: "yha", Scope: 'I', LanguageType: 'L', Name: "Baha Buyang"},
-	"yhd": {Part3: "yhd", Scope: 'I', LanguageType: 'L', Name: "Judeo-Iraqi Arabic"},
+	"yhd": {Part3: "yhd", Scope: 'I', LanguageType: 'L', Name: "Judeo-Iraqi Arabic", MacrolanguageCode: "jrb"},
 	"yhl": {Part3: "yhl", Scope: 'I', LanguageType: 'L', Name: "Hlepho Phowa"},
 	"yhs": {Part3: "yhs", Scope: 'I', LanguageType: 'L', Name: "Yan-nhaŋu Sign Language"},
 	"yia": {Part3: "yia", Scope: 'I', LanguageType: 'L', Name: "Yinggarda"},
 	"yid": {Part3: "yid", Part2B: "yid", Part2T: "yid", Part1: "yi", Scope: 'M', LanguageType: 'L', Name: "Yiddish"},
 	"yif": {Part3: "yif", Scope: 'I', LanguageType: 'L', Name: "Ache"},
 	"yig": {Part3: "yig", Scope: 'I', LanguageType: 'L', Name: "Wusa Nasu"},
-	"yih": {Part3: "yih", Scope: 'I', LanguageType: 'L', Name: "Western Yiddish"},
+	"yih": {Part3: "yih", Scope: 'I', LanguageType: 'L', Name: "Western Yiddish", MacrolanguageCode: "yid"},
 	"yii": {Part3: "yii", Scope: 'I', LanguageType: 'L', Name: "Yidiny"},
 	"yij": {Part3: "yij", Scope: 'I', LanguageType: 'L', Name: "Yindjibarndi"},
 	"yik": {Part3: "yik", Scope: 'I', LanguageType: 'L', Name: "Dongshanba Lalo"},
@@ -7672,8 +7679,8 @@ var LanguagesPart3 = map[string]Language{
 	"yua": {Part3: "yua", Scope: 'I', LanguageType: 'L', Name: "Yucateco"},
 	"yub": {Part3: "yub", Scope: 'I', LanguageType: 'E', Name: "Yugambal"},
 	"yuc": {Part3: "yuc", Scope: 'I', LanguageType: 'L', Name: "Yuchi"},
-	"yud": {Part3: "yud", Scope: 'I', LanguageType: 'L', Name: "Judeo-Tripolitanian Arabic"},
-	"yue": {Part3: "yue", Scope: 'I', LanguageType: 'L', Name: "Yue Chinese"},
+	"yud": {Part3: "yud", Scope: 'I', LanguageType: 'L', Name: "Judeo-Tripolitanian Arabic", MacrolanguageCode: "jrb"},
+	"yue": {Part3: "yue", Scope: 'I', LanguageType: 'L', Name: "Yue Chinese", MacrolanguageCode: "zho"},
 	"yuf": {Part3: "yuf", Scope: 'I', LanguageType: 'L', Name: "Havasupai-Walapai-Yavapai"},
 	"yug": {Part3: "yug", Scope: 'I', LanguageType: 'E', Name: "Yug"},
 	"yui": {Part3: "yui", Scope: 'I', LanguageType: 'L', Name: "Yurutí"},
@@ -7712,29 +7719,29 @@ var LanguagesPart3 = map[string]Language{
 	"yyz": {Part3: "yyz", Scope: 'I', LanguageType: 'L', Name: "Ayizi"},
 	"yzg": {Part3: "yzg", Scope: 'I', LanguageType: 'L', Name: "E'ma Buyang"},
 	"yzk": {Part3: "yzk", Scope: 'I', LanguageType: 'L', Name: "Zokhuo"},
-	"zaa": {Part3: "zaa", Scope: 'I', LanguageType: 'L', Name: "Sierra de Juárez Zapotec"},
-	"zab": {Part3: "zab", Scope: 'I', LanguageType: 'L', Name: "Western Tlacolula Valley Zapotec"},
-	"zac": {Part3: "zac", Scope: 'I', LanguageType: 'L', Name: "Ocotlán Zapotec"},
-	"zad": {Part3: "zad", Scope: 'I', LanguageType: 'L', Name: "Cajonos Zapotec"},
-	"zae": {Part3: "zae", Scope: 'I', LanguageType: 'L', Name: "Yareni Zapotec"},
-	"zaf": {Part3: "zaf", Scope: 'I', LanguageType: 'L', Name: "Ayoquesco Zapotec"},
+	"zaa": {Part3: "zaa", Scope: 'I', LanguageType: 'L', Name: "Sierra de Juárez Zapotec", MacrolanguageCode: "zap"},
+	"zab": {Part3: "zab", Scope: 'I', LanguageType: 'L', Name: "Western Tlacolula Valley Zapotec", MacrolanguageCode: "zap"},
+	"zac": {Part3: "zac", Scope: 'I', LanguageType: 'L', Name: "Ocotlán Zapotec", MacrolanguageCode: "zap"},
+	"zad": {Part3: "zad", Scope: 'I', LanguageType: 'L', Name: "Cajonos Zapotec", MacrolanguageCode: "zap"},
+	"zae": {Part3: "zae", Scope: 'I', LanguageType: 'L', Name: "Yareni Zapotec", MacrolanguageCode: "zap"},
+	"zaf": {Part3: "zaf", Scope: 'I', LanguageType: 'L', Name: "Ayoquesco Zapotec", MacrolanguageCode: "zap"},
 	"zag": {Part3: "zag", Scope: 'I', LanguageType: 'L', Name: "Zaghawa"},
 	"zah": {Part3: "zah", Scope: 'I', LanguageType: 'L', Name: "Zangwal"},
-	"zai": {Part3: "zai", Scope: 'I', LanguageType: 'L', Name: "Isthmus Zapotec"},
+	"zai": {Part3: "zai", Scope: 'I', LanguageType: 'L', Name: "Isthmus Zapotec", MacrolanguageCode: "zap"},
 	"zaj": {Part3: "zaj", Scope: 'I', LanguageType: 'L', Name: "Zaramo"},
 	"zak": {Part3: "zak", Scope: 'I', LanguageType: 'L', Name: "Zanaki"},
 	"zal": {Part3: "zal", Scope: 'I', LanguageType: 'L', Name: "Zauzou"},
-	"zam": {Part3: "zam", Scope: 'I', LanguageType: 'L', Name: "Miahuatlán Zapotec"},
-	"zao": {Part3: "zao", Scope: 'I', LanguageType: 'L', Name: "Ozolotepec Zapotec"},
+	"zam": {Part3: "zam", Scope: 'I', LanguageType: 'L', Name: "Miahuatlán Zapotec", MacrolanguageCode: "zap"},
+	"zao": {Part3: "zao", Scope: 'I', LanguageType: 'L', Name: "Ozolotepec Zapotec", MacrolanguageCode: "zap"},
 	"zap": {Part3: "zap", Part2B: "zap", Part2T: "zap", Scope: 'M', LanguageType: 'L', Name: "Zapotec"},
-	"zaq": {Part3: "zaq", Scope: 'I', LanguageType: 'L', Name: "Aloápam Zapotec"},
-	"zar": {Part3: "zar", Scope: 'I', LanguageType: 'L', Name: "Rincón Zapotec"},
-	"zas": {Part3: "zas", Scope: 'I', LanguageType: 'L', Name: "Santo Domingo Albarradas Zapotec"},
-	"zat": {Part3: "zat", Scope: 'I', LanguageType: 'L', Name: "Tabaa Zapotec"},
+	"zaq": {Part3: "zaq", Scope: 'I', LanguageType: 'L', Name: "Aloápam Zapotec", MacrolanguageCode: "zap"},
+	"zar": {Part3: "zar", Scope: 'I', LanguageType: 'L', Name: "Rincón Zapotec", MacrolanguageCode: "zap"},
+	"zas": {Part3: "zas", Scope: 'I', LanguageType: 'L', Name: "Santo Domingo Albarradas Zapotec", MacrolanguageCode: "zap"},
+	"zat": {Part3: "zat", Scope: 'I', LanguageType: 'L', Name: "Tabaa Zapotec", MacrolanguageCode: "zap"},
 	"zau": {Part3: "zau", Scope: 'I', LanguageType: 'L', Name: "Zangskari"},
-	"zav": {Part3: "zav", Scope: 'I', LanguageType: 'L', Name: "Yatzachi Zapotec"},
-	"zaw": {Part3: "zaw", Scope: 'I', LanguageType: 'L', Name: "Mitla Zapotec"},
-	"zax": {Part3: "zax", Scope: 'I', LanguageType: 'L', Name: "Xadani Zapotec"},
+	"zav": {Part3: "zav", Scope: 'I', LanguageType: 'L', Name: "Yatzachi Zapotec", MacrolanguageCode: "zap"},
+	"zaw": {Part3: "zaw", Scope: 'I', LanguageType: 'L', Name: "Mitla Zapotec", MacrolanguageCode: "zap"},
+	"zax": {Part3: "zax", Scope: 'I', LanguageType: 'L', Name: "Xadani Zapotec", MacrolanguageCode: "zap"},
 	"zay": {Part3: "zay", Scope: 'I', LanguageType: 'L', Name: "Zayse-Zergulla"},
 	"zaz": {Part3: "zaz", Scope: 'I', LanguageType: 'L', Name: "Zari"},
 	"zba": {Part3: "zba", Scope: 'I', LanguageType: 'C', Name: "Balaibalan"},
@@ -7744,24 +7751,24 @@ var LanguagesPart3 = map[string]Language{
 	"zbt": {Part3: "zbt", Scope: 'I', LanguageType: 'L', Name: "Batui"},
 	"zbu": {Part3: "zbu", Scope: 'I', LanguageType: 'L', Name: "Bu (Bauchi State)"},
 	"zbw": {Part3: "zbw", Scope: 'I', LanguageType: 'L', Name: "West Berawan"},
-	"zca": {Part3: "zca", Scope: 'I', LanguageType: 'L', Name: "Coatecas Altas Zapotec"},
-	"zch": {Part3: "zch", Scope: 'I', LanguageType: 'L', Name: "Central Hongshuihe Zhuang"},
+	"zca": {Part3: "zca", Scope: 'I', LanguageType: 'L', Name: "Coatecas Altas Zapotec", MacrolanguageCode: "zap"},
+	"zch": {Part3: "zch", Scope: 'I', LanguageType: 'L', Name: "Central Hongshuihe Zhuang", MacrolanguageCode: "zha"},
 	"zdj": {Part3: "zdj", Scope: 'I', LanguageType: 'L', Name: "Ngazidja Comorian"},
 	"zea": {Part3: "zea", Scope: 'I', LanguageType: 'L', Name: "Zeeuws"},
 	"zeg": {Part3: "zeg", Scope: 'I', LanguageType: 'L', Name: "Zenag"},
-	"zeh": {Part3: "zeh", Scope: 'I', LanguageType: 'L', Name: "Eastern Hongshuihe Zhuang"},
+	"zeh": {Part3: "zeh", Scope: 'I', LanguageType: 'L', Name: "Eastern Hongshuihe Zhuang", MacrolanguageCode: "zha"},
 	"zen": {Part3: "zen", Part2B: "zen", Part2T: "zen", Scope: 'I', LanguageType: 'L', Name: "Zenaga"},
 	"zga": {Part3: "zga", Scope: 'I', LanguageType: 'L', Name: "Kinga"},
-	"zgb": {Part3: "zgb", Scope: 'I', LanguageType: 'L', Name: "Guibei Zhuang"},
+	"zgb": {Part3: "zgb", Scope: 'I', LanguageType: 'L', Name: "Guibei Zhuang", MacrolanguageCode: "zha"},
 	"zgh": {Part3: "zgh", Part2B: "zgh", Part2T: "zgh", Scope: 'I', LanguageType: 'L', Name: "Standard Moroccan Tamazight"},
-	"zgm": {Part3: "zgm", Scope: 'I', LanguageType: 'L', Name: "Minz Zhuang"},
-	"zgn": {Part3: "zgn", Scope: 'I', LanguageType: 'L', Name: "Guibian Zhuang"},
+	"zgm": {Part3: "zgm", Scope: 'I', LanguageType: 'L', Name: "Minz Zhuang", MacrolanguageCode: "zha"},
+	"zgn": {Part3: "zgn", Scope: 'I', LanguageType: 'L', Name: "Guibian Zhuang", MacrolanguageCode: "zha"},
 	"zgr": {Part3: "zgr", Scope: 'I', LanguageType: 'L', Name: "Magori"},
 	"zha": {Part3: "zha", Part2B: "zha", Part2T: "zha", Part1: "za", Scope: 'M', LanguageType: 'L', Name: "Zhuang"},
 	"zhb": {Part3: "zhb", Scope: 'I', LanguageType: 'L', Name: "Zhaba"},
-	"zhd": {Part3: "zhd", Scope: 'I', LanguageType: 'L', Name: "Dai Zhuang"},
+	"zhd": {Part3: "zhd", Scope: 'I', LanguageType: 'L', Name: "Dai Zhuang", MacrolanguageCode: "zha"},
 	"zhi": {Part3: "zhi", Scope: 'I', LanguageType: 'L', Name: "Zhire"},
-	"zhn": {Part3: "zhn", Scope: 'I', LanguageType: 'L', Name: "Nong Zhuang"},
+	"zhn": {Part3: "zhn", Scope: 'I', LanguageType: 'L', Name: "Nong Zhuang", MacrolanguageCode: "zha"},
 	"zho": {Part3: "zho", Part2B: "chi", Part2T: "zho", Part1: "zh", Scope: 'M', LanguageType: 'L', Name: "Chinese"},
 	"zhw": {Part3: "zhw", Scope: 'I', LanguageType: 'L', Name: "Zhoa"},
 	"zia": {Part3: "zia", Scope: 'I', LanguageType: 'L', Name: "Zia"},
@@ -7787,10 +7794,10 @@ var LanguagesPart3 = map[string]Language{
 	"zkv": {Part3: "zkv", Scope: 'I', LanguageType: 'E', Name: "Krevinian"},
 	"zkz": {Part3: "zkz", Scope: 'I', LanguageType: 'H', Name: "Khazar"},
 	"zla": {Part3: "zla", Scope: 'I', LanguageType: 'L', Name: "Zula"},
-	"zlj": {Part3: "zlj", Scope: 'I', LanguageType: 'L', Name: "Liujiang Zhuang"},
-	"zlm": {Part3: "zlm", Scope: 'I', LanguageType: 'L', Name: "Malay (individual language)"},
-	"zln": {Part3: "zln", Scope: 'I', LanguageType: 'L', Name: "Lianshan Zhuang"},
-	"zlq": {Part3: "zlq", Scope: 'I', LanguageType: 'L', Name: "Liuqian Zhuang"},
+	"zlj": {Part3: "zlj", Scope: 'I', LanguageType: 'L', Name: "Liujiang Zhuang", MacrolanguageCode: "zha"},
+	"zlm": {Part3: "zlm", Scope: 'I', LanguageType: 'L', Name: "Malay (individual language)", MacrolanguageCode: "msa"},
+	"zln": {Part3: "zln", Scope: 'I', LanguageType: 'L', Name: "Lianshan Zhuang", MacrolanguageCode: "zha"},
+	"zlq": {Part3: "zlq", Scope: 'I', LanguageType: 'L', Name: "Liuqian Zhuang", MacrolanguageCode: "zha"},
 	"zma": {Part3: "zma", Scope: 'I', LanguageType: 'L', Name: "Manda (Australia)"},
 	"zmb": {Part3: "zmb", Scope: 'I', LanguageType: 'L', Name: "Zimba"},
 	"zmc": {Part3: "zmc", Scope: 'I', LanguageType: 'E', Name: "Margany"},
@@ -7799,7 +7806,7 @@ var LanguagesPart3 = map[string]Language{
 	"zmf": {Part3: "zmf", Scope: 'I', LanguageType: 'L', Name: "Mfinu"},
 	"zmg": {Part3: "zmg", Scope: 'I', LanguageType: 'L', Name: "Marti Ke"},
 	"zmh": {Part3: "zmh", Scope: 'I', LanguageType: 'E', Name: "Makolkol"},
-	"zmi": {Part3: "zmi", Scope: 'I', LanguageType: 'L', Name: "Negeri Sembilan Malay"},
+	"zmi": {Part3: "zmi", Scope: 'I', LanguageType: 'L', Name: "Negeri Sembilan Malay", MacrolanguageCode: "msa"},
 	"zmj": {Part3: "zmj", Scope: 'I', LanguageType: 'L', Name: "Maridjabin"},
 	"zmk": {Part3: "zmk", Scope: 'I', LanguageType: 'E', Name: "Mandandanyi"},
 	"zml": {Part3: "zml", Scope: 'I', LanguageType: 'E', Name: "Matngala"},
@@ -7825,37 +7832,37 @@ var LanguagesPart3 = map[string]Language{
 	"zoc": {Part3: "zoc", Scope: 'I', LanguageType: 'L', Name: "Copainalá Zoque"},
 	"zoh": {Part3: "zoh", Scope: 'I', LanguageType: 'L', Name: "Chimalapa Zoque"},
 	"zom": {Part3: "zom", Scope: 'I', LanguageType: 'L', Name: "Zou"},
-	"zoo": {Part3: "zoo", Scope: 'I', LanguageType: 'L', Name: "Asunción Mixtepec Zapotec"},
+	"zoo": {Part3: "zoo", Scope: 'I', LanguageType: 'L', Name: "Asunción Mixtepec Zapotec", MacrolanguageCode: "zap"},
 	"zoq": {Part3: "zoq", Scope: 'I', LanguageType: 'L', Name: "Tabasco Zoque"},
 	"zor": {Part3: "zor", Scope: 'I', LanguageType: 'L', Name: "Rayón Zoque"},
 	"zos": {Part3: "zos", Scope: 'I', LanguageType: 'L', Name: "Francisco León Zoque"},
-	"zpa": {Part3: "zpa", Scope: 'I', LanguageType: 'L', Name: "Lachiguiri Zapotec"},
-	"zpb": {Part3: "zpb", Scope: 'I', LanguageType: 'L', Name: "Yautepec Zapotec"},
-	"zpc": {Part3: "zpc", Scope: 'I', LanguageType: 'L', Name: "Choapan Zapotec"},
-	"zpd": {Part3: "zpd", Scope: 'I', LanguageType: 'L', Name: "Southeastern Ixtlán Zapotec"},
-	"zpe": {Part3: "zpe", Scope: 'I', LanguageType: 'L', Name: "Petapa Zapotec"},
-	"zpf": {Part3: "zpf", Scope: 'I', LanguageType: 'L', Name: "San Pedro Quiatoni Zapotec"},
-	"zpg": {Part3: "zpg", Scope: 'I', LanguageType: 'L', Name: "Guevea De Humboldt Zapotec"},
-	"zph": {Part3: "zph", Scope: 'I', LanguageType: 'L', Name: "Totomachapan Zapotec"},
-	"zpi": {Part3: "zpi", Scope: 'I', LanguageType: 'L', Name: "Santa María Quiegolani Zapotec"},
-	"zpj": {Part3: "zpj", Scope: 'I', LanguageType: 'L', Name: "Quiavicuzas Zapotec"},
-	"zpk": {Part3: "zpk", Scope: 'I', LanguageType: 'L', Name: "Tlacolulita Zapotec"},
-	"zpl": {Part3: "zpl", Scope: 'I', LanguageType: 'L', Name: "Lachixío Zapotec"},
-	"zpm": {Part3: "zpm", Scope: 'I', LanguageType: 'L', Name: "Mixtepec Zapotec"},
-	"zpn": {Part3: "zpn", Scope: 'I', LanguageType: 'L', Name: "Santa Inés Yatzechi Zapotec"},
-	"zpo": {Part3: "zpo", Scope: 'I', LanguageType: 'L', Name: "Amatlán Zapotec"},
-	"zpp": {Part3: "zpp", Scope: 'I', LanguageType: 'L', Name: "El Alto Zapotec"},
-	"zpq": {Part3: "zpq", Scope: 'I', LanguageType: 'L', Name: "Zoogocho Zapotec"},
-	"zpr": {Part3: "zpr", Scope: 'I', LanguageType: 'L', Name: "Santiago Xanica Zapotec"},
-	"zps": {Part3: "zps", Scope: 'I', LanguageType: 'L', Name: "Coatlán Zapotec"},
-	"zpt": {Part3: "zpt", Scope: 'I', LanguageType: 'L', Name: "San Vicente Coatlán Zapotec"},
-	"zpu": {Part3: "zpu", Scope: 'I', LanguageType: 'L', Name: "Yalálag Zapotec"},
-	"zpv": {Part3: "zpv", Scope: 'I', LanguageType: 'L', Name: "Chichicapan Zapotec"},
-	"zpw": {Part3: "zpw", Scope: 'I', LanguageType: 'L', Name: "Zaniza Zapotec"},
-	"zpx": {Part3: "zpx", Scope: 'I', LanguageType: 'L', Name: "San Baltazar Loxicha Zapotec"},
-	"zpy": {Part3: "zpy", Scope: 'I', LanguageType: 'L', Name: "Mazaltepec Zapotec"},
-	"zpz": {Part3: "zpz", Scope: 'I', LanguageType: 'L', Name: "Texmelucan Zapotec"},
-	"zqe": {Part3: "zqe", Scope: 'I', LanguageType: 'L', Name: "Qiubei Zhuang"},
+	"zpa": {Part3: "zpa", Scope: 'I', LanguageType: 'L', Name: "Lachiguiri Zapotec", MacrolanguageCode: "zap"},
+	"zpb": {Part3: "zpb", Scope: 'I', LanguageType: 'L', Name: "Yautepec Zapotec", MacrolanguageCode: "zap"},
+	"zpc": {Part3: "zpc", Scope: 'I', LanguageType: 'L', Name: "Choapan Zapotec", MacrolanguageCode: "zap"},
+	"zpd": {Part3: "zpd", Scope: 'I', LanguageType: 'L', Name: "Southeastern Ixtlán Zapotec", MacrolanguageCode: "zap"},
+	"zpe": {Part3: "zpe", Scope: 'I', LanguageType: 'L', Name: "Petapa Zapotec", MacrolanguageCode: "zap"},
+	"zpf": {Part3: "zpf", Scope: 'I', LanguageType: 'L', Name: "San Pedro Quiatoni Zapotec", MacrolanguageCode: "zap"},
+	"zpg": {Part3: "zpg", Scope: 'I', LanguageType: 'L', Name: "Guevea De Humboldt Zapotec", MacrolanguageCode: "zap"},
+	"zph": {Part3: "zph", Scope: 'I', LanguageType: 'L', Name: "Totomachapan Zapotec", MacrolanguageCode: "zap"},
+	"zpi": {Part3: "zpi", Scope: 'I', LanguageType: 'L', Name: "Santa María Quiegolani Zapotec", MacrolanguageCode: "zap"},
+	"zpj": {Part3: "zpj", Scope: 'I', LanguageType: 'L', Name: "Quiavicuzas Zapotec", MacrolanguageCode: "zap"},
+	"zpk": {Part3: "zpk", Scope: 'I', LanguageType: 'L', Name: "Tlacolulita Zapotec", MacrolanguageCode: "zap"},
+	"zpl": {Part3: "zpl", Scope: 'I', LanguageType: 'L', Name: "Lachixío Zapotec", MacrolanguageCode: "zap"},
+	"zpm": {Part3: "zpm", Scope: 'I', LanguageType: 'L', Name: "Mixtepec Zapotec", MacrolanguageCode: "zap"},
+	"zpn": {Part3: "zpn", Scope: 'I', LanguageType: 'L', Name: "Santa Inés Yatzechi Zapotec", MacrolanguageCode: "zap"},
+	"zpo": {Part3: "zpo", Scope: 'I', LanguageType: 'L', Name: "Amatlán Zapotec", MacrolanguageCode: "zap"},
+	"zpp": {Part3: "zpp", Scope: 'I', LanguageType: 'L', Name: "El Alto Zapotec", MacrolanguageCode: "zap"},
+	"zpq": {Part3: "zpq", Scope: 'I', LanguageType: 'L', Name: "Zoogocho Zapotec", MacrolanguageCode: "zap"},
+	"zpr": {Part3: "zpr", Scope: 'I', LanguageType: 'L', Name: "Santiago Xanica Zapotec", MacrolanguageCode: "zap"},
+	"zps": {Part3: "zps", Scope: 'I', LanguageType: 'L', Name: "Coatlán Zapotec", MacrolanguageCode: "zap"},
+	"zpt": {Part3: "zpt", Scope: 'I', LanguageType: 'L', Name: "San Vicente Coatlán Zapotec", MacrolanguageCode: "zap"},
+	"zpu": {Part3: "zpu", Scope: 'I', LanguageType: 'L', Name: "Yalálag Zapotec", MacrolanguageCode: "zap"},
+	"zpv": {Part3: "zpv", Scope: 'I', LanguageType: 'L', Name: "Chichicapan Zapotec", MacrolanguageCode: "zap"},
+	"zpw": {Part3: "zpw", Scope: 'I', LanguageType: 'L', Name: "Zaniza Zapotec", MacrolanguageCode: "zap"},
+	"zpx": {Part3: "zpx", Scope: 'I', LanguageType: 'L', Name: "San Baltazar Loxicha Zapotec", MacrolanguageCode: "zap"},
+	"zpy": {Part3: "zpy", Scope: 'I', LanguageType: 'L', Name: "Mazaltepec Zapotec", MacrolanguageCode: "zap"},
+	"zpz": {Part3: "zpz", Scope: 'I', LanguageType: 'L', Name: "Texmelucan Zapotec", MacrolanguageCode: "zap"},
+	"zqe": {Part3: "zqe", Scope: 'I', LanguageType: 'L', Name: "Qiubei Zhuang", MacrolanguageCode: "zha"},
 	"zra": {Part3: "zra", Scope: 'I', LanguageType: 'A', Name: "Kara (Korea)"},
 	"zrg": {Part3: "zrg", Scope: 'I', LanguageType: 'L', Name: "Mirgan"},
 	"zrn": {Part3: "zrn", Scope: 'I', LanguageType: 'L', Name: "Zerenkel"},
@@ -7865,21 +7872,21 @@ var LanguagesPart3 = map[string]Language{
 	"zsa": {Part3: "zsa", Scope: 'I', LanguageType: 'L', Name: "Sarasira"},
 	"zsk": {Part3: "zsk", Scope: 'I', LanguageType: 'A', Name: "Kaskean"},
 	"zsl": {Part3: "zsl", Scope: 'I', LanguageType: 'L', Name: "Zambian Sign Language"},
-	"zsm": {Part3: "zsm", Scope: 'I', LanguageType: 'L', Name: "Standard Malay"},
-	"zsr": {Part3: "zsr", Scope: 'I', LanguageType: 'L', Name: "Southern Rincon Zapotec"},
+	"zsm": {Part3: "zsm", Scope: 'I', LanguageType: 'L', Name: "Standard Malay", MacrolanguageCode: "msa"},
+	"zsr": {Part3: "zsr", Scope: 'I', LanguageType: 'L', Name: "Southern Rincon Zapotec", MacrolanguageCode: "zap"},
 	"zsu": {Part3: "zsu", Scope: 'I', LanguageType: 'L', Name: "Sukurum"},
-	"zte": {Part3: "zte", Scope: 'I', LanguageType: 'L', Name: "Elotepec Zapotec"},
-	"ztg": {Part3: "ztg", Scope: 'I', LanguageType: 'L', Name: "Xanaguía Zapotec"},
-	"ztl": {Part3: "ztl", Scope: 'I', LanguageType: 'L', Name: "Lapaguía-Guivini Zapotec"},
-	"ztm": {Part3: "ztm", Scope: 'I', LanguageType: 'L', Name: "San Agustín Mixtepec Zapotec"},
-	"ztn": {Part3: "ztn", Scope: 'I', LanguageType: 'L', Name: "Santa Catarina Albarradas Zapotec"},
-	"ztp": {Part3: "ztp", Scope: 'I', LanguageType: 'L', Name: "Loxicha Zapotec"},
-	"ztq": {Part3: "ztq", Scope: 'I', LanguageType: 'L', Name: "Quioquitani-Quierí Zapotec"},
-	"zts": {Part3: "zts", Scope: 'I', LanguageType: 'L', Name: "Tilquiapan Zapotec"},
-	"ztt": {Part3: "ztt", Scope: 'I', LanguageType: 'L', Name: "Tejalapan Zapotec"},
-	"ztu": {Part3: "ztu", Scope: 'I', LanguageType: 'L', Name: "Güilá Zapotec"},
-	"ztx": {Part3: "ztx", Scope: 'I', LanguageType: 'L', Name: "Zaachila Zapotec"},
-	"zty": {Part3: "zty", Scope: 'I', LanguageType: 'L', Name: "Yatee Zapotec"},
+	"zte": {Part3: "zte", Scope: 'I', LanguageType: 'L', Name: "Elotepec Zapotec", MacrolanguageCode: "zap"},
+	"ztg": {Part3: "ztg", Scope: 'I', LanguageType: 'L', Name: "Xanaguía Zapotec", MacrolanguageCode: "zap"},
+	"ztl": {Part3: "ztl", Scope: 'I', LanguageType: 'L', Name: "Lapaguía-Guivini Zapotec", MacrolanguageCode: "zap"},
+	"ztm": {Part3: "ztm", Scope: 'I', LanguageType: 'L', Name: "San Agustín Mixtepec Zapotec", MacrolanguageCode: "zap"},
+	"ztn": {Part3: "ztn", Scope: 'I', LanguageType: 'L', Name: "Santa Catarina Albarradas Zapotec", MacrolanguageCode: "zap"},
+	"ztp": {Part3: "ztp", Scope: 'I', LanguageType: 'L', Name: "Loxicha Zapotec", MacrolanguageCode: "zap"},
+	"ztq": {Part3: "ztq", Scope: 'I', LanguageType: 'L', Name: "Quioquitani-Quierí Zapotec", MacrolanguageCode: "zap"},
+	"zts": {Part3: "zts", Scope: 'I', LanguageType: 'L', Name: "Tilquiapan Zapotec", MacrolanguageCode: "zap"},
+	"ztt": {Part3: "ztt", Scope: 'I', LanguageType: 'L', Name: "Tejalapan Zapotec", MacrolanguageCode: "zap"},
+	"ztu": {Part3: "ztu", Scope: 'I', LanguageType: 'L', Name: "Güilá Zapotec", MacrolanguageCode: "zap"},
+	"ztx": {Part3: "ztx", Scope: 'I', LanguageType: 'L', Name: "Zaachila Zapotec", MacrolanguageCode: "zap"},
+	"zty": {Part3: "zty", Scope: 'I', LanguageType: 'L', Name: "Yatee Zapotec", MacrolanguageCode: "zap"},
 	"zua": {Part3: "zua", Scope: 'I', LanguageType: 'L', Name: "Zeem"},
 	"zuh": {Part3: "zuh", Scope: 'I', LanguageType: 'L', Name: "Tokano"},
 	"zul": {Part3: "zul", Part2B: "zul", Part2T: "zul", Part1: "zu", Scope: 'I', LanguageType: 'L', Name: "Zulu"},
@@ -7888,16 +7895,17 @@ var LanguagesPart3 = map[string]Language{
 	"zuy": {Part3: "zuy", Scope: 'I', LanguageType: 'L', Name: "Zumaya"},
 	"zwa": {Part3: "zwa", Scope: 'I', LanguageType: 'L', Name: "Zay"},
 	"zxx": {Part3: "zxx", Part2B: "zxx", Part2T: "zxx", Scope: 'S', LanguageType: 'S', Name: "No linguistic content"},
-	"zyb": {Part3: "zyb", Scope: 'I', LanguageType: 'L', Name: "Yongbei Zhuang"},
-	"zyg": {Part3: "zyg", Scope: 'I', LanguageType: 'L', Name: "Yang Zhuang"},
-	"zyj": {Part3: "zyj", Scope: 'I', LanguageType: 'L', Name: "Youjiang Zhuang"},
-	"zyn": {Part3: "zyn", Scope: 'I', LanguageType: 'L', Name: "Yongnan Zhuang"},
+	"zyb": {Part3: "zyb", Scope: 'I', LanguageType: 'L', Name: "Yongbei Zhuang", MacrolanguageCode: "zha"},
+	"zyg": {Part3: "zyg", Scope: 'I', LanguageType: 'L', Name: "Yang Zhuang", MacrolanguageCode: "zha"},
+	"zyj": {Part3: "zyj", Scope: 'I', LanguageType: 'L', Name: "Youjiang Zhuang", MacrolanguageCode: "zha"},
+	"zyn": {Part3: "zyn", Scope: 'I', LanguageType: 'L', Name: "Yongnan Zhuang", MacrolanguageCode: "zha"},
 	"zyp": {Part3: "zyp", Scope: 'I', LanguageType: 'L', Name: "Zyphe Chin"},
 	"zza": {Part3: "zza", Part2B: "zza", Part2T: "zza", Scope: 'M', LanguageType: 'L', Name: "Zaza"},
-	"zzj": {Part3: "zzj", Scope: 'I', LanguageType: 'L', Name: "Zuojiang Zhuang"},
+	"zzj": {Part3: "zzj", Scope: 'I', LanguageType: 'L', Name: "Zuojiang Zhuang", MacrolanguageCode: "zha"},
 }
 
-// LanguagesPart2 lookup table. Keys are ISO 639-2 codes
+// LanguagesPart2 lookup table. Keys are ISO 639-2 codes.
+// Not declared with iso639_blob build tag, use LanguageTables to get it regardless of build tags
 var LanguagesPart2 = map[string]Language{
 	"aar": {Part3: "aar", Part2B: "aar", Part2T: "aar", Part1: "aa", Scope: 'I', LanguageType: 'L', Name: "Afar"},
 	"abk": {Part3: "abk", Part2B: "abk", Part2T: "abk", Part1: "ab", Scope: 'I', LanguageType: 'L', Name: "Abkhazian"},
@@ -7944,7 +7952,7 @@ var LanguagesPart2 = map[string]Language{
 	"bla": {Part3: "bla", Part2B: "bla", Part2T: "bla", Scope: 'I', LanguageType: 'L', Name: "Siksika"},
 	"tib": {Part3: "bod", Part2B: "tib", Part2T: "bod", Part1: "bo", Scope: 'I', LanguageType: 'L', Name: "Tibetan"},
 	"bod": {Part3: "bod", Part2B: "tib", Part2T: "bod", Part1: "bo", Scope: 'I', LanguageType: 'L', Name: "Tibetan"},
-	"bos": {Part3: "bos", Part2B: "bos", Part2T: "bos", Part1: "bs", Scope: 'I', LanguageType: 'L', Name: "Bosnian"},
+	"bos": {Part3: "bos", Part2B: "bos", Part2T: "bos", Part1: "bs", Scope: 'I', LanguageType: 'L', Name: "Bosnian", MacrolanguageCode: "hbs"},
 	"bra": {Part3: "bra", Part2B: "bra", Part2T: "bra", Scope: 'I', LanguageType: 'L', Name: "Braj"},
 	"bre": {Part3: "bre", Part2B: "bre", Part2T: "bre", Part1: "br", Scope: 'I', LanguageType: 'L', Name: "Breton"},
 	"bua": {Part3: "bua", Part2B: "bua", Part2T: "bua", Scope: 'M', LanguageType: 'L', Name: "Buriat"},
@@ -7970,7 +7978,7 @@ var LanguagesPart2 = map[string]Language{
 	"chu": {Part3: "chu", Part2B: "chu", Part2T: "chu", Part1: "cu", Scope: 'I', LanguageType: 'A', Name: "Church Slavic"},
 	"chv": {Part3: "chv", Part2B: "chv", Part2T: "chv", Part1: "cv", Scope: 'I', LanguageType: 'L', Name: "Chuvash"},
 	"chy": {Part3: "chy", Part2B: "chy", Part2T: "chy", Scope: 'I', LanguageType: 'L', Name: "Cheyenne"},
-	"cnr": {Part3: "cnr", Part2B: "cnr", Part2T: "cnr", Scope: 'I', LanguageType: 'L', Name: "Montenegrin"},
+	"cnr": {Part3: "cnr", Part2B: "cnr", Part2T: "cnr", Scope: 'I', LanguageType: 'L', Name: "Montenegrin", MacrolanguageCode: "hbs"},
 	"cop": {Part3: "cop", Part2B: "cop", Part2T: "cop", Scope: 'I', LanguageType: 'E', Name: "Coptic"},
 	"cor": {Part3: "cor", Part2B: "cor", Part2T: "cor", Part1: "kw", Scope: 'I', LanguageType: 'L', Name: "Cornish"},
 	"cos": {Part3: "cos", Part2B: "cos", Part2T: "cos", Part1: "co", Scope: 'I', LanguageType: 'L', Name: "Corsican"},
@@ -8013,7 +8021,7 @@ var LanguagesPart2 = map[string]Language{
 	"fao": {Part3: "fao", Part2B: "fao", Part2T: "fao", Part1: "fo", Scope: 'I', LanguageType: 'L', Name: "Faroese"},
 	"per": {Part3: "fas", Part2B: "per", Part2T: "fas", Part1: "fa", Scope: 'M', LanguageType: 'L', Name: "Persian"},
 	"fas": {Part3: "fas", Part2B: "per", Part2T: "fas", Part1: "fa", Scope: 'M', LanguageType: 'L', Name: "Persian"},
-	"fat": {Part3: "fat", Part2B: "fat", Part2T: "fat", Scope: 'I', LanguageType: 'L', Name: "Fanti"},
+	"fat": {Part3: "fat", Part2B: "fat", Part2T: "fat", Scope: 'I', LanguageType: 'L', Name: "Fanti", MacrolanguageCode: "aka"},
 	"fij": {Part3: "fij", Part2B: "fij", Part2T: "fij", Part1: "fj", Scope: 'I', LanguageType: 'L', Name: "Fijian"},
 	"fil": {Part3: "fil", Part2B: "fil", Part2T: "fil", Scope: 'I', LanguageType: 'L', Name: "Filipino"},
 	"fin": {Part3: "fin", Part2B: "fin", Part2T: "fin", Part1: "fi", Scope: 'I', LanguageType: 'L', Name: "Finnish"},
@@ -8058,7 +8066,7 @@ var LanguagesPart2 = map[string]Language{
 	"hit": {Part3: "hit", Part2B: "hit", Part2T: "hit", Scope: 'I', LanguageType: 'A', Name: "Hittite"},
 	"hmn": {Part3: "hmn", Part2B: "hmn", Part2T: "hmn", Scope: 'M', LanguageType: 'L', Name: "Hmong"},
 	"hmo": {Part3: "hmo", Part2B: "hmo", Part2T: "hmo", Part1: "ho", Scope: 'I', LanguageType: 'L', Name: "Hiri Motu"},
-	"hrv": {Part3: "hrv", Part2B: "hrv", Part2T: "hrv", Part1: "hr", Scope: 'I', LanguageType: 'L', Name: "Croatian"},
+	"hrv": {Part3: "hrv", Part2B: "hrv", Part2T: "hrv", Part1: "hr", Scope: 'I', LanguageType: 'L', Name: "Croatian", MacrolanguageCode: "hbs"},
 	"hsb": {Part3: "hsb", Part2B: "hsb", Part2T: "hsb", Scope: 'I', LanguageType: 'L', Name: "Upper Sorbian"},
 	"hun": {Part3: "hun", Part2B: "hun", Part2T: "hun", Part1: "hu", Scope: 'I', LanguageType: 'L', Name: "Hungarian"},
 	"hup": {Part3: "hup", Part2B: "hup", Part2T: "hup", Scope: 'I', LanguageType: 'L', Name: "Hupa"},
@@ -8072,7 +8080,7 @@ var LanguagesPart2 = map[string]Language{
 	"ile": {Part3: "ile", Part2B: "ile", Part2T: "ile", Part1: "ie", Scope: 'I', LanguageType: 'C', Name: "Interlingue"},
 	"ilo": {Part3: "ilo", Part2B: "ilo", Part2T: "ilo", Scope: 'I', LanguageType: 'L', Name: "Iloko"},
 	"ina": {Part3: "ina", Part2B: "ina", Part2T: "ina", Part1: "ia", Scope: 'I', LanguageType: 'C', Name: "Interlingua (International Auxiliary Language Association)"},
-	"ind": {Part3: "ind", Part2B: "ind", Part2T: "ind", Part1: "id", Scope: 'I', LanguageType: 'L', Name: "Indonesian"},
+	"ind": {Part3: "ind", Part2B: "ind", Part2T: "ind", Part1: "id", Scope: 'I', LanguageType: 'L', Name: "Indonesian", MacrolanguageCode: "msa"},
 	"inh": {Part3: "inh", Part2B: "inh", Part2T: "inh", Scope: 'I', LanguageType: 'L', Name: "Ingush"},
 	"ipk": {Part3: "ipk", Part2B: "ipk", Part2T: "ipk", Part1: "ik", Scope: 'M', LanguageType: 'L', Name: "Inupiaq"},
 	"ice": {Part3: "isl", Part2B: "ice", Part2T: "isl", Part1: "is", Scope: 'I', LanguageType: 'L', Name: "Icelandic"},
@@ -8150,7 +8158,7 @@ var LanguagesPart2 = map[string]Language{
 	"men": {Part3: "men", Part2B: "men", Part2T: "men", Scope: 'I', LanguageType: 'L', Name: "Mende (Sierra Leone)"},
 	"mga": {Part3: "mga", Part2B: "mga", Part2T: "mga", Scope: 'I', LanguageType: 'H', Name: "Middle Irish (900-1200)"},
 	"mic": {Part3: "mic", Part2B: "mic", Part2T: "mic", Scope: 'I', LanguageType: 'L', Name: "Mi'kmaq"},
-	"min": {Part3: "min", Part2B: "min", Part2T: "min", Scope: 'I', LanguageType: 'L', Name: "Minangkabau"},
+	"min": {Part3: "min", Part2B: "min", Part2T: "min", Scope: 'I', LanguageType: 'L', Name: "Minangkabau", MacrolanguageCode: "msa"},
 	"mis": {Part3: "mis", Part2B: "mis", Part2T: "mis", Scope: 'S', LanguageType: 'S', Name: "Uncoded languages"},
 	"mac": {Part3: "mkd", Part2B: "mac", Part2T: "mkd", Part1: "mk", Scope: 'I', LanguageType: 'L', Name: "Macedonian"},
 	"mkd": {Part3: "mkd", Part2B: "mac", Part2T: "mkd", Part1: "mk", Scope: 'I', LanguageType: 'L', Name: "Macedonian"},
@@ -8185,8 +8193,8 @@ var LanguagesPart2 = map[string]Language{
 	"niu": {Part3: "niu", Part2B: "niu", Part2T: "niu", Scope: 'I', LanguageType: 'L', Name: "Niuean"},
 	"dut": {Part3: "nld", Part2B: "dut", Part2T: "nld", Part1: "nl", Scope: 'I', LanguageType: 'L', Name: "Dutch"},
 	"nld": {Part3: "nld", Part2B: "dut", Part2T: "nld", Part1: "nl", Scope: 'I', LanguageType: 'L', Name: "Dutch"},
-	"nno": {Part3: "nno", Part2B: "nno", Part2T: "nno", Part1: "nn", Scope: 'I', LanguageType: 'L', Name: "Norwegian Nynorsk"},
-	"nob": {Part3: "nob", Part2B: "nob", Part2T: "nob", Part1: "nb", Scope: 'I', LanguageType: 'L', Name: "Norwegian Bokmål"},
+	"nno": {Part3: "nno", Part2B: "nno", Part2T: "nno", Part1: "nn", Scope: 'I', LanguageType: 'L', Name: "Norwegian Nynorsk", MacrolanguageCode: "nor"},
+	"nob": {Part3: "nob", Part2B: "nob", Part2T: "nob", Part1: "nb", Scope: 'I', LanguageType: 'L', Name: "Norwegian Bokmål", MacrolanguageCode: "nor"},
 	"nog": {Part3: "nog", Part2B: "nog", Part2T: "nog", Scope: 'I', LanguageType: 'L', Name: "Nogai"},
 	"non": {Part3: "non", Part2B: "non", Part2T: "non", Scope: 'I', LanguageType: 'H', Name: "Old Norse"},
 	"nor": {Part3: "nor", Part2B: "nor", Part2T: "nor", Part1: "no", Scope: 'M', LanguageType: 'L', Name: "Norwegian"},
@@ -8264,7 +8272,7 @@ var LanguagesPart2 = map[string]Language{
 	"sqi": {Part3: "sqi", Part2B: "alb", Part2T: "sqi", Part1: "sq", Scope: 'M', LanguageType: 'L', Name: "Albanian"},
 	"srd": {Part3: "srd", Part2B: "srd", Part2T: "srd", Part1: "sc", Scope: 'M', LanguageType: 'L', Name: "Sardinian"},
 	"srn": {Part3: "srn", Part2B: "srn", Part2T: "srn", Scope: 'I', LanguageType: 'L', Name: "Sranan Tongo"},
-	"srp": {Part3: "srp", Part2B: "srp", Part2T: "srp", Part1: "sr", Scope: 'I', LanguageType: 'L', Name: "Serbian"},
+	"srp": {Part3: "srp", Part2B: "srp", Part2T: "srp", Part1: "sr", Scope: 'I', LanguageType: 'L', Name: "Serbian", MacrolanguageCode: "hbs"},
 	"srr": {Part3: "srr", Part2B: "srr", Part2T: "srr", Scope: 'I', LanguageType: 'L', Name: "Serer"},
 	"ssw": {Part3: "ssw", Part2B: "ssw", Part2T: "ssw", Part1: "ss", Scope: 'I', LanguageType: 'L', Name: "Swati"},
 	"suk": {Part3: "suk", Part2B: "suk", Part2T: "suk", Scope: 'I', LanguageType: 'L', Name: "Sukuma"},
@@ -8302,7 +8310,7 @@ var LanguagesPart2 = map[string]Language{
 	"tum": {Part3: "tum", Part2B: "tum", Part2T: "tum", Scope: 'I', LanguageType: 'L', Name: "Tumbuka"},
 	"tur": {Part3: "tur", Part2B: "tur", Part2T: "tur", Part1: "tr", Scope: 'I', LanguageType: 'L', Name: "Turkish"},
 	"tvl": {Part3: "tvl", Part2B: "tvl", Part2T: "tvl", Scope: 'I', LanguageType: 'L', Name: "Tuvalu"},
-	"twi": {Part3: "twi", Part2B: "twi", Part2T: "twi", Part1: "tw", Scope: 'I', LanguageType: 'L', Name: "Twi"},
+	"twi": {Part3: "twi", Part2B: "twi", Part2T: "twi", Part1: "tw", Scope: 'I', LanguageType: 'L', Name: "Twi", MacrolanguageCode: "aka"},
 	"tyv": {Part3: "tyv", Part2B: "tyv", Part2T: "tyv", Scope: 'I', LanguageType: 'L', Name: "Tuvinian"},
 	"udm": {Part3: "udm", Part2B: "udm", Part2T: "udm", Scope: 'I', LanguageType: 'L', Name: "Udmurt"},
 	"uga": {Part3: "uga", Part2B: "uga", Part2T: "uga", Scope: 'I', LanguageType: 'A', Name: "Ugaritic"},
@@ -8341,7 +8349,8 @@ var LanguagesPart2 = map[string]Language{
 	"zza": {Part3: "zza", Part2B: "zza", Part2T: "zza", Scope: 'M', LanguageType: 'L', Name: "Zaza"},
 }
 
-// LanguagesPart1 lookup table. Keys are ISO 639-1 codes
+// LanguagesPart1 lookup table. Keys are ISO 639-1 codes.
+// Not declared with iso639_blob build tag, use LanguageTables to get it regardless of build tags
 var LanguagesPart1 = map[string]Language{
 	"aa": {Part3: "aar", Part2B: "aar", Part2T: "aar", Part1: "aa", Scope: 'I', LanguageType: 'L', Name: "Afar"},
 	"ab": {Part3: "abk", Part2B: "abk", Part2T: "abk", Part1: "ab", Scope: 'I', LanguageType: 'L', Name: "Abkhazian"},
@@ -8361,7 +8370,7 @@ var LanguagesPart1 = map[string]Language{
 	"bn": {Part3: "ben", Part2B: "ben", Part2T: "ben", Part1: "bn", Scope: 'I', LanguageType: 'L', Name: "Bengali"},
 	"bi": {Part3: "bis", Part2B: "bis", Part2T: "bis", Part1: "bi", Scope: 'I', LanguageType: 'L', Name: "Bislama"},
 	"bo": {Part3: "bod", Part2B: "tib", Part2T: "bod", Part1: "bo", Scope: 'I', LanguageType: 'L', Name: "Tibetan"},
-	"bs": {Part3: "bos", Part2B: "bos", Part2T: "bos", Part1: "bs", Scope: 'I', LanguageType: 'L', Name: "Bosnian"},
+	"bs": {Part3: "bos", Part2B: "bos", Part2T: "bos", Part1: "bs", Scope: 'I', LanguageType: 'L', Name: "Bosnian", MacrolanguageCode: "hbs"},
 	"br": {Part3: "bre", Part2B: "bre", Part2T: "bre", Part1: "br", Scope: 'I', LanguageType: 'L', Name: "Breton"},
 	"bg": {Part3: "bul", Part2B: "bul", Part2T: "bul", Part1: "bg", Scope: 'I', LanguageType: 'L', Name: "Bulgarian"},
 	"ca": {Part3: "cat", Part2B: "cat", Part2T: "cat", Part1: "ca", Scope: 'I', LanguageType: 'L', Name: "Catalan"},
@@ -8404,7 +8413,7 @@ var LanguagesPart1 = map[string]Language{
 	"hz": {Part3: "her", Part2B: "her", Part2T: "her", Part1: "hz", Scope: 'I', LanguageType: 'L', Name: "Herero"},
 	"hi": {Part3: "hin", Part2B: "hin", Part2T: "hin", Part1: "hi", Scope: 'I', LanguageType: 'L', Name: "Hindi"},
 	"ho": {Part3: "hmo", Part2B: "hmo", Part2T: "hmo", Part1: "ho", Scope: 'I', LanguageType: 'L', Name: "Hiri Motu"},
-	"hr": {Part3: "hrv", Part2B: "hrv", Part2T: "hrv", Part1: "hr", Scope: 'I', LanguageType: 'L', Name: "Croatian"},
+	"hr": {Part3: "hrv", Part2B: "hrv", Part2T: "hrv", Part1: "hr", Scope: 'I', LanguageType: 'L', Name: "Croatian", MacrolanguageCode: "hbs"},
 	"hu": {Part3: "hun", Part2B: "hun", Part2T: "hun", Part1: "hu", Scope: 'I', LanguageType: 'L', Name: "Hungarian"},
 	"hy": {Part3: "hye", Part2B: "arm", Part2T: "hye", Part1: "hy", Scope: 'I', LanguageType: 'L', Name: "Armenian"},
 	"ig": {Part3: "ibo", Part2B: "ibo", Part2T: "ibo", Part1: "ig", Scope: 'I', LanguageType: 'L', Name: "Igbo"},
@@ -8413,7 +8422,7 @@ var LanguagesPart1 = map[string]Language{
 	"iu": {Part3: "iku", Part2B: "iku", Part2T: "iku", Part1: "iu", Scope: 'M', LanguageType: 'L', Name: "Inuktitut"},
 	"ie": {Part3: "ile", Part2B: "ile", Part2T: "ile", Part1: "ie", Scope: 'I', LanguageType: 'C', Name: "Interlingue"},
 	"ia": {Part3: "ina", Part2B: "ina", Part2T: "ina", Part1: "ia", Scope: 'I', LanguageType: 'C', Name: "Interlingua (International Auxiliary Language Association)"},
-	"id": {Part3: "ind", Part2B: "ind", Part2T: "ind", Part1: "id", Scope: 'I', LanguageType: 'L', Name: "Indonesian"},
+	"id": {Part3: "ind", Part2B: "ind", Part2T: "ind", Part1: "id", Scope: 'I', LanguageType: 'L', Name: "Indonesian", MacrolanguageCode: "msa"},
 	"ik": {Part3: "ipk", Part2B: "ipk", Part2T: "ipk", Part1: "ik", Scope: 'M', LanguageType: 'L', Name: "Inupiaq"},
 	"is": {Part3: "isl", Part2B: "ice", Part2T: "isl", Part1: "is", Scope: 'I', LanguageType: 'L', Name: "Icelandic"},
 	"it": {Part3: "ita", Part2B: "ita", Part2T: "ita", Part1: "it", Scope: 'I', LanguageType: 'L', Name: "Italian"},
@@ -8460,8 +8469,8 @@ var LanguagesPart1 = map[string]Language{
 	"ng": {Part3: "ndo", Part2B: "ndo", Part2T: "ndo", Part1: "ng", Scope: 'I', LanguageType: 'L', Name: "Ndonga"},
 	"ne": {Part3: "nep", Part2B: "nep", Part2T: "nep", Part1: "ne", Scope: 'M', LanguageType: 'L', Name: "Nepali (macrolanguage)"},
 	"nl": {Part3: "nld", Part2B: "dut", Part2T: "nld", Part1: "nl", Scope: 'I', LanguageType: 'L', Name: "Dutch"},
-	"nn": {Part3: "nno", Part2B: "nno", Part2T: "nno", Part1: "nn", Scope: 'I', LanguageType: 'L', Name: "Norwegian Nynorsk"},
-	"nb": {Part3: "nob", Part2B: "nob", Part2T: "nob", Part1: "nb", Scope: 'I', LanguageType: 'L', Name: "Norwegian Bokmål"},
+	"nn": {Part3: "nno", Part2B: "nno", Part2T: "nno", Part1: "nn", Scope: 'I', LanguageType: 'L', Name: "Norwegian Nynorsk", MacrolanguageCode: "nor"},
+	"nb": {Part3: "nob", Part2B: "nob", Part2T: "nob", Part1: "nb", Scope: 'I', LanguageType: 'L', Name: "Norwegian Bokmål", MacrolanguageCode: "nor"},
 	"no": {Part3: "nor", Part2B: "nor", Part2T: "nor", Part1: "no", Scope: 'M', LanguageType: 'L', Name: "Norwegian"},
 	"ny": {Part3: "nya", Part2B: "nya", Part2T: "nya", Part1: "ny", Scope: 'I', LanguageType: 'L', Name: "Nyanja"},
 	"oc": {Part3: "oci", Part2B: "oci", Part2T: "oci", Part1: "oc", Scope: 'I', LanguageType: 'L', Name: "Occitan (post 1500)"},
@@ -8493,7 +8502,7 @@ var LanguagesPart1 = map[string]Language{
 	"es": {Part3: "spa", Part2B: "spa", Part2T: "spa", Part1: "es", Scope: 'I', LanguageType: 'L', Name: "Spanish"},
 	"sq": {Part3: "sqi", Part2B: "alb", Part2T: "sqi", Part1: "sq", Scope: 'M', LanguageType: 'L', Name: "Albanian"},
 	"sc": {Part3: "srd", Part2B: "srd", Part2T: "srd", Part1: "sc", Scope: 'M', LanguageType: 'L', Name: "Sardinian"},
-	"sr": {Part3: "srp", Part2B: "srp", Part2T: "srp", Part1: "sr", Scope: 'I', LanguageType: 'L', Name: "Serbian"},
+	"sr": {Part3: "srp", Part2B: "srp", Part2T: "srp", Part1: "sr", Scope: 'I', LanguageType: 'L', Name: "Serbian", MacrolanguageCode: "hbs"},
 	"ss": {Part3: "ssw", Part2B: "ssw", Part2T: "ssw", Part1: "ss", Scope: 'I', LanguageType: 'L', Name: "Swati"},
 	"su": {Part3: "sun", Part2B: "sun", Part2T: "sun", Part1: "su", Scope: 'I', LanguageType: 'L', Name: "Sundanese"},
 	"sw": {Part3: "swa", Part2B: "swa", Part2T: "swa", Part1: "sw", Scope: 'M', LanguageType: 'L', Name: "Swahili (macrolanguage)"},
@@ -8511,7 +8520,7 @@ var LanguagesPart1 = map[string]Language{
 	"ts": {Part3: "tso", Part2B: "tso", Part2T: "tso", Part1: "ts", Scope: 'I', LanguageType: 'L', Name: "Tsonga"},
 	"tk": {Part3: "tuk", Part2B: "tuk", Part2T: "tuk", Part1: "tk", Scope: 'I', LanguageType: 'L', Name: "Turkmen"},
 	"tr": {Part3: "tur", Part2B: "tur", Part2T: "tur", Part1: "tr", Scope: 'I', LanguageType: 'L', Name: "Turkish"},
-	"tw": {Part3: "twi", Part2B: "twi", Part2T: "twi", Part1: "tw", Scope: 'I', LanguageType: 'L', Name: "Twi"},
+	"tw": {Part3: "twi", Part2B: "twi", Part2T: "twi", Part1: "tw", Scope: 'I', LanguageType: 'L', Name: "Twi", MacrolanguageCode: "aka"},
 	"ug": {Part3: "uig", Part2B: "uig", Part2T: "uig", Part1: "ug", Scope: 'I', LanguageType: 'L', Name: "Uighur"},
 	"uk": {Part3: "ukr", Part2B: "ukr", Part2T: "ukr", Part1: "uk", Scope: 'I', LanguageType: 'L', Name: "Ukrainian"},
 	"ur": {Part3: "urd", Part2B: "urd", Part2T: "urd", Part1: "ur", Scope: 'I', LanguageType: 'L', Name: "Urdu"},
@@ -8528,3 +8537,208 @@ var LanguagesPart1 = map[string]Language{
 	"zh": {Part3: "zho", Part2B: "chi", Part2T: "zho", Part1: "zh", Scope: 'M', LanguageType: 'L', Name: "Chinese"},
 	"zu": {Part3: "zul", Part2B: "zul", Part2T: "zul", Part1: "zu", Scope: 'I', LanguageType: 'L', Name: "Zulu"},
 }
+
+// macrolanguageMembers lookup table. Keys are ISO 639-3 macrolanguage codes, values are ISO 639-3 codes of their members
+var macrolanguageMembers = map[string][]string{
+	"aka": {"fat", "twi"},
+	"ara": {"aao", "abh", "abv", "acm", "acq", "acw", "acx", "acy", "adf", "aeb", "aec", "afb", "apc", "apd", "arb", "arq", "ars", "ary", "arz", "auz", "avl", "ayh", "ayl", "ayn", "ayp", "pga", "shu", "ssh"},
+	"aym": {"ayc", "ayr"},
+	"aze": {"azb", "azj"},
+	"bal": {"bcc", "bgn", "bgp"},
+	"bik": {"bcl", "bln", "bto", "cts", "fbl", "lbl", "rbl", "ubl"},
+	"bnc": {"ebk", "lbk", "obk", "rbk", "vbk"},
+	"bua": {"bxm", "bxr", "bxu"},
+	"chm": {"mhr", "mrj"},
+	"cre": {"crj", "crk", "crl", "crm", "csw", "cwd"},
+	"del": {"umu", "unm"},
+	"den": {"scs", "xsl"},
+	"din": {"dib", "dik", "dip", "diw", "dks"},
+	"doi": {"dgo", "xnr"},
+	"est": {"ekk", "vro"},
+	"fas": {"pes", "prs"},
+	"ful": {"ffm", "fub", "fuc", "fue", "fuf", "fuh", "fui", "fuq", "fuv", "fvr"},
+	"gba": {"bdt", "gbp", "gbq", "gmm", "gso", "gya"},
+	"gon": {"esg", "gno", "wsg"},
+	"grb": {"gbo", "gec", "grj", "grv", "gry"},
+	"grn": {"gnw", "gug", "gui", "gun", "nhd"},
+	"hai": {"hax", "hdn"},
+	"hbs": {"bos", "cnr", "hrv", "srp"},
+	"hmn": {"cqd", "hea", "hma", "hmc", "hmd", "hme", "hmg", "hmh", "hmi", "hmj", "hml", "hmm", "hmp", "hmq", "hms", "hmw", "hmy", "hmz", "hnj", "hrm", "huj", "mmr", "muq", "mww", "sfm"},
+	"iku": {"ike", "ikt"},
+	"ipk": {"esi", "esk"},
+	"jrb": {"ajt", "aju", "jye", "yhd", "yud"},
+	"kau": {"kby", "knc", "krt"},
+	"kln": {"enb", "eyo", "niq", "oki", "pko", "sgc", "spy", "tec", "tuy"},
+	"kok": {"gom", "knn"},
+	"kom": {"koi", "kpv"},
+	"kon": {"kng", "kwy", "ldi"},
+	"kpe": {"gkp", "xpe"},
+	"kur": {"ckb", "kmr", "sdh"},
+	"lah": {"hnd", "hno", "jat", "phr", "pnb", "skr", "xhe"},
+	"lav": {"ltg", "lvs"},
+	"luy": {"bxk", "ida", "lkb", "lko", "lks", "lri", "lrm", "lsm", "lto", "lts", "lwg", "nle", "nyd", "rag"},
+	"man": {"emk", "mku", "mlq", "mnk", "msc", "mwk"},
+	"mlg": {"bhr", "bmm", "bzc", "msh", "plt", "skg", "tdx", "tkg", "txy", "xmv", "xmw"},
+	"mon": {"khk", "mvf"},
+	"msa": {"bjn", "btj", "bve", "bvu", "coa", "dup", "hji", "ind", "jak", "jax", "kvb", "kvr", "kxd", "lce", "lcf", "liw", "max", "meo", "mfa", "mfb", "min", "mqg", "msi", "mui", "orn", "ors", "pel", "pse", "tmw", "urk", "vkk", "vkt", "xmm", "zlm", "zmi", "zsm"},
+	"mwr": {"dhd", "mtr", "mve", "rwr", "swv", "wry"},
+	"nep": {"dty", "npi"},
+	"nor": {"nno", "nob"},
+	"oji": {"ciw", "ojb", "ojc", "ojg", "ojs", "ojw", "otw"},
+	"ori": {"ory", "spv"},
+	"orm": {"gax", "gaz", "hae", "orc"},
+	"pus": {"pbt", "pbu", "pst"},
+	"que": {"qub", "qud", "quf", "qug", "quh", "quk", "qul", "qup", "qur", "qus", "quw", "qux", "quy", "quz", "qva", "qvc", "qve", "qvh", "qvi", "qvj", "qvl", "qvm", "qvn", "qvo", "qvp", "qvs", "qvw", "qvz", "qwa", "qws", "qxa", "qxc", "qxh", "qxl", "qxn", "qxo", "qxp", "qxr", "qxt", "qxu", "qxw"},
+	"raj": {"bgq", "gda", "gju", "hoj", "mup", "wbr"},
+	"rom": {"rmc", "rmf", "rml", "rmn", "rmo", "rmw", "rmy"},
+	"sqi": {"aae", "aat", "aln", "als"},
+	"srd": {"sdc", "sdn", "src", "sro"},
+	"swa": {"swc", "swh"},
+	"syr": {"aii", "cld"},
+	"tmh": {"taq", "thv", "thz", "ttq"},
+	"uzb": {"uzn", "uzs"},
+	"yid": {"ydd", "yih"},
+	"zap": {"zaa", "zab", "zac", "zad", "zae", "zaf", "zai", "zam", "zao", "zaq", "zar", "zas", "zat", "zav", "zaw", "zax", "zca", "zoo", "zpa", "zpb", "zpc", "zpd", "zpe", "zpf", "zpg", "zph", "zpi", "zpj", "zpk", "zpl", "zpm", "zpn", "zpo", "zpp", "zpq", "zpr", "zps", "zpt", "zpu", "zpv", "zpw", "zpx", "zpy", "zpz", "zsr", "zte", "ztg", "ztl", "ztm", "ztn", "ztp", "ztq", "zts", "ztt", "ztu", "ztx", "zty"},
+	"zha": {"zch", "zeh", "zgb", "zgm", "zgn", "zhd", "zhn", "zlj", "zln", "zlq", "zqe", "zyb", "zyg", "zyj", "zyn", "zzj"},
+	"zho": {"cdo", "cjy", "cmn", "cnp", "cpx", "csp", "czh", "czo", "gan", "hak", "hsn", "lzh", "mnp", "nan", "wuu", "yue"},
+	"zza": {"diq", "kiu"},
+}
+
+// languageAltNames lookup table. Keys are ISO 639-3 codes, values are alternative names from the name index,
+// sorted and without exact (case-sensitive) duplicates. Empty unless generated with the name index
+var languageAltNames = map[string][]string{}
+
+// retiredCodes lookup table. Keys are retired ISO 639-3 codes
+var retiredCodes = map[string]RetiredCode{}
+
+// languageAutonyms lookup table. Keys are ISO 639-3 codes, values are names of languages in themselves from Unicode CLDR
+var languageAutonyms = map[string]string{
+	"afr": "Afrikaans",
+	"aka": "Akan",
+	"amh": "አማርኛ",
+	"ara": "العربية",
+	"asm": "অসমীয়া",
+	"aze": "azərbaycan",
+	"bam": "bamanakan",
+	"bel": "беларуская",
+	"ben": "বাংলা",
+	"bod": "བོད་སྐད་",
+	"bos": "bosanski",
+	"bre": "brezhoneg",
+	"bul": "български",
+	"cat": "català",
+	"ces": "čeština",
+	"che": "нохчийн",
+	"cor": "kernewek",
+	"cym": "Cymraeg",
+	"dan": "dansk",
+	"deu": "Deutsch",
+	"dzo": "རྫོང་ཁ",
+	"ell": "Ελληνικά",
+	"eng": "English",
+	"epo": "esperanto",
+	"est": "eesti",
+	"eus": "euskara",
+	"ewe": "Eʋegbe",
+	"fao": "føroyskt",
+	"fas": "فارسی",
+	"fin": "suomi",
+	"fra": "français",
+	"fry": "Frysk",
+	"ful": "Pulaar",
+	"gla": "Gàidhlig",
+	"gle": "Gaeilge",
+	"glg": "galego",
+	"glv": "Gaelg",
+	"guj": "ગુજરાતી",
+	"hau": "Hausa",
+	"hbs": "srpskohrvatski",
+	"heb": "עברית",
+	"hin": "हिन्दी",
+	"hrv": "hrvatski",
+	"hun": "magyar",
+	"hye": "հայերեն",
+	"ibo": "Igbo",
+	"iii": "ꆈꌠꉙ",
+	"ind": "Indonesia",
+	"isl": "íslenska",
+	"ita": "italiano",
+	"jpn": "日本語",
+	"kal": "kalaallisut",
+	"kan": "ಕನ್ನಡ",
+	"kas": "کٲشُر",
+	"kat": "ქართული",
+	"kaz": "қазақ тілі",
+	"khm": "ខ្មែរ",
+	"kik": "Gikuyu",
+	"kin": "Kinyarwanda",
+	"kir": "кыргызча",
+	"kor": "한국어",
+	"lao": "ລາວ",
+	"lav": "latviešu",
+	"lin": "lingála",
+	"lit": "lietuvių",
+	"ltz": "Lëtzebuergesch",
+	"lub": "Tshiluba",
+	"lug": "Luganda",
+	"mal": "മലയാളം",
+	"mar": "मराठी",
+	"mkd": "македонски",
+	"mlg": "Malagasy",
+	"mlt": "Malti",
+	"mon": "монгол",
+	"msa": "Melayu",
+	"mya": "မြန်မာ",
+	"nde": "isiNdebele",
+	"nep": "नेपाली",
+	"nld": "Nederlands",
+	"nno": "nynorsk",
+	"nob": "norsk bokmål",
+	"nor": "norsk bokmål",
+	"ori": "ଓଡ଼ିଆ",
+	"orm": "Oromoo",
+	"oss": "ирон",
+	"pan": "ਪੰਜਾਬੀ",
+	"pol": "polski",
+	"por": "português",
+	"pus": "پښتو",
+	"que": "Runasimi",
+	"roh": "rumantsch",
+	"ron": "română",
+	"run": "Ikirundi",
+	"rus": "русский",
+	"sag": "Sängö",
+	"sin": "සිංහල",
+	"slk": "slovenčina",
+	"slv": "slovenščina",
+	"sme": "davvisámegiella",
+	"sna": "chiShona",
+	"snd": "سنڌي",
+	"som": "Soomaali",
+	"spa": "español",
+	"sqi": "shqip",
+	"srp": "српски",
+	"swa": "Kiswahili",
+	"swe": "svenska",
+	"tam": "தமிழ்",
+	"tat": "татар",
+	"tel": "తెలుగు",
+	"tgk": "тоҷикӣ",
+	"tgl": "Filipino",
+	"tha": "ไทย",
+	"tir": "ትግርኛ",
+	"ton": "lea fakatonga",
+	"tuk": "Türkmen dili",
+	"tur": "Türkçe",
+	"twi": "Akan",
+	"uig": "ئۇيغۇرچە",
+	"ukr": "українська",
+	"urd": "اردو",
+	"uzb": "o‘zbek",
+	"vie": "Tiếng Việt",
+	"wol": "Wolof",
+	"yid": "ייִדיש",
+	"yor": "Èdè Yorùbá",
+	"zho": "中文",
+	"zul": "isiZulu",
+}
@@ -0,0 +1,432 @@
+// Data in this file is derived from Unicode CLDR likely subtags (https://cldr.unicode.org),
+// copyright Unicode, Inc., distributed under the Unicode License, as provided by golang.org/x/text/language
+
+package iso639_3
+
+// likelyLanguageByRegion lookup table. Keys are region codes, values are ISO 639-3 codes of languages most likely used there
+var likelyLanguageByRegion = map[string]string{
+	"AC": "eng",
+	"AD": "cat",
+	"AE": "ara",
+	"AF": "fas",
+	"AG": "eng",
+	"AI": "eng",
+	"AL": "sqi",
+	"AM": "hye",
+	"AN": "eng",
+	"AO": "por",
+	"AR": "spa",
+	"AS": "smo",
+	"AT": "deu",
+	"AU": "eng",
+	"AW": "nld",
+	"AX": "swe",
+	"AZ": "aze",
+	"BA": "bos",
+	"BB": "eng",
+	"BD": "ben",
+	"BE": "nld",
+	"BF": "fra",
+	"BG": "bul",
+	"BH": "ara",
+	"BI": "run",
+	"BJ": "fra",
+	"BL": "fra",
+	"BM": "eng",
+	"BN": "msa",
+	"BO": "spa",
+	"BQ": "pap",
+	"BR": "por",
+	"BS": "eng",
+	"BT": "dzo",
+	"BU": "mya",
+	"BW": "eng",
+	"BY": "bel",
+	"BZ": "eng",
+	"CA": "eng",
+	"CC": "eng",
+	"CD": "swa",
+	"CF": "fra",
+	"CG": "fra",
+	"CH": "deu",
+	"CI": "fra",
+	"CK": "eng",
+	"CL": "spa",
+	"CM": "fra",
+	"CN": "zho",
+	"CO": "spa",
+	"CQ": "eng",
+	"CR": "spa",
+	"CS": "eng",
+	"CT": "eng",
+	"CU": "spa",
+	"CV": "por",
+	"CW": "pap",
+	"CX": "eng",
+	"CY": "ell",
+	"CZ": "ces",
+	"DD": "deu",
+	"DE": "deu",
+	"DG": "eng",
+	"DJ": "aar",
+	"DK": "dan",
+	"DM": "eng",
+	"DO": "spa",
+	"DY": "fra",
+	"DZ": "ara",
+	"EA": "spa",
+	"EC": "spa",
+	"EE": "est",
+	"EG": "ara",
+	"EH": "ara",
+	"ER": "tir",
+	"ES": "spa",
+	"ET": "amh",
+	"EZ": "deu",
+	"FI": "fin",
+	"FJ": "eng",
+	"FK": "eng",
+	"FM": "eng",
+	"FO": "fao",
+	"FQ": "eng",
+	"FR": "fra",
+	"FX": "fra",
+	"GA": "fra",
+	"GB": "eng",
+	"GD": "eng",
+	"GE": "kat",
+	"GF": "fra",
+	"GG": "eng",
+	"GH": "aka",
+	"GI": "eng",
+	"GL": "kal",
+	"GM": "eng",
+	"GN": "fra",
+	"GP": "fra",
+	"GQ": "spa",
+	"GR": "ell",
+	"GT": "spa",
+	"GU": "eng",
+	"GW": "por",
+	"GY": "eng",
+	"HK": "zho",
+	"HN": "spa",
+	"HR": "hrv",
+	"HT": "hat",
+	"HU": "hun",
+	"HV": "fra",
+	"IC": "spa",
+	"ID": "ind",
+	"IE": "eng",
+	"IL": "heb",
+	"IM": "eng",
+	"IN": "hin",
+	"IO": "eng",
+	"IQ": "ara",
+	"IR": "fas",
+	"IS": "isl",
+	"IT": "ita",
+	"JE": "eng",
+	"JM": "eng",
+	"JO": "ara",
+	"JP": "jpn",
+	"JT": "eng",
+	"KE": "swa",
+	"KG": "kir",
+	"KH": "khm",
+	"KI": "eng",
+	"KM": "ara",
+	"KN": "eng",
+	"KP": "kor",
+	"KR": "kor",
+	"KW": "ara",
+	"KY": "eng",
+	"KZ": "rus",
+	"LA": "lao",
+	"LB": "ara",
+	"LC": "eng",
+	"LI": "deu",
+	"LK": "sin",
+	"LR": "eng",
+	"LS": "sot",
+	"LT": "lit",
+	"LU": "fra",
+	"LV": "lav",
+	"LY": "ara",
+	"MA": "ara",
+	"MC": "fra",
+	"MD": "ron",
+	"ME": "srp",
+	"MF": "fra",
+	"MG": "mlg",
+	"MH": "eng",
+	"MI": "eng",
+	"MK": "mkd",
+	"ML": "bam",
+	"MM": "mya",
+	"MN": "mon",
+	"MO": "zho",
+	"MP": "eng",
+	"MQ": "fra",
+	"MR": "ara",
+	"MS": "eng",
+	"MT": "mlt",
+	"MU": "mfe",
+	"MV": "div",
+	"MW": "eng",
+	"MX": "spa",
+	"MY": "msa",
+	"MZ": "por",
+	"NA": "afr",
+	"NC": "fra",
+	"NE": "hau",
+	"NF": "eng",
+	"NG": "eng",
+	"NH": "bis",
+	"NI": "spa",
+	"NL": "nld",
+	"NO": "nob",
+	"NP": "nep",
+	"NR": "eng",
+	"NT": "eng",
+	"NU": "eng",
+	"NZ": "eng",
+	"OM": "ara",
+	"PA": "spa",
+	"PC": "eng",
+	"PE": "spa",
+	"PF": "fra",
+	"PG": "tpi",
+	"PH": "fil",
+	"PK": "urd",
+	"PL": "pol",
+	"PM": "fra",
+	"PN": "eng",
+	"PR": "spa",
+	"PS": "ara",
+	"PT": "por",
+	"PU": "eng",
+	"PW": "pau",
+	"PY": "grn",
+	"PZ": "spa",
+	"QA": "ara",
+	"RE": "fra",
+	"RH": "sna",
+	"RO": "ron",
+	"RS": "srp",
+	"RU": "rus",
+	"RW": "kin",
+	"SA": "ara",
+	"SB": "eng",
+	"SC": "fra",
+	"SD": "ara",
+	"SE": "swe",
+	"SG": "eng",
+	"SH": "eng",
+	"SI": "slv",
+	"SJ": "nob",
+	"SK": "slk",
+	"SL": "eng",
+	"SM": "ita",
+	"SN": "fra",
+	"SO": "som",
+	"SR": "nld",
+	"SS": "eng",
+	"ST": "por",
+	"SU": "eng",
+	"SV": "spa",
+	"SX": "eng",
+	"SY": "ara",
+	"SZ": "eng",
+	"TA": "eng",
+	"TC": "eng",
+	"TD": "fra",
+	"TF": "fra",
+	"TG": "fra",
+	"TH": "tha",
+	"TJ": "tgk",
+	"TK": "tkl",
+	"TL": "por",
+	"TM": "tuk",
+	"TN": "ara",
+	"TO": "ton",
+	"TP": "por",
+	"TR": "tur",
+	"TT": "eng",
+	"TV": "tvl",
+	"TW": "zho",
+	"TZ": "swa",
+	"UA": "ukr",
+	"UG": "swa",
+	"UK": "eng",
+	"UM": "eng",
+	"UN": "eng",
+	"US": "eng",
+	"UY": "spa",
+	"UZ": "uzb",
+	"VA": "ita",
+	"VC": "eng",
+	"VD": "vie",
+	"VE": "spa",
+	"VG": "eng",
+	"VI": "eng",
+	"VN": "vie",
+	"VU": "bis",
+	"WF": "fra",
+	"WK": "eng",
+	"WS": "smo",
+	"XK": "sqi",
+	"YD": "ara",
+	"YE": "ara",
+	"YT": "fra",
+	"YU": "eng",
+	"ZA": "eng",
+	"ZM": "eng",
+	"ZR": "swa",
+	"ZW": "sna",
+}
+
+// likelyLanguageByScript lookup table. Keys are ISO 15924 script codes, values are ISO 639-3 codes of languages most likely written in them
+var likelyLanguageByScript = map[string]string{
+	"Adlm": "ful",
+	"Aghb": "lez",
+	"Ahom": "aho",
+	"Arab": "ara",
+	"Armi": "arc",
+	"Armn": "hye",
+	"Avst": "ave",
+	"Bali": "ban",
+	"Bamu": "bax",
+	"Bass": "bsq",
+	"Batk": "bbc",
+	"Beng": "ben",
+	"Bhks": "san",
+	"Bopo": "zho",
+	"Brah": "pka",
+	"Brai": "fra",
+	"Bugi": "bug",
+	"Buhd": "bku",
+	"Cakm": "ccp",
+	"Cans": "cre",
+	"Cari": "xcr",
+	"Cham": "cjm",
+	"Cher": "chr",
+	"Copt": "cop",
+	"Cprt": "grc",
+	"Cyrl": "rus",
+	"Deva": "hin",
+	"Dupl": "fra",
+	"Egyp": "egy",
+	"Elba": "sqi",
+	"Ethi": "amh",
+	"Geor": "kat",
+	"Glag": "chu",
+	"Gonm": "gon",
+	"Goth": "got",
+	"Gran": "san",
+	"Grek": "ell",
+	"Gujr": "guj",
+	"Guru": "pan",
+	"Hanb": "zho",
+	"Hang": "kor",
+	"Hani": "zho",
+	"Hano": "hnn",
+	"Hans": "zho",
+	"Hant": "zho",
+	"Hatr": "mis",
+	"Hebr": "heb",
+	"Hira": "jpn",
+	"Hluw": "hlu",
+	"Hmng": "hnj",
+	"Hung": "hun",
+	"Ital": "ett",
+	"Jamo": "kor",
+	"Java": "jav",
+	"Jpan": "jpn",
+	"Kali": "eky",
+	"Kana": "jpn",
+	"Khmr": "khm",
+	"Khoj": "snd",
+	"Knda": "kan",
+	"Kore": "kor",
+	"Kthi": "bho",
+	"Lana": "nod",
+	"Laoo": "lao",
+	"Latn": "eng",
+	"Lepc": "lep",
+	"Limb": "lif",
+	"Lina": "lab",
+	"Linb": "grc",
+	"Lisu": "lis",
+	"Lyci": "xlc",
+	"Lydi": "xld",
+	"Mahj": "hin",
+	"Mand": "myz",
+	"Mani": "xmn",
+	"Marc": "bod",
+	"Mend": "men",
+	"Merc": "xmr",
+	"Mero": "xmr",
+	"Mlym": "mal",
+	"Modi": "mar",
+	"Mong": "mon",
+	"Mroo": "mro",
+	"Mtei": "mni",
+	"Mult": "skr",
+	"Mymr": "mya",
+	"Narb": "xna",
+	"Nbat": "arc",
+	"Newa": "new",
+	"Nkoo": "man",
+	"Ogam": "sga",
+	"Olck": "sat",
+	"Orkh": "otk",
+	"Orya": "ori",
+	"Osge": "osa",
+	"Osma": "som",
+	"Palm": "arc",
+	"Pauc": "ctd",
+	"Perm": "kom",
+	"Phag": "lzh",
+	"Phli": "pal",
+	"Phlp": "pal",
+	"Phnx": "phn",
+	"Plrd": "hmd",
+	"Prti": "xpr",
+	"Rjng": "rej",
+	"Runr": "non",
+	"Samr": "smp",
+	"Sarb": "xsa",
+	"Saur": "saz",
+	"Sgnw": "ase",
+	"Shrd": "san",
+	"Sidd": "san",
+	"Sind": "snd",
+	"Sinh": "sin",
+	"Sora": "srb",
+	"Soyo": "cmg",
+	"Sund": "sun",
+	"Sylo": "syl",
+	"Syrc": "syr",
+	"Tagb": "tbw",
+	"Takr": "doi",
+	"Tale": "tdd",
+	"Talu": "khb",
+	"Taml": "tam",
+	"Tang": "txg",
+	"Tavt": "blt",
+	"Telu": "tel",
+	"Tfng": "zgh",
+	"Tglg": "fil",
+	"Thaa": "div",
+	"Thai": "tha",
+	"Tibt": "bod",
+	"Tirh": "mai",
+	"Ugar": "uga",
+	"Vaii": "vai",
+	"Wara": "hoc",
+	"Xpeo": "peo",
+	"Xsux": "akk",
+	"Yiii": "iii",
+	"Zanb": "cmg",
+}
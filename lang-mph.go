@@ -0,0 +1,630 @@
+//go:build iso639_mph
+// +build iso639_mph
+
+package iso639_3
+
+// part3MPHSeeds holds minimal perfect hash seed for each bucket of ISO 639-3 codes
+var part3MPHSeeds = [...]uint32{
+	1, 12, 0, 94, 2, 28, 10, 85, 4, 31, 183, 16, 2, 33, 8, 110,
+	34, 106, 364, 4, 1, 69, 16, 34, 40, 98, 28, 33, 12, 207, 84, 34,
+	1, 14, 38, 1, 37, 237, 187, 27, 1, 32, 2, 1, 16, 46, 166, 142,
+	131, 49, 33, 4, 13, 71, 47, 26, 2, 0, 222, 7, 4, 74, 33, 29,
+	1, 40, 25, 85, 9, 55, 82, 17, 1, 164, 18, 7, 39, 14, 25, 45,
+	5, 113, 25, 17, 15, 3, 13, 21, 36, 66, 6, 36, 14, 38, 39, 21,
+	19, 266, 194, 63, 1, 6, 7, 8, 44, 171, 332, 71, 46, 89, 16, 4,
+	6, 51, 38, 95, 65, 524, 1, 95, 27, 66, 31, 1, 45, 66, 32, 1,
+	37, 18, 232, 25, 17, 16, 4, 4, 15, 84, 50, 27, 32, 27, 42, 1,
+	46, 114, 160, 3, 116, 16, 15, 66, 40, 59, 13, 2, 82, 56, 35, 1,
+	15, 26, 226, 45, 11, 117, 30, 37, 23, 108, 213, 11, 70, 117, 54, 1,
+	1, 48, 48, 42, 39, 96, 94, 3, 1, 52, 20, 1, 17, 52, 101, 16,
+	33, 1, 1, 1, 14, 54, 144, 30, 68, 144, 130, 1, 16, 124, 108, 9,
+	76, 171, 85, 61, 43, 58, 33, 97, 5, 90, 21, 9, 29, 0, 33, 2,
+	126, 17, 10, 4, 14, 44, 39, 17, 114, 87, 6, 1, 221, 200, 1, 34,
+	171, 167, 239, 2, 37, 211, 32, 15, 83, 42, 30, 248, 67, 16, 66, 1,
+	32, 0, 18, 1, 92, 1, 130, 3, 1, 128, 1, 2, 124, 34, 22, 0,
+	4, 99, 45, 1, 131, 77, 6, 43, 191, 47, 47, 12, 5, 45, 2, 20,
+	116, 133, 36, 47, 8, 0, 2, 1, 42, 35, 93, 87, 44, 130, 41, 46,
+	67, 110, 98, 1, 58, 63, 33, 81, 32, 19, 44, 60, 122, 19, 31, 2,
+	7, 35, 1, 64, 36, 0, 2, 1, 289, 77, 1, 176, 38, 89, 177, 3,
+	144, 1, 14, 11, 83, 75, 39, 105, 159, 9, 6, 11, 3, 17, 24, 17,
+	0, 52, 23, 116, 33, 19, 2, 41, 24, 106, 34, 41, 104, 203, 136, 10,
+	0, 71, 14, 25, 66, 201, 5, 180, 102, 41, 23, 44, 37, 79, 5, 1,
+	350, 155, 1, 131, 393, 61, 1, 103, 16, 135, 4, 194, 162, 63, 1, 27,
+	189, 22, 1, 39, 225, 124, 1, 9, 95, 5, 10, 48, 66, 153, 59, 22,
+	0, 32, 2, 45, 285, 16, 1, 43, 15, 32, 16, 1, 125, 56, 5, 138,
+	32, 71, 63, 90, 212, 45, 21, 39, 3, 34, 25, 52, 112, 881, 73, 154,
+	69, 2, 4, 0, 216, 18, 1, 72, 48, 33, 19, 44, 264, 69, 1, 39,
+	28, 25, 1, 98, 91, 13, 5, 33, 72, 30, 61, 26, 109, 37, 14, 106,
+	33, 75, 28, 140, 415, 44, 45, 34, 131, 41, 53, 27, 60, 2, 6, 113,
+	55, 127, 44, 127, 20, 6, 88, 63, 202, 166, 124, 123, 39, 84, 5, 26,
+	79, 93, 13, 212, 119, 11, 37, 176, 21, 16, 16, 35, 33, 11, 208, 4,
+	175, 24, 127, 64, 1, 67, 156, 35, 119, 126, 33, 138, 28, 4, 2, 37,
+	280, 2, 1, 134, 338, 95, 88, 99, 46, 19, 2, 531, 151, 192, 41, 11,
+	142, 6, 6, 66, 306, 4, 174, 34, 45, 59, 591, 245, 35, 10, 26, 133,
+	173, 24, 27, 0, 110, 18, 51, 66, 7, 38, 217, 141, 198, 31, 213, 42,
+	2, 1, 9, 113, 52, 7, 27, 59, 47, 23, 298, 166, 33, 15, 481, 101,
+	89, 25, 55, 0, 63, 2, 44, 53, 39, 3, 89, 63, 72, 45, 44, 271,
+	53, 8, 7, 231, 76, 162, 9, 259, 410, 1, 6, 35, 89, 184, 122, 7,
+	5, 118, 86, 634, 52, 1, 102, 143, 8, 21, 220, 206, 1, 1, 43, 52,
+	248, 2, 3, 243, 21, 1, 149, 18, 45, 1, 845, 159, 39, 77, 65, 129,
+	270, 9, 87, 15, 6, 7, 124, 362, 271, 90, 268, 0, 0, 40, 23, 30,
+	78, 106, 108, 194, 141, 16, 157, 41, 18, 62, 4, 40, 67, 60, 2, 58,
+	100, 112, 22, 247, 6, 13, 25, 50, 6, 37, 151, 120, 40, 11, 174, 131,
+	11, 433, 40, 0, 109, 2, 24, 116, 101, 47, 82, 77, 202, 69, 3, 4,
+	5, 26, 37, 1, 2, 37, 45, 36, 1, 57, 184, 86, 34, 38, 171, 58,
+	3, 78, 25, 36, 7, 34, 460, 268, 372, 13, 265, 261, 50, 112, 442, 397,
+	1, 6, 86, 199, 19, 61, 122, 27, 1, 61, 11, 29, 1, 39, 140, 0,
+	2, 61, 36, 31, 1, 1, 137, 427, 2, 226, 141, 86, 48, 1, 0, 704,
+	1, 34, 200, 613, 34, 122, 0, 421, 2, 160, 169, 43, 12, 140, 114, 40,
+	26, 176, 66, 59, 8, 1, 181, 22, 325, 936, 94, 82, 1, 163, 109, 3,
+	1, 143, 190, 226, 56, 406, 35, 107, 6, 444, 238, 28, 5, 61, 237, 37,
+	11, 139, 47, 2, 1, 146, 276, 268, 30, 166, 147, 73, 1, 299, 334, 3,
+	234, 150, 181, 2, 16, 52, 139, 4, 61, 25, 18, 98, 57, 102, 264, 2,
+	42, 0, 180, 297, 408, 858, 269, 52, 3, 26, 12, 127, 167, 598, 238, 5,
+	146, 92, 55, 33, 31, 36, 426, 18, 3, 32, 51, 67, 31, 203, 14, 110,
+	14, 35, 36, 133, 382, 195, 376, 1, 7, 134, 7, 39, 35, 268, 41, 65,
+	22, 9, 1, 1, 20, 234, 174, 2, 244, 931, 66, 1, 11, 22, 32, 3,
+	123, 433, 155, 2, 313, 113, 90, 3, 61, 331, 634, 215, 14, 88, 74, 14,
+	37, 7, 104, 25, 17, 258, 19, 36, 6, 0, 53, 42, 499, 4, 65, 21,
+	52, 155, 390, 1, 76, 13, 17, 1, 17, 45, 280, 420, 245, 209, 175, 77,
+	12, 139, 72, 11, 301, 17, 32, 40, 39, 0, 254, 13, 25, 150, 119, 5,
+	69, 98, 63, 1, 124, 584, 66, 2, 101, 137, 91, 34, 196, 79, 87, 2,
+	4, 47, 1, 106, 57, 104, 24, 1, 155, 266, 100, 1, 0, 523, 16, 5,
+	124, 96, 11, 2, 189, 12, 463, 557, 19, 207, 34, 32, 22, 197, 87, 2,
+	84, 605, 249, 198, 227, 16, 183, 1, 3, 0, 233, 136, 54, 151, 34, 3,
+	470, 261, 114, 16, 305, 118, 25, 18, 657, 368, 133, 3, 190, 228, 8, 34,
+	403, 1638, 316, 38, 117, 33, 32, 20, 12, 88, 131, 336, 87, 57, 240, 96,
+	91, 122, 335, 22, 37, 153, 17, 212, 134, 144, 61, 64, 339, 57, 2, 39,
+	368, 119, 50, 7, 283, 152, 2, 265, 208, 177, 60, 229, 114, 4, 4, 2,
+	50, 272, 21, 16, 57, 20, 3, 35, 72, 736, 235, 1, 106, 157, 331, 69,
+	4, 300, 98, 22, 184, 319, 282, 25, 160, 90, 21, 782, 241, 62, 1, 9,
+	174, 20, 15, 7, 468, 173, 32, 657, 225, 156, 1, 61, 247, 0, 419, 12,
+	76, 24, 1, 1, 0, 28, 29, 0, 49, 239, 1, 7, 154, 25, 17, 182,
+	1009, 57, 23, 479, 458, 59, 1, 757, 220, 33, 1, 174, 136, 36, 103, 33,
+	777, 15, 1, 189, 129, 5, 87, 930, 349, 91, 1, 7, 48, 37, 32, 298,
+	36, 804, 65, 461, 73, 10, 2, 330, 378, 35, 3, 111, 63, 1, 1, 159,
+	93, 325, 7, 219, 24, 55, 39, 173, 240, 126, 1, 253, 659, 61, 1227, 119,
+	134, 228, 8, 84, 168, 3, 11, 216, 37, 277, 92, 641, 54, 149, 178, 106,
+	482, 21, 13, 184, 942, 331, 160, 775, 156, 180, 5, 61, 1249, 128, 3, 531,
+	128, 369, 145, 17, 1219, 683, 50, 74, 27, 345, 40, 117, 416, 530, 16, 446,
+	52, 1, 60, 29, 324, 280, 4, 309, 1220, 50, 19, 590, 248, 169, 54, 39,
+	332, 2, 16, 140, 180, 39, 27, 53, 630, 24, 70, 8, 4, 41, 2, 311,
+	74, 4, 14, 23, 66, 405, 113, 719, 95, 67, 8, 596, 511, 169, 34, 351,
+	85, 3, 4, 27, 255, 57, 58, 342, 101, 5, 127, 48, 36, 25, 16, 127,
+	1075, 1, 35, 18, 5, 1, 1, 1806, 332, 18, 268, 326, 402, 16, 216, 42,
+	129, 5, 4, 203, 879, 21, 61, 46, 390, 2, 535, 0, 253, 19, 1058, 158,
+	135, 32, 24, 24, 113, 4, 49, 80, 47, 45, 566, 297, 38, 2, 293, 158,
+	1, 14, 245, 104, 4021, 15, 54, 629, 23, 1, 114, 523, 8, 1, 0, 382,
+	3, 1, 322, 53, 7, 1, 1069, 73, 157, 109, 873, 74, 137, 65, 140, 0,
+	6, 792, 139, 154, 214, 18, 120, 146, 6, 25, 169, 28, 213, 126, 81, 94,
+	105, 1, 227, 199, 57, 333, 993, 437, 269, 1, 1228, 135, 34, 215, 163, 1310,
+	371, 12, 39, 20, 8, 65, 252, 67, 6, 201, 105, 214, 712, 8, 1549, 298,
+	57, 1, 55, 116, 167, 49, 246, 1228, 1, 8, 937, 139, 1, 657, 1004, 99,
+	65, 7, 147, 142, 3, 46, 2, 64, 405, 905, 490, 158, 33, 42, 35, 5,
+	3, 146, 617, 781, 3, 796, 1289, 106, 8, 34, 2033, 431, 104, 64, 36, 450,
+	247, 278, 1138, 71, 145, 15, 173, 657, 62, 21, 524, 28, 47, 26, 74, 18,
+	34, 145, 94, 36, 4, 486, 37, 7, 1, 2, 292, 897, 4, 261, 1769, 2,
+	34, 113, 1063, 3, 1, 1168, 1338, 2, 147, 61, 430, 277, 35, 206, 1307, 36,
+	14, 52, 513, 205, 708, 72, 108, 182, 56, 169, 248, 406, 592, 6, 299, 18,
+	17, 405, 186, 5, 2, 2261, 697, 1442, 55, 376, 57, 4, 95, 659, 62, 90,
+	1022, 57, 266, 12, 2, 901, 1347, 53, 1, 285, 123, 219, 11, 313, 9, 135,
+	2, 38, 152, 2798, 49, 2, 1891, 707, 33, 408, 2311, 235, 208, 139, 684, 201,
+	8, 610, 26, 1, 7, 39, 683, 111, 339, 1779, 1108, 123, 16, 264, 2748, 5,
+	127, 673, 294, 408, 287, 91, 212, 21, 96, 438, 362, 272, 109, 84, 96, 338,
+	46, 6, 113, 299, 3, 4044, 42, 112, 161, 88, 458, 34, 2, 3867, 186, 1,
+	1077, 114, 630, 1893, 2, 87, 127, 39, 160, 200, 59, 16, 432, 236, 11, 2,
+	208, 55, 13, 616, 60, 1097, 761, 1, 286, 1, 0, 15, 34, 1031, 721, 170,
+	620, 198, 1568, 352, 43, 3017, 53, 4, 34, 378, 107, 581, 850, 1737, 284, 2,
+	32, 67, 294, 36, 1, 122, 8, 1, 481, 47, 11, 1, 71, 6, 131, 49,
+	142, 108, 62, 33, 105, 0, 27, 80, 1079, 34, 10, 3, 16, 0, 31, 1,
+	255, 0, 127, 49, 80, 4659, 78, 2, 46, 323, 288, 32, 16, 902, 73, 132,
+	92, 106, 9, 20, 224, 267, 136, 1538, 4534, 693, 39, 2, 393, 70, 189, 6,
+	635, 162, 2324, 93, 37, 116, 451, 6, 196, 1786, 426, 63, 239, 1018, 768, 18,
+	43, 4783, 2424, 1, 2610, 1144, 797, 95, 9, 1370, 154, 1, 203, 582, 72, 332,
+	64, 131, 177, 276, 2240, 3760, 1, 66, 2177, 1609, 127, 63, 644, 244, 1726, 4,
+	121, 379, 519, 34, 823, 217, 2519, 127, 120, 136, 55, 287, 840, 575, 12, 10,
+	573, 164, 949, 339, 763, 33, 435, 17, 211, 708, 12, 26, 514, 533, 27, 54,
+	3821, 32, 1, 532, 9181, 2127, 21, 1, 7481, 465, 1, 48, 1451, 324, 337, 46,
+	85, 122, 2, 18, 80, 3806, 62, 161, 98, 642, 770, 42, 52, 35, 62, 102,
+	3788, 259, 138, 21, 538, 685, 24, 179, 2101, 362, 101, 8, 639, 2046, 630, 9,
+	1714, 60, 1, 60, 197, 1730, 52, 185, 1589, 3665, 57, 1055, 214, 1603, 5, 18,
+	2076, 3128, 31, 311, 1611, 56,
+}
+
+// part3MPHIndices maps minimal perfect hash slots to indices of languages sorted by ISO 639-3 code
+var part3MPHIndices = [...]uint16{
+	274, 127, 1965, 3405, 5947, 5845, 2703, 7647, 7221, 6893, 4254, 6983, 1011, 1206, 3441, 910,
+	5562, 3908, 4686, 1814, 7304, 7389, 1813, 6952, 6919, 4943, 7318, 486, 4216, 606, 4042, 2080,
+	7449, 1086, 6529, 4011, 1118, 283, 2065, 4557, 3510, 5860, 5225, 1128, 5761, 7746, 5533, 2718,
+	5437, 1659, 2967, 7164, 7561, 3474, 2454, 5037, 6057, 3069, 2768, 843, 6922, 1152, 7092, 7068,
+	7681, 1312, 853, 3877, 3628, 895, 4634, 3387, 3954, 6953, 3666, 3063, 6545, 5794, 6052, 5296,
+	7312, 7634, 2961, 1708, 3996, 5775, 2722, 1831, 5681, 6450, 281, 6479, 3667, 5200, 6268, 3016,
+	7438, 5813, 6858, 3377, 7474, 2018, 4578, 5392, 3407, 2719, 6390, 104, 4950, 978, 5937, 1398,
+	7194, 1572, 220, 2440, 1365, 7093, 4596, 6386, 6772, 2206, 3517, 6637, 303, 4362, 3221, 7702,
+	2534, 4161, 1361, 2330, 3075, 6495, 7342, 4885, 2002, 6197, 2170, 7178, 4574, 4469, 75, 2972,
+	1374, 3641, 1013, 3866, 2176, 1062, 5647, 5810, 6411, 6004, 319, 3988, 6295, 4267, 4526, 3255,
+	5714, 2876, 1704, 3638, 5013, 4382, 4742, 6065, 948, 6171, 5668, 5024, 3973, 7155, 2863, 1487,
+	6330, 4283, 1912, 5621, 7323, 1897, 3028, 3803, 764, 6928, 3348, 5662, 459, 5613, 2308, 6099,
+	982, 671, 7731, 7737, 7130, 5774, 6475, 3346, 92, 4312, 4969, 735, 1450, 1360, 72, 4952,
+	4170, 4545, 2707, 6233, 3113, 4390, 4869, 7462, 5305, 1608, 435, 374, 731, 6985, 5203, 7603,
+	2461, 6881, 5418, 427, 2801, 1716, 7566, 5052, 3249, 253, 3115, 457, 1481, 7394, 7843, 3060,
+	5569, 1023, 2546, 5216, 1293, 5482, 7259, 5274, 2977, 7242, 6408, 5328, 2670, 1381, 4982, 3585,
+	3689, 3888, 4019, 4527, 1303, 6639, 674, 4538, 3608, 6399, 4352, 4652, 2386, 167, 1674, 2517,
+	318, 1664, 6359, 1597, 6335, 6382, 2010, 7872, 5622, 4518, 2798, 423, 4660, 4191, 936, 5520,
+	5822, 653, 2047, 3876, 3587, 5742, 472, 4523, 7656, 3753, 1737, 5050, 1699, 717, 3014, 7368,
+	7589, 4587, 295, 2923, 6143, 3710, 49, 4971, 5844, 766, 4135, 3412, 4410, 7864, 2163, 2621,
+	616, 2857, 1036, 3813, 5404, 6804, 2648, 6451, 4944, 1012, 515, 3142, 409, 5863, 4868, 4876,
+	1320, 6072, 5190, 3309, 7753, 1870, 2042, 262, 3922, 913, 2533, 3353, 156, 2510, 4525, 2071,
+	7620, 3099, 4542, 5166, 6755, 4031, 2638, 1268, 4114, 7854, 7357, 2145, 3540, 3784, 40, 5060,
+	152, 7885, 3780, 2661, 3580, 3015, 769, 6226, 2506, 7255, 4346, 656, 5715, 7677, 2220, 4483,
+	2874, 5605, 5403, 5273, 2256, 7139, 4155, 1310, 3534, 3886, 5152, 1592, 4932, 6741, 1358, 6842,
+	4808, 4003, 6096, 3470, 4427, 7624, 5450, 937, 7745, 1748, 5535, 7787, 542, 1579, 855, 467,
+	6460, 5525, 4609, 6139, 2614, 2301, 5908, 6369, 2784, 2870, 7352, 296, 2471, 6258, 7163, 3622,
+	2984, 6010, 5430, 27, 6616, 5638, 209, 4732, 1826, 4510, 4209, 1227, 5924, 5334, 6998, 1485,
+	5624, 7657, 6114, 7127, 1656, 1736, 5594, 7443, 7827, 3429, 6192, 6790, 1095, 5373, 5974, 4397,
+	3799, 5635, 6445, 567, 4484, 1384, 1286, 2087, 5510, 1600, 7881, 3713, 7048, 6352, 3665, 154,
+	4189, 4985, 2193, 7148, 7700, 4007, 829, 4848, 6371, 97, 7541, 489, 1992, 1798, 4798, 6682,
+	5215, 1435, 3068, 3976, 5381, 7791, 1113, 819, 723, 4639, 4756, 3925, 5419, 4235, 3596, 7719,
+	5809, 1756, 908, 2829, 428, 4494, 5130, 2062, 289, 6412, 6693, 5272, 6340, 5560, 4877, 7469,
+	5077, 6159, 3332, 1260, 4018, 6969, 6610, 3447, 370, 6785, 2995, 5788, 706, 6117, 5168, 5650,
+	3012, 6669, 3593, 2940, 263, 4298, 5457, 7182, 4592, 100, 624, 1684, 4376, 4336, 5434, 4361,
+	4143, 2398, 826, 6553, 5787, 7328, 7691, 623, 586, 1053, 3216, 2999, 7675, 266, 5751, 1305,
+	3130, 11, 4499, 1752, 6324, 1185, 4972, 3126, 4033, 3614, 2872, 5856, 164, 4942, 372, 7574,
+	4425, 5976, 6490, 5154, 4456, 6140, 6964, 1772, 5864, 499, 277, 4507, 6014, 6943, 4429, 1451,
+	4370, 311, 6634, 7755, 7605, 359, 1663, 6651, 6636, 1872, 2094, 6241, 7567, 7559, 3244, 807,
+	799, 3583, 6008, 7402, 1199, 3940, 5201, 1383, 4934, 4953, 2350, 4519, 5938, 5779, 2177, 5226,
+	7836, 6474, 4805, 2758, 3752, 2749, 6793, 4558, 6238, 4140, 3761, 800, 7882, 4947, 384, 6249,
+	3754, 1367, 1190, 7542, 4704, 3302, 2837, 7640, 4056, 1812, 6154, 6631, 6074, 509, 2832, 7832,
+	1805, 6432, 7695, 7738, 2467, 1746, 2089, 6516, 7205, 625, 7247, 952, 6375, 525, 4462, 6206,
+	1915, 7815, 6224, 1588, 3308, 535, 2340, 3086, 3947, 2312, 7466, 2217, 1037, 7251, 510, 5968,
+	4920, 172, 3134, 6656, 1434, 6329, 7302, 1710, 2101, 94, 6972, 6716, 7769, 4195, 4005, 1987,
+	1732, 2151, 6311, 1335, 1626, 6535, 7698, 522, 6398, 1220, 1714, 3143, 6705, 232, 775, 2985,
+	4855, 1085, 5500, 5357, 3198, 1531, 3555, 2778, 3576, 6875, 7716, 7169, 5834, 7319, 2429, 2552,
+	2200, 419, 160, 4068, 1639, 2900, 3798, 1762, 1658, 7306, 7181, 360, 5725, 3355, 7064, 5162,
+	1507, 3785, 7773, 6407, 4168, 1788, 1410, 7284, 517, 3746, 4489, 404, 3184, 6742, 7747, 1879,
+	4389, 3637, 987, 6856, 2353, 5626, 3571, 885, 2019, 1926, 5317, 7121, 6092, 3737, 4619, 7141,
+	554, 5229, 2728, 4416, 2026, 3851, 1907, 5734, 1550, 3949, 3092, 7467, 6666, 5772, 5666, 5135,
+	4100, 7128, 3022, 2676, 2580, 596, 1513, 587, 5074, 1565, 2475, 3282, 3701, 5412, 685, 3520,
+	3378, 6907, 439, 4293, 5104, 3945, 939, 1202, 3961, 3736, 4715, 4449, 2566, 5121, 4208, 7450,
+	6603, 608, 2653, 1603, 7511, 3333, 5770, 6845, 3968, 6774, 138, 5765, 3693, 4671, 5524, 7476,
+	2945, 1149, 315, 1026, 5895, 2615, 6965, 3868, 6025, 410, 2859, 6622, 1295, 523, 1920, 1646,
+	7115, 669, 1585, 3226, 5377, 7637, 5241, 1424, 1072, 4678, 5536, 7805, 5211, 5837, 5795, 1954,
+	4713, 1000, 250, 4516, 4407, 6151, 6807, 133, 4682, 6160, 7425, 902, 7783, 4094, 6957, 1476,
+	4700, 975, 6719, 4532, 7036, 6642, 6748, 5505, 5526, 131, 3781, 4424, 7866, 7470, 363, 2916,
+	3898, 1257, 7544, 2714, 3535, 6205, 4583, 6941, 1553, 110, 7760, 1046, 7575, 7804, 5036, 7355,
+	3270, 6701, 5902, 1493, 4601, 3311, 4679, 2881, 4814, 1066, 3773, 7248, 2675, 7849, 2663, 3989,
+	7217, 307, 1117, 2640, 7532, 1778, 7648, 1139, 811, 3093, 2478, 4791, 4935, 3175, 5896, 7174,
+	5643, 5336, 7643, 1984, 4967, 4286, 4384, 7369, 7326, 116, 6365, 2842, 7631, 6175, 5368, 5148,
+	1512, 3694, 1751, 5102, 981, 3597, 7533, 3395, 234, 6805, 1786, 2138, 7031, 2085, 7298, 4544,
+	2745, 7364, 6835, 2164, 6288, 2766, 2048, 3491, 4219, 3204, 2030, 3930, 5945, 1977, 1212, 6105,
+	7794, 4664, 4846, 5602, 4244, 7100, 5645, 3006, 5174, 714, 5011, 5080, 2457, 4259, 6525, 288,
+	3185, 4839, 3449, 636, 4636, 7423, 7520, 6161, 752, 1099, 924, 680, 7536, 1271, 5880, 861,
+	1045, 1376, 4240, 3972, 3285, 2906, 161, 7732, 1721, 436, 4831, 1638, 798, 4675, 7770, 3053,
+	5352, 5261, 3064, 7218, 1880, 2807, 3824, 3456, 2888, 2363, 6560, 5878, 7697, 2817, 394, 5159,
+	1615, 6640, 574, 6729, 3747, 7280, 3765, 3873, 2460, 7138, 6041, 6455, 6643, 7398, 6657, 7527,
+	979, 143, 2997, 2656, 5138, 3371, 780, 5629, 2066, 5480, 4655, 1299, 3749, 6405, 2245, 1627,
+	4413, 1865, 2162, 2481, 607, 7296, 3918, 3476, 2417, 5410, 4309, 4412, 2011, 2334, 2823, 1437,
+	5762, 5220, 4492, 6781, 6667, 4834, 6574, 1899, 6814, 6532, 2573, 4603, 6823, 5933, 2394, 4299,
+	6946, 3146, 2140, 2750, 4537, 7526, 5758, 6349, 3797, 1606, 599, 5494, 5105, 866, 3262, 7379,
+	4780, 4470, 6802, 4627, 4716, 3191, 1162, 1930, 2427, 5654, 772, 15, 6722, 2934, 1083, 5065,
+	3224, 1443, 3770, 4374, 1775, 2513, 6361, 7551, 1001, 1896, 38, 3236, 1159, 7751, 2045, 336,
+	2495, 2250, 5022, 1730, 5005, 6717, 4411, 6939, 4432, 2165, 3140, 7628, 2222, 857, 6572, 245,
+	7504, 2257, 7782, 4556, 1636, 5720, 2422, 4568, 4204, 6830, 7049, 4188, 4157, 4332, 2775, 1484,
+	753, 1764, 4249, 1533, 1933, 2595, 6312, 4138, 7032, 1866, 2625, 6924, 2908, 4044, 4785, 4217,
+	2602, 6397, 763, 6239, 5486, 3962, 6480, 80, 6577, 3766, 3017, 4546, 6747, 5988, 77, 2708,
+	1411, 6181, 6591, 5579, 4884, 1404, 4613, 4063, 4463, 7213, 3194, 3040, 1316, 1309, 6559, 4635,
+	3110, 5325, 5964, 3190, 645, 6611, 4447, 7509, 4055, 2326, 4757, 3771, 7132, 7534, 1129, 464,
+	1989, 7586, 239, 6679, 1225, 3078, 3050, 4938, 1368, 5286, 5367, 6912, 5492, 538, 7727, 5593,
+	5420, 4186, 5842, 7718, 4783, 366, 3862, 898, 2700, 6166, 3669, 692, 2759, 7510, 3205, 1141,
+	2618, 1939, 5188, 2290, 1101, 5843, 1722, 5375, 1672, 5667, 4034, 3448, 7409, 7606, 1403, 1477,
+	7765, 1217, 5541, 5423, 754, 7043, 7710, 2937, 7868, 3263, 4264, 2449, 3892, 2636, 1637, 6035,
+	6203, 4753, 7563, 4118, 3159, 2119, 272, 1677, 5266, 3889, 6476, 99, 7162, 2746, 3156, 7752,
+	1094, 3486, 1650, 3905, 3127, 5279, 7821, 2530, 6313, 3114, 2583, 3080, 733, 6813, 6133, 6848,
+	1084, 5780, 5710, 5915, 2691, 2539, 7263, 4941, 5287, 4973, 5384, 433, 7433, 4173, 1645, 2156,
+	3569, 2024, 3649, 495, 3329, 2544, 5665, 3172, 3688, 7224, 5147, 6650, 4461, 4248, 3709, 3409,
+	6301, 3605, 582, 1448, 1527, 7818, 827, 6082, 1125, 3640, 5199, 284, 1463, 5343, 5278, 228,
+	5217, 7629, 2123, 6355, 7841, 4794, 7278, 5348, 7021, 6180, 3107, 412, 2677, 5791, 5095, 5850,
+	2488, 2952, 967, 7706, 7822, 1372, 6332, 3074, 4964, 7313, 2802, 6430, 746, 276, 3229, 6458,
+	2088, 6900, 3823, 5262, 1462, 2150, 4513, 4512, 6070, 1279, 878, 6519, 2112, 306, 4912, 1347,
+	5936, 66, 5861, 7168, 1914, 6739, 1963, 2100, 3222, 532, 2318, 3085, 2730, 3503, 420, 2401,
+	343, 3777, 6316, 6846, 2483, 842, 6069, 1255, 3168, 5445, 5294, 5322, 1583, 1440, 3499, 1256,
+	1712, 196, 927, 3248, 909, 4890, 89, 432, 7593, 4146, 2835, 5149, 4206, 114, 520, 3912,
+	5230, 932, 959, 6372, 2954, 7109, 1657, 1902, 3201, 7725, 3617, 5871, 1906, 5004, 6927, 1688,
+	5544, 6777, 4086, 7585, 1940, 4356, 6344, 7639, 6368, 183, 7285, 4595, 7136, 7736, 1140, 346,
+	3519, 492, 4988, 6831, 5139, 6961, 5876, 2988, 63, 4237, 2202, 6564, 37, 7439, 817, 1701,
+	3525, 4350, 3843, 6221, 2584, 4588, 4989, 1697, 2782, 4746, 3242, 7082, 322, 6796, 3366, 6897,
+	6427, 7238, 3404, 2757, 6131, 1675, 1901, 1331, 2657, 3854, 1276, 615, 2856, 4773, 3089, 324,
+	6800, 1009, 3274, 7244, 920, 6102, 2601, 2219, 5083, 1532, 1516, 1322, 1325, 5191, 3315, 6549,
+	5556, 3498, 4421, 6169, 1280, 7411, 4040, 4107, 4222, 2649, 5316, 6533, 1819, 4294, 484, 5764,
+	4911, 4097, 6671, 174, 5069, 4693, 6853, 5103, 907, 968, 710, 1082, 5740, 7596, 4386, 3360,
+	6920, 2129, 3967, 6071, 6987, 4058, 3627, 5719, 3899, 7463, 4160, 7522, 1509, 4394, 2016, 1465,
+	1147, 4593, 2959, 5875, 6540, 4178, 4026, 2215, 3509, 5529, 5553, 3644, 3833, 1107, 4728, 214,
+	5214, 5750, 667, 6550, 2310, 6334, 3097, 4467, 2265, 7625, 3494, 918, 5030, 3552, 223, 7809,
+	5157, 6724, 83, 4960, 4550, 976, 5985, 4274, 7584, 4241, 664, 3036, 6721, 6271, 6582, 6868,
+	4334, 3334, 7422, 1709, 1377, 6896, 5202, 4006, 76, 2079, 2216, 4069, 2125, 6702, 185, 1453,
+	414, 5922, 3586, 200, 1039, 6780, 7726, 5623, 4560, 4539, 7297, 5167, 1564, 4717, 4070, 7607,
+	2701, 6520, 6915, 4566, 6619, 1941, 5565, 7282, 7666, 4371, 4802, 1136, 4521, 4163, 430, 873,
+	5852, 308, 5675, 397, 6193, 2507, 3732, 1981, 170, 2551, 3147, 329, 7137, 699, 3603, 2939,
+	5293, 1460, 2319, 2538, 546, 6404, 6436, 2208, 6668, 7012, 5683, 382, 7413, 7117, 3814, 1251,
+	4703, 998, 7795, 5882, 2116, 3598, 1892, 6464, 5184, 6608, 4674, 5401, 5242, 6017, 483, 1905,
+	527, 7867, 2763, 995, 4116, 2865, 5673, 3469, 3203, 6980, 5416, 102, 3708, 4314, 6050, 3998,
+	3257, 1723, 4207, 1204, 7671, 7229, 5362, 257, 181, 2684, 3354, 2302, 3145, 6689, 6798, 482,
+	2983, 4914, 2946, 6617, 2741, 6749, 1050, 279, 269, 2915, 1889, 3135, 7348, 1946, 3659, 2267,
+	792, 5889, 6615, 2557, 993, 166, 757, 5920, 5872, 1961, 4959, 3103, 5228, 3258, 1313, 3129,
+	159, 1030, 6145, 718, 3615, 693, 5670, 7215, 1576, 6210, 5411, 3927, 186, 3369, 1317, 5859,
+	7748, 3183, 4662, 7722, 890, 5304, 6068, 1430, 6892, 2526, 7341, 6911, 6542, 3793, 1776, 2040,
+	963, 3223, 5503, 5267, 4414, 6589, 3857, 4585, 2025, 7793, 5906, 5395, 3164, 5056, 6406, 5329,
+	6061, 7454, 1886, 4741, 7157, 4643, 7682, 5868, 1822, 1243, 105, 5113, 1098, 5587, 7573, 4108,
+	6075, 2841, 348, 206, 4722, 2009, 5934, 7432, 2783, 2323, 1785, 153, 1308, 3358, 4017, 3436,
+	1818, 1230, 6512, 1780, 6043, 5465, 950, 5550, 1166, 4572, 6958, 1962, 4547, 1958, 767, 3116,
+	2013, 2931, 3560, 4564, 3827, 6485, 5496, 7576, 3826, 7371, 4690, 6765, 4818, 6726, 6883, 1375,
+	1163, 1155, 7250, 7659, 5432, 3493, 3942, 6459, 724, 7061, 1609, 901, 1757, 4832, 7310, 1467,
+	6547, 6838, 4503, 7771, 2815, 1002, 1336, 6029, 6978, 5126, 1771, 2791, 5070, 5655, 3234, 3209,
+	2845, 5086, 7340, 7325, 2126, 9, 468, 5186, 1540, 7324, 5002, 2673, 103, 751, 2186, 4684,
+	1829, 4391, 68, 676, 2950, 1974, 4949, 5345, 696, 3565, 851, 1501, 5129, 818, 50, 1649,
+	6414, 2001, 7785, 4899, 1552, 6644, 3294, 5582, 1068, 7201, 1918, 2294, 5887, 988, 3722, 6118,
+	547, 6885, 6469, 7095, 224, 3716, 6286, 5771, 6157, 473, 3739, 2014, 3182, 7022, 4401, 4297,
+	5405, 6528, 6385, 6595, 2276, 4689, 5680, 5467, 3341, 3144, 136, 5746, 5057, 1356, 6037, 6786,
+	7167, 1297, 6678, 7176, 4342, 3008, 5892, 7713, 7878, 2466, 5283, 897, 5302, 3723, 6709, 5289,
+	5570, 1183, 1370, 6754, 7876, 3082, 1536, 2866, 3748, 7762, 1802, 4784, 5407, 290, 732, 3445,
+	3674, 380, 3547, 4477, 7764, 1455, 286, 3322, 756, 2969, 3591, 2704, 1820, 2462, 4261, 6587,
+	5935, 727, 4966, 5918, 1090, 779, 3801, 3952, 1123, 1591, 6200, 6039, 7216, 6235, 5067, 2965,
+	1968, 5441, 1265, 6367, 7887, 4565, 4148, 6338, 3974, 6676, 1842, 4755, 5532, 2132, 1405, 222,
+	1569, 5358, 2292, 5031, 543, 1770, 7070, 287, 1607, 6123, 6580, 1091, 1266, 298, 602, 406,
+	4233, 507, 2171, 4199, 7374, 601, 2665, 129, 2204, 2090, 3870, 3176, 5592, 3243, 579, 6257,
+	2734, 516, 4649, 6250, 3188, 6744, 2291, 458, 6504, 2174, 4998, 7232, 5169, 2499, 3906, 7338,
+	3314, 1838, 4029, 1024, 3122, 3325, 1869, 3590, 7842, 5019, 5058, 3864, 7018, 1021, 6410, 6395,
+	6992, 5127, 2589, 3323, 2184, 1182, 4379, 2879, 5980, 7613, 1191, 3391, 111, 2489, 21, 3152,
+	1665, 5961, 1729, 4888, 5470, 1254, 638, 7850, 2152, 1208, 5831, 1852, 6575, 1745, 883, 6515,
+	572, 5046, 2958, 4863, 4084, 373, 1292, 6376, 6212, 5218, 120, 2744, 7170, 2692, 6292, 618,
+	7889, 4473, 5068, 6214, 271, 4867, 6317, 521, 590, 7337, 6602, 7592, 647, 726, 5992, 5323,
+	7066, 4708, 3500, 4778, 202, 7227, 4951, 3251, 6771, 3384, 3056, 7198, 5798, 3444, 7484, 6356,
+	7086, 4479, 1408, 5179, 480, 5059, 142, 1029, 7333, 7275, 3284, 6646, 3219, 5232, 494, 4169,
+	3896, 6710, 2840, 649, 1079, 4103, 2586, 2251, 5333, 2767, 503, 3563, 5517, 1014, 3365, 1353,
+	1733, 2393, 1595, 6137, 4015, 3696, 2159, 5252, 1498, 3759, 6104, 5804, 5048, 7363, 392, 5904,
+	5310, 3915, 816, 1662, 5485, 3381, 5360, 7007, 7045, 5691, 518, 7855, 4586, 2629, 4212, 6576,
+	1069, 777, 1839, 5155, 3782, 4187, 3645, 1863, 6423, 3619, 6933, 4277, 708, 3849, 2509, 4597,
+	35, 6425, 3471, 5865, 4329, 2248, 6449, 1176, 4433, 530, 7002, 6085, 862, 2214, 1518, 4714,
+	1327, 5189, 264, 6811, 2820, 3422, 1452, 2894, 6629, 5051, 4036, 7558, 4801, 2523, 3465, 5025,
+	5700, 313, 2225, 2693, 2522, 371, 2607, 2366, 5366, 2383, 660, 2316, 3631, 916, 5716, 3502,
+	7029, 670, 7366, 3554, 3327, 3916, 1171, 5718, 2242, 7219, 6506, 4819, 3439, 2037, 4375, 128,
+	6937, 7223, 1055, 4053, 2966, 5042, 3026, 7207, 3642, 7495, 6108, 3155, 6672, 3719, 1743, 2654,
+	7385, 3796, 3911, 1836, 7776, 7813, 4931, 6348, 3427, 2827, 6218, 3867, 7686, 7578, 4554, 3260,
+	7515, 5664, 7404, 391, 5344, 6059, 5855, 1468, 6714, 7516, 5847, 1351, 597, 4236, 5987, 3575,
+	2720, 48, 3021, 4317, 5978, 2194, 4584, 413, 4041, 1909, 5033, 1464, 4974, 2082, 4234, 1148,
+	5884, 408, 2278, 2487, 1017, 2955, 4153, 7833, 1692, 5956, 5687, 146, 7392, 2437, 2053, 2887,
+	1598, 4369, 2575, 2743, 5064, 1728, 5736, 3154, 1043, 3913, 896, 3654, 2083, 3920, 7436, 1133,
+	3061, 3277, 6100, 6020, 960, 6093, 4727, 230, 5648, 2987, 6350, 1994, 7570, 6005, 1189, 1486,
+	4600, 4956, 7717, 5607, 4845, 2232, 4581, 6231, 3893, 4851, 774, 7416, 6001, 4795, 7721, 6103,
+	7320, 6304, 514, 4772, 1088, 5128, 6217, 5928, 4616, 6692, 3537, 6735, 2074, 1560, 3800, 177,
+	1145, 7391, 7025, 2846, 4994, 4159, 5391, 4502, 7599, 6607, 1474, 3472, 1418, 5282, 7678, 5785,
+	1025, 1724, 481, 4001, 1127, 3564, 2620, 7158, 2343, 663, 2356, 2570, 4902, 78, 5823, 7750,
+	5481, 6975, 946, 2593, 6270, 2862, 6665, 293, 4948, 3214, 5491, 5158, 4481, 7075, 1976, 7730,
+	7690, 7699, 4642, 4575, 2281, 7135, 6837, 5926, 3206, 4995, 7262, 6825, 2240, 4227, 7572, 2685,
+	2527, 7370, 211, 4051, 5250, 4939, 3316, 6155, 5256, 561, 5730, 2199, 6561, 5040, 1426, 4553,
+	1355, 3895, 4767, 7208, 7026, 249, 4446, 1841, 3734, 5389, 3751, 7367, 786, 6660, 4672, 2407,
+	4136, 830, 7171, 4439, 1106, 2558, 1686, 4865, 282, 7661, 2519, 2157, 1740, 339, 4087, 7834,
+	3601, 208, 7531, 850, 2006, 7642, 7028, 679, 6394, 7662, 802, 4968, 2610, 4269, 805, 5356,
+	2223, 3914, 7707, 3776, 4285, 5255, 2973, 4054, 1580, 1339, 4657, 5209, 3279, 2521, 4190, 3946,
+	7308, 1601, 5236, 4514, 1428, 5717, 4501, 5608, 5425, 3165, 479, 1323, 2432, 4872, 661, 2336,
+	267, 5574, 2104, 7788, 537, 2928, 7395, 6215, 1108, 1100, 926, 6871, 3932, 6255, 7786, 5568,
+	1059, 6821, 6051, 3230, 1161, 4335, 4908, 340, 2810, 7184, 4524, 7461, 1258, 3934, 4987, 4272,
+	7011, 7144, 4396, 1804, 7892, 7627, 7486, 5577, 1124, 2259, 7268, 1840, 6936, 773, 6220, 3178,
+	6419, 1103, 6204, 3196, 7493, 571, 6463, 1282, 4013, 6746, 1154, 7149, 3139, 4089, 4083, 1690,
+	5580, 1328, 2187, 4646, 3149, 4046, 4762, 1343, 130, 4167, 2072, 3345, 1660, 4962, 7622, 1505,
+	3711, 2416, 947, 3720, 3501, 7491, 6377, 7330, 7451, 1307, 3305, 3367, 1048, 3921, 204, 4057,
+	4381, 985, 2929, 4032, 5993, 251, 47, 6486, 4654, 3298, 5885, 416, 5382, 3400, 3738, 7360,
+	1214, 3480, 6487, 79, 2409, 4892, 7806, 5893, 5474, 3907, 3874, 3548, 2886, 4255, 1288, 4864,
+	7273, 7812, 3105, 4768, 598, 1547, 256, 1031, 7382, 2571, 1543, 496, 3425, 5292, 7502, 3380,
+	4739, 7852, 6730, 5600, 1364, 595, 709, 2335, 5208, 4754, 1115, 5507, 165, 7448, 6788, 3357,
+	3467, 6857, 1754, 6994, 5397, 2234, 3495, 4210, 5690, 4901, 88, 7134, 6213, 7051, 278, 2839,
+	4444, 3265, 2453, 7099, 1515, 7687, 2980, 1213, 5738, 5835, 6493, 7521, 3790, 176, 2124, 5632,
+	7580, 5849, 5958, 2634, 5146, 5552, 1422, 5696, 5826, 2272, 6585, 3417, 3121, 5793, 5371, 4166,
+	6315, 6269, 6308, 2298, 5693, 5143, 3794, 6413, 4201, 4900, 4823, 7079, 2315, 4295, 7480, 2067,
+	6767, 301, 1399, 989, 4228, 1541, 7548, 2681, 1156, 3783, 2076, 447, 7424, 7069, 3033, 6121,
+	4936, 2650, 328, 4824, 6040, 4302, 6732, 5563, 2811, 12, 4878, 4325, 3579, 3728, 4641, 841,
+	1169, 74, 3566, 7617, 3310, 1628, 4630, 4150, 4129, 4075, 6806, 1160, 7847, 803, 4930, 316,
+	7015, 3613, 5528, 6536, 565, 5989, 6421, 813, 4096, 6440, 4508, 5156, 2448, 6031, 7317, 3635,
+	5567, 2914, 1986, 5444, 4288, 6677, 6483, 5930, 6713, 7489, 5193, 6502, 7873, 5625, 3153, 4748,
+	379, 7768, 7676, 1971, 7226, 2445, 1131, 6863, 7271, 6136, 5891, 665, 1584, 192, 5997, 4472,
+	904, 837, 5637, 573, 760, 5923, 1904, 6756, 604, 3034, 1537, 7283, 1520, 4465, 3661, 193,
+	2550, 5796, 5597, 5919, 5172, 6685, 3616, 2547, 5684, 6879, 4719, 3250, 4276, 3839, 1290, 524,
+	2770, 4927, 7180, 5674, 5965, 4955, 1990, 7568, 6472, 352, 3278, 7175, 5962, 3809, 7076, 6736,
+	3252, 4589, 2073, 1319, 588, 5112, 5575, 3179, 5881, 5284, 2889, 796, 7085, 4305, 242, 168,
+	5141, 502, 7081, 5846, 2786, 2012, 4804, 1777, 4633, 7146, 4990, 2805, 7292, 1300, 3621, 7230,
+	2789, 6670, 6699, 7120, 2683, 4111, 3561, 7246, 1479, 1924, 3013, 5805, 3291, 6653, 3010, 889,
+	6128, 2658, 4573, 5140, 7083, 6016, 6462, 771, 1350, 2166, 953, 505, 6970, 52, 4338, 2049,
+	4014, 894, 3133, 609, 453, 2046, 3087, 1357, 6662, 2873, 545, 429, 1844, 5125, 5446, 2686,
+	5085, 3339, 7419, 5197, 3228, 1860, 5093, 6199, 1153, 2574, 6049, 2463, 914, 834, 4301, 7817,
+	1388, 6718, 3887, 3271, 2822, 6518, 5300, 4976, 4320, 6763, 1057, 5522, 1038, 4455, 1999, 2921,
+	2646, 2812, 2105, 5027, 7172, 528, 3850, 6592, 1859, 770, 2667, 7623, 5511, 4060, 2792, 5424,
+	4529, 5150, 1661, 2565, 1167, 5911, 112, 6027, 4691, 7254, 5542, 4991, 449, 2041, 2118, 6887,
+	6770, 3286, 4313, 1420, 4000, 4870, 7756, 4426, 4275, 5975, 4183, 3458, 1269, 7714, 2142, 1459,
+	3123, 7780, 6801, 317, 2882, 7, 3430, 6562, 3268, 6116, 6507, 860, 5769, 1193, 2609, 1610,
+	5173, 4247, 1535, 4345, 3111, 56, 3193, 4729, 1654, 2195, 5108, 1321, 4038, 6358, 1561, 2097,
+	4895, 3611, 7350, 5219, 3657, 1449, 6429, 5538, 884, 1242, 6030, 2127, 6331, 591, 6058, 386,
+	7154, 1828, 6725, 5265, 1402, 4826, 2286, 5118, 3259, 944, 3980, 4266, 3802, 1808, 3035, 5661,
+	6984, 5931, 697, 2396, 216, 6253, 6878, 5818, 3883, 7501, 1849, 5571, 7880, 140, 3009, 1991,
+	4442, 1845, 6681, 5611, 6775, 30, 4101, 2331, 642, 3931, 584, 3065, 2346, 4710, 2469, 6291,
+	7401, 4981, 7720, 2819, 3454, 5285, 2508, 7103, 6170, 5677, 43, 3049, 2111, 4020, 4321, 6824,
+	1344, 7890, 725, 5400, 3, 1878, 6555, 2373, 529, 4517, 1016, 3673, 7604, 7006, 3479, 7767,
+	536, 7460, 4913, 1077, 118, 3594, 320, 5062, 2446, 6712, 6492, 7704, 3764, 7314, 2679, 4843,
+	3057, 6500, 418, 3157, 5244, 1643, 2596, 364, 3963, 6478, 4400, 4799, 2747, 5281, 687, 3671,
+	2721, 3861, 1063, 3484, 2207, 275, 7601, 6322, 5898, 501, 2389, 6632, 4392, 7196, 217, 2736,
+	1092, 3632, 2577, 6186, 7517, 6023, 3416, 3363, 921, 2637, 581, 4856, 3578, 2172, 5116, 6073,
+	5649, 3691, 2599, 6006, 741, 5483, 6079, 2413, 2723, 7107, 4667, 2785, 4192, 1456, 6733, 7792,
+	6940, 6196, 4318, 641, 3724, 455, 7749, 3460, 7133, 5979, 940, 2246, 5723, 4820, 745, 5009,
+	1018, 6828, 3059, 7839, 4615, 3582, 4559, 1617, 4438, 5745, 7241, 452, 1231, 5763, 3326, 6347,
+	6731, 3225, 7299, 4491, 7615, 4498, 1734, 1222, 1631, 1614, 1824, 4280, 7869, 5800, 2502, 6134,
+	2643, 2329, 5990, 3943, 65, 7587, 2919, 4569, 1950, 892, 6256, 7106, 3518, 6164, 951, 3054,
+	273, 6645, 123, 1796, 3992, 285, 178, 634, 3524, 1104, 3634, 5695, 2858, 2793, 6229, 5879,
+	7552, 2572, 4196, 7437, 1461, 6694, 6621, 5957, 5206, 783, 191, 1581, 5181, 3497, 4946, 5595,
+	720, 6715, 3299, 2211, 5708, 4977, 2051, 7796, 6538, 864, 7231, 5254, 589, 6032, 7523, 702,
+	2148, 2198, 3917, 95, 5295, 5346, 3020, 3199, 5185, 1698, 3683, 2364, 5679, 4594, 6107, 3928,
+	3672, 5951, 2485, 5995, 7452, 3997, 6387, 1087, 4996, 992, 1194, 3703, 1301, 6046, 1215, 4889,
+	6055, 2600, 2230, 1506, 3756, 1270, 5982, 3690, 7190, 3804, 7361, 2712, 375, 45, 4853, 1471,
+	768, 5546, 1174, 5706, 4081, 7293, 5280, 6697, 4835, 3423, 6511, 4625, 6077, 3295, 2672, 2450,
+	3668, 7286, 5756, 4171, 2754, 4670, 5107, 6566, 3852, 3070, 617, 7027, 1203, 1483, 1948, 6684,
+	2306, 4258, 5471, 577, 323, 5824, 6303, 6391, 7428, 531, 7652, 7236, 431, 3317, 4782, 7472,
+	7673, 1236, 7111, 2814, 7332, 2274, 2320, 358, 3618, 5314, 6496, 2556, 6129, 5825, 1568, 5755,
+	6274, 3848, 4326, 1444, 2922, 6803, 738, 3450, 3336, 7126, 2008, 2501, 3102, 4695, 3362, 971,
+	5694, 20, 7335, 5554, 7380, 3941, 3541, 4224, 719, 3477, 425, 3516, 6869, 2374, 2806, 6310,
+	426, 1853, 4534, 6812, 7579, 3338, 3162, 1846, 7824, 2297, 1425, 1817, 2549, 3869, 4419, 833,
+	2834, 7418, 6548, 4271, 1003, 2137, 1911, 824, 4486, 6966, 134, 2390, 4141, 1143, 3231, 4262,
+	1943, 2055, 1893, 5944, 974, 2627, 7826, 7399, 4239, 2976, 5237, 562, 3370, 6109, 2096, 2405,
+	578, 2, 5320, 6638, 1064, 5612, 6973, 81, 3684, 1181, 4921, 6245, 3810, 2385, 3240, 7728,
+	3994, 957, 5133, 3253, 7214, 2705, 1345, 1311, 3118, 6766, 2892, 2179, 3950, 4072, 378, 6021,
+	7848, 2036, 2994, 4730, 1837, 5239, 3027, 6995, 3647, 400, 1138, 812, 4158, 854, 1228, 2236,
+	2852, 6601, 5198, 7272, 2555, 7459, 1983, 4891, 4287, 7073, 5399, 7458, 5221, 3032, 7496, 7060,
+	6773, 341, 5260, 4360, 6817, 1807, 4180, 980, 2694, 5685, 7626, 7405, 3937, 2824, 6776, 6149,
+	5124, 5939, 2528, 1593, 354, 7846, 5087, 612, 797, 6275, 917, 3038, 3944, 3037, 2578, 326,
+	6593, 1875, 6816, 7597, 3428, 7479, 5599, 4303, 7630, 5642, 4777, 331, 6066, 87, 7645, 2659,
+	1890, 6526, 6389, 3112, 7530, 3446, 6840, 7564, 34, 4958, 2130, 6988, 4905, 6558, 3514, 2942,
+	552, 5110, 5041, 2742, 3729, 5799, 2339, 4734, 1582, 1470, 2515, 7140, 4133, 7856, 1130, 790,
+	1, 197, 3396, 879, 5161, 3924, 3825, 6769, 1432, 6569, 5836, 60, 5238, 1876, 7875, 1720,
+	7037, 1302, 6396, 6663, 6183, 1685, 4963, 7672, 7098, 7665, 6138, 1334, 1705, 4983, 3293, 3005,
+	2247, 6658, 294, 1700, 3180, 5076, 7279, 2518, 1458, 7033, 7358, 7871, 7577, 7535, 949, 3750,
+	6230, 4347, 4340, 794, 7050, 3855, 2155, 3335, 5754, 2913, 6843, 6762, 4002, 4405, 7089, 7654,
+	929, 512, 4906, 7427, 5088, 4694, 6457, 113, 6290, 5776, 4434, 6757, 3239, 2849, 6047, 1529,
+	2477, 221, 2277, 2696, 7199, 3655, 793, 5473, 2825, 6177, 1314, 4451, 7142, 1519, 603, 6448,
+	4887, 6510, 1224, 4194, 4788, 912, 4128, 3007, 4037, 3483, 233, 1825, 3281, 4829, 6307, 5043,
+	2933, 2388, 1868, 5585, 2309, 1514, 5969, 2878, 139, 5672, 2144, 2285, 1207, 4860, 7549, 1158,
+	5, 6202, 2102, 4214, 4881, 4464, 1731, 7177, 3999, 4709, 2210, 3681, 2480, 804, 1545, 7108,
+	4770, 2844, 3610, 2052, 632, 6481, 4437, 650, 755, 4861, 3955, 1210, 4093, 4098, 7067, 610,
+	7543, 1330, 7143, 5249, 5523, 2464, 5627, 3567, 5270, 1010, 1900, 4726, 5817, 2192, 3929, 4331,
+	4043, 6076, 6473, 7195, 1396, 5722, 5153, 5829, 6078, 6130, 4644, 5709, 1439, 7062, 1032, 6194,
+	4268, 4661, 7775, 6132, 2724, 3300, 3067, 4027, 7203, 1632, 868, 4073, 994, 6991, 3041, 1244,
+	6122, 4164, 1346, 3117, 6477, 6905, 6467, 7386, 2761, 3740, 3704, 3173, 2424, 6690, 7860, 5973,
+	1272, 4852, 7508, 1988, 3529, 2524, 3101, 1726, 6570, 1616, 6273, 2077, 7595, 3863, 5534, 7042,
+	539, 252, 7465, 1758, 2695, 3599, 7715, 4751, 3682, 4328, 2833, 6237, 2787, 5792, 3128, 5182,
+	3607, 966, 141, 6337, 5335, 3909, 6045, 2500, 3515, 5297, 5374, 2751, 6191, 6277, 3443, 390,
+	7862, 2376, 5414, 7766, 2456, 5704, 2420, 3073, 4504, 1571, 2936, 1246, 2838, 5768, 3820, 4806,
+	3650, 6913, 7202, 2543, 2133, 5431, 2971, 2003, 1589, 1111, 1679, 7583, 3806, 261, 4590, 4812,
+	6659, 6606, 2347, 5724, 1209, 1833, 7023, 4263, 6509, 6556, 3663, 6314, 5008, 2904, 3630, 5953,
+	7636, 919, 4162, 5081, 6583, 5398, 108, 3207, 592, 3982, 7222, 2781, 2355, 1795, 6263, 4231,
+	189, 1642, 1363, 4580, 1354, 3167, 5020, 2710, 1522, 4849, 5697, 1245, 2434, 2605, 6979, 657,
+	3261, 3714, 3983, 4420, 6417, 5659, 1975, 1287, 923, 4701, 1034, 5806, 4915, 469, 5452, 1874,
+	2452, 1142, 3835, 6909, 4080, 7267, 1925, 7840, 3592, 3991, 150, 1400, 1122, 3414, 6734, 3677,
+	2630, 2660, 2486, 6261, 477, 184, 356, 3755, 6797, 4718, 6328, 6768, 4291, 6931, 237, 4279,
+	7305, 3844, 5447, 655, 4112, 2664, 6882, 6815, 4225, 5900, 7554, 6929, 3376, 5402, 6513, 244,
+	548, 1415, 7097, 1633, 368, 4631, 2831, 3108, 2367, 7500, 2099, 1218, 6833, 3815, 1285, 6997,
+	4505, 4487, 1329, 4366, 1575, 6442, 7582, 7512, 7041, 2797, 7789, 630, 2442, 6198, 2465, 3602,
+	5433, 7853, 2399, 1782, 5551, 5054, 611, 5591, 6962, 6918, 4145, 5797, 6539, 7709, 7729, 7667,
+	4445, 4610, 2772, 1625, 4117, 1071, 2086, 2953, 934, 5331, 6652, 3217, 2531, 7381, 7618, 2899,
+	3821, 1168, 887, 1523, 2160, 1197, 5390, 5663, 7455, 1570, 6501, 6373, 5981, 5640, 1669, 7863,
+	5811, 2644, 5513, 6003, 1653, 7417, 5429, 32, 4810, 1969, 6740, 5819, 7420, 1413, 2651, 6916,
+	7406, 5363, 3303, 6461, 3079, 6267, 2998, 5705, 6664, 761, 4796, 1655, 3304, 1629, 6431, 1578,
+	4591, 1362, 7446, 7094, 1076, 2520, 4023, 1247, 5053, 905, 6174, 6571, 3847, 4669, 620, 2853,
+	5119, 3088, 5838, 6026, 6112, 2196, 5903, 6673, 1283, 6707, 2238, 7545, 6531, 2588, 5644, 4509,
+	4485, 6422, 3238, 6360, 1763, 5941, 5160, 3382, 1834, 2273, 248, 4221, 5144, 2752, 305, 3453,
+	4193, 5490, 6758, 5463, 2114, 2441, 19, 1371, 4638, 6854, 3891, 3629, 3556, 389, 5120, 4923,
+	7733, 5929, 4533, 1409, 3457, 5914, 7336, 2925, 4658, 6508, 1964, 4323, 1042, 7475, 6880, 4838,
+	848, 3841, 1438, 14, 7240, 1811, 240, 4243, 314, 4611, 626, 3462, 7096, 2748, 1903, 4113,
+	7150, 91, 3051, 5428, 5841, 1670, 7879, 1749, 1004, 4090, 1666, 2264, 3421, 5370, 1340, 849,
+	5309, 4999, 1784, 2612, 7165, 1180, 2147, 4933, 972, 4174, 6370, 2541, 2459, 3697, 5246, 6124,
+	299, 31, 155, 6053, 1667, 137, 2178, 6635, 5614, 3169, 6125, 4541, 1774, 563, 1792, 3933,
+	2948, 445, 2348, 2293, 2662, 3733, 3871, 4260, 2304, 4095, 1414, 7859, 6590, 6333, 2117, 4602,
+	3958, 2287, 7487, 3721, 7233, 580, 5784, 4059, 7010, 5347, 5508, 5515, 5163, 1641, 1135, 722,
+	2189, 5136, 1767, 162, 4406, 2043, 2000, 6990, 2433, 6056, 1980, 4076, 7349, 6305, 7030, 705,
+	119, 5897, 3926, 5017, 2226, 3466, 5851, 3528, 2341, 1065, 875, 399, 4021, 2514, 2241, 6891,
+	2404, 2321, 564, 6195, 7492, 1239, 4743, 2729, 5427, 6760, 6343, 6586, 2352, 3390, 6624, 4223,
+	6000, 6162, 6319, 5489, 7429, 784, 1392, 1605, 3508, 5408, 1480, 3731, 840, 2800, 911, 5671,
+	2106, 2739, 2403, 53, 5422, 6743, 460, 3768, 3881, 1238, 1184, 506, 965, 444, 4139, 3662,
+	3364, 4242, 2333, 7009, 3451, 3807, 3307, 2738, 3212, 7105, 3539, 270, 1993, 6185, 3573, 3024,
+	2981, 7055, 6321, 3653, 3865, 3373, 7071, 7346, 2372, 1466, 7074, 7059, 6899, 2639, 4197, 347,
+	5555, 2624, 5589, 7694, 5464, 3715, 4435, 2991, 265, 6044, 2512, 6568, 6111, 5090, 5778, 2095,
+	5171, 7347, 4218, 7114, 5527, 6647, 6950, 1703, 5883, 1873, 1074, 3109, 7693, 1341, 3312, 7260,
+	828, 838, 2484, 3779, 3350, 490, 7334, 6820, 7020, 4954, 2031, 1524, 2021, 6424, 1769, 29,
+	4606, 1668, 4821, 3276, 1373, 7505, 2075, 345, 778, 5468, 5744, 5760, 6381, 5194, 4460, 7609,
+	6018, 4897, 7375, 6588, 5098, 1635, 5096, 5531, 1338, 124, 5263, 3269, 1525, 4252, 2564, 7315,
+	7122, 2645, 5443, 6453, 568, 1382, 7300, 2803, 3399, 369, 148, 7377, 4337, 2697, 2597, 6598,
+	3840, 5231, 3838, 5749, 2930, 3789, 2604, 5393, 4306, 928, 5142, 3420, 6938, 7046, 7052, 5417,
+	1696, 2023, 4735, 1033, 1390, 6150, 3320, 5651, 639, 3131, 2270, 5547, 2370, 7799, 2212, 4922,
+	1102, 3136, 4440, 7831, 198, 7004, 5839, 2395, 5440, 2943, 6799, 3948, 2447, 6222, 958, 1694,
+	2307, 3834, 2266, 3055, 440, 4497, 6351, 1611, 7388, 5117, 1530, 2382, 2779, 5970, 2203, 7327,
+	6471, 2185, 1359, 5743, 4048, 126, 1967, 1366, 865, 2826, 7383, 2439, 3636, 6243, 690, 3403,
+	2414, 190, 1648, 4847, 5438, 2909, 41, 1195, 3872, 3171, 1557, 5566, 2068, 6886, 3018, 5610,
+	991, 6819, 3538, 3296, 4110, 2616, 3901, 6126, 5321, 6285, 5617, 1386, 7191, 1393, 7090, 652,
+	6254, 487, 1201, 238, 5943, 1248, 7239, 5453, 3831, 2345, 1304, 6110, 7445, 3047, 4122, 5385,
+	3791, 6034, 2479, 3902, 1511, 4121, 2989, 6599, 3318, 6207, 3523, 1713, 4873, 1622, 3374, 2038,
+	4404, 4324, 1049, 4984, 7038, 5451, 5584, 3254, 2727, 3778, 1949, 3042, 4205, 4744, 3368, 4929,
+	5960, 5858, 335, 1554, 3062, 2092, 640, 511, 2542, 5039, 3956, 6266, 5484, 5676, 2244, 6296,
+	3267, 3551, 3237, 6054, 3158, 7065, 6244, 3488, 3875, 3386, 788, 5035, 344, 3818, 945, 7814,
+	6782, 158, 7649, 7488, 6738, 7321, 3984, 5726, 1735, 2918, 2960, 3975, 4894, 5548, 387, 2733,
+	203, 4859, 5653, 6388, 2788, 4883, 1938, 3845, 1116, 5578, 5449, 2902, 4522, 5123, 3394, 2472,
+	2920, 1858, 456, 350, 3860, 5311, 1881, 7084, 7354, 4428, 2154, 633, 1558, 7473, 4536, 1056,
+	7387, 5499, 297, 7703, 2221, 3000, 4062, 5341, 6974, 2289, 7295, 7145, 3727, 2317, 5854, 7819,
+	1294, 149, 1119, 2136, 7189, 2885, 3174, 6686, 5497, 6926, 7353, 7845, 5757, 6810, 2680, 64,
+	4937, 7477, 6827, 2032, 405, 6415, 3324, 4604, 3692, 4511, 5038, 5082, 605, 2158, 7538, 4339,
+	4871, 2561, 6156, 4282, 5204, 4476, 3227, 4052, 7057, 121, 6503, 6557, 2029, 3760, 6299, 2804,
+	5257, 7674, 4945, 628, 1172, 2493, 4468, 1586, 135, 5001, 758, 2391, 5240, 4790, 4621, 6007,
+	6834, 4548, 93, 1075, 2325, 4692, 367, 4348, 5759, 2070, 6849, 2979, 6499, 5504, 7591, 1112,
+	1469, 5598, 5628, 7017, 2254, 2525, 443, 1715, 4979, 7373, 6287, 475, 3163, 2384, 4515, 5801,
+	7742, 3819, 5910, 7087, 583, 4307, 3625, 2505, 5460, 6165, 1647, 7803, 3100, 7883, 7290, 3468,
+	6910, 3882, 6466, 2910, 6841, 662, 1683, 5616, 3676, 3639, 6764, 4385, 3969, 6341, 4771, 3643,
+	1973, 635, 4358, 3186, 1500, 84, 3359, 7820, 1078, 3521, 6247, 1497, 5833, 1741, 4807, 533,
+	7646, 6216, 3177, 2581, 5387, 821, 2816, 7160, 2611, 2895, 3001, 7503, 7739, 4896, 4886, 1640,
+	2559, 742, 3283, 6873, 5170, 5312, 1567, 442, 2425, 1296, 4598, 1793, 7245, 2689, 4803, 7660,
+	2173, 4663, 619, 2860, 7179, 2828, 3104, 872, 997, 4273, 6888, 3402, 4066, 1794, 5606, 5084,
+	6279, 28, 2603, 54, 7684, 6362, 5888, 2993, 1979, 6141, 5448, 3832, 5786, 5089, 4724, 6596,
+	593, 795, 6750, 1223, 6354, 1491, 6836, 2305, 2717, 4213, 1916, 5365, 6259, 6208, 1797, 6228,
+	376, 1526, 3002, 4181, 3490, 1803, 2735, 422, 7362, 1175, 4172, 3487, 1040, 1219, 2867, 6482,
+	3885, 4378, 417, 700, 2378, 2303, 3393, 6934, 255, 3029, 1429, 1687, 5306, 3328, 7426, 4992,
+	2415, 3544, 4837, 7555, 4840, 2851, 3679, 3418, 5870, 2674, 1848, 1604, 2490, 1618, 7185, 4687,
+	5106, 2516, 4776, 7116, 3211, 6613, 1830, 4256, 1521, 3202, 1144, 6439, 2951, 7153, 2110, 4088,
+	437, 4822, 5406, 2146, 5151, 6234, 2423, 4862, 5620, 6091, 2169, 1799, 4016, 1972, 7497, 2926,
+	4910, 246, 5699, 5388, 5657, 4705, 3397, 1923, 5583, 4707, 1178, 5326, 1566, 5386, 4278, 5378,
+	5955, 2731, 3095, 3788, 4880, 3792, 5963, 3879, 4698, 2380, 1240, 4833, 7166, 5245, 215, 2473,
+	5731, 4712, 1132, 4373, 1436, 4774, 4919, 1695, 1401, 1387, 3019, 6294, 6135, 2869, 1442, 2224,
+	5998, 7650, 5971, 4817, 6433, 3894, 6088, 5010, 6042, 6752, 2284, 550, 5641, 4555, 5091, 2209,
+	6949, 3337, 5952, 869, 5290, 5748, 4333, 5205, 1781, 6434, 241, 2113, 4628, 334, 2986, 4543,
+	4102, 6976, 1412, 5506, 2567, 6674, 6864, 5502, 205, 3680, 3767, 737, 683, 765, 7483, 973,
+	2033, 6441, 6179, 2503, 4142, 5476, 5816, 7384, 381, 6494, 1273, 5773, 5927, 7206, 4377, 7547,
+	1019, 5493, 7598, 3347, 6691, 6809, 226, 2944, 5874, 4408, 7211, 2737, 3215, 6877, 2771, 825,
+	2912, 3830, 1862, 6537, 5195, 3658, 6323, 7378, 67, 1624, 4493, 3507, 1061, 3652, 4322, 6309,
+	4763, 338, 4296, 3076, 3256, 7113, 109, 6968, 6614, 4, 5479, 2992, 2698, 5028, 6033, 4830,
+	71, 6914, 6859, 4749, 672, 7759, 7209, 2619, 6119, 90, 1573, 1707, 7228, 2128, 6089, 5032,
+	6363, 808, 4723, 4137, 7453, 3383, 42, 7125, 1546, 6223, 1035, 863, 2877, 4978, 678, 2296,
+	7797, 2669, 7837, 321, 3549, 2941, 6579, 2115, 4765, 3822, 5466, 1177, 5092, 4092, 2715, 82,
+	3744, 7600, 3700, 7614, 55, 5803, 7123, 5596, 1996, 1823, 6935, 1765, 2529, 3331, 3936, 852,
+	1475, 6251, 5540, 5183, 7365, 6850, 5495, 4124, 5812, 5350, 2688, 3557, 3463, 3220, 86, 4653,
+	7091, 7723, 1249, 1489, 3241, 1727, 5488, 70, 2927, 6923, 2765, 2299, 6488, 2711, 2591, 1216,
+	4341, 1252, 7212, 3351, 1871, 1324, 1932, 6392, 3039, 5318, 1717, 1951, 3496, 3245, 5180, 621,
+	569, 4126, 6851, 7590, 6211, 1332, 7307, 36, 6839, 7562, 5253, 4825, 2258, 3675, 4380, 750,
+	6954, 2061, 1884, 6420, 5732, 7186, 1110, 526, 327, 7339, 5741, 4012, 7444, 4844, 7035, 3138,
+	5353, 776, 463, 7276, 6336, 2182, 7102, 4395, 4387, 3609, 210, 1121, 4928, 5777, 5114, 6011,
+	3959, 6484, 1504, 5044, 822, 7754, 1612, 1789, 3805, 7256, 7151, 7800, 1885, 4841, 2431, 4793,
+	5187, 4702, 7471, 7456, 984, 876, 7235, 7524, 810, 5711, 2375, 2027, 5959, 6300, 6002, 2135,
+	6874, 2288, 4099, 5109, 1590, 4417, 5901, 2408, 2641, 5099, 7129, 1711, 7844, 1379, 6552, 1005,
+	6019, 2713, 1910, 5061, 7513, 1229, 2365, 7581, 6265, 5299, 1753, 2476, 6791, 7001, 5609, 6600,
+	3687, 5727, 7063, 7104, 342, 1760, 1766, 337, 1416, 7124, 5264, 7825, 5012, 6956, 5196, 6272,
+	6872, 3431, 1044, 2260, 5994, 1289, 1226, 300, 2774, 688, 2949, 677, 2455, 4154, 4711, 504,
+	2880, 5122, 654, 4809, 3266, 4130, 6013, 2850, 3406, 5101, 2362, 6009, 2568, 7112, 470, 1081,
+	3664, 6696, 942, 7161, 1235, 4916, 7193, 6948, 3763, 1423, 721, 6612, 6862, 325, 7758, 5601,
+	3559, 4731, 806, 1544, 6981, 5442, 6167, 7403, 424, 3375, 2666, 5351, 5728, 5394, 207, 6706,
+	2004, 7258, 1262, 6895, 2022, 5063, 6982, 4229, 6498, 7014, 451, 6867, 1959, 3686, 446, 7147,
+	5234, 1706, 1596, 7468, 3288, 500, 3705, 1548, 935, 2205, 4061, 1352, 3083, 5698, 2732, 411,
+	1274, 5458, 361, 5815, 1494, 195, 1237, 3090, 7602, 2229, 2180, 3442, 4074, 1857, 132, 4676,
+	2028, 5383, 6942, 2227, 1832, 5630, 7641, 4500, 1281, 2592, 814, 7621, 171, 4898, 2237, 789,
+	4857, 1542, 7537, 107, 2861, 1985, 7779, 4289, 7798, 7088, 7356, 5932, 3572, 5192, 4184, 2153,
+	4454, 7741, 2901, 3106, 1750, 874, 2809, 1275, 4907, 7507, 3287, 1534, 3811, 2590, 4797, 3098,
+	4549, 1170, 4626, 2655, 2917, 2716, 6865, 7888, 4478, 6178, 4220, 4781, 6444, 1538, 5000, 2911,
+	2263, 3774, 730, 3426, 7525, 1151, 644, 4330, 6908, 782, 6182, 5233, 4617, 332, 2608, 5276,
+	6524, 4035, 2622, 6098, 6087, 1676, 4579, 4903, 1234, 6489, 6497, 2059, 6172, 4115, 5557, 3511,
+	3935, 5966, 7013, 1843, 6947, 5631, 2406, 1821, 2131, 8, 6544, 5942, 2628, 4475, 4104, 560,
+	1978, 4612, 199, 471, 3656, 7044, 3606, 7861, 3990, 6403, 3044, 2084, 4471, 1815, 1291, 7735,
+	5564, 2271, 3052, 6584, 1073, 5634, 7288, 23, 2034, 820, 7159, 6446, 3795, 970, 3003, 1559,
+	6654, 7482, 886, 715, 6064, 7514, 7546, 859, 2438, 5359, 5820, 3712, 1120, 2044, 5315, 5581,
+	847, 6106, 6822, 7658, 558, 3372, 96, 3957, 1250, 7829, 260, 4836, 2252, 5853, 1173, 2235,
+	3837, 1022, 893, 5848, 4792, 1454, 6383, 5814, 2313, 3558, 7610, 7234, 5652, 5462, 681, 5660,
+	351, 1783, 3600, 7316, 5227, 629, 5977, 2344, 6517, 6168, 5487, 740, 3536, 6173, 7016, 1041,
+	7669, 5301, 930, 3890, 961, 7588, 3581, 5007, 2843, 4496, 4131, 7441, 2109, 187, 3531, 7494,
+	3424, 5999, 3361, 7679, 933, 3031, 666, 5678, 4202, 6932, 7884, 519, 6227, 6530, 600, 2201,
+	5021, 1594, 5954, 6648, 1634, 6190, 5545, 2794, 2410, 627, 365, 3897, 3141, 5790, 1956, 1747,
+	2262, 6379, 6219, 1445, 7781, 4372, 2760, 1927, 144, 3859, 899, 3273, 3884, 5047, 6283, 5827,
+	3620, 5688, 1089, 122, 2796, 2982, 2081, 2143, 2451, 4177, 1528, 6353, 2190, 7359, 4402, 4045,
+	4211, 5559, 4986, 5176, 5134, 7632, 7711, 3745, 962, 6428, 7705, 5100, 450, 7421, 2007, 6649,
+	3432, 846, 5877, 3356, 695, 7372, 353, 7200, 1407, 7435, 4570, 6761, 943, 3979, 6306, 7077,
+	3385, 6573, 2098, 1457, 5014, 398, 3389, 7188, 3071, 4165, 1682, 4403, 6142, 1955, 1864, 3192,
+	7400, 2563, 1922, 3233, 3119, 6680, 2494, 377, 3048, 7430, 7708, 6189, 7408, 6452, 2818, 553,
+	3769, 493, 6289, 1895, 1146, 675, 1080, 6062, 6898, 1020, 707, 3210, 557, 4351, 7266, 781,
+	4009, 2359, 7080, 5337, 7808, 1333, 2699, 85, 448, 4506, 2361, 6083, 729, 2268, 2269, 956,
+	4614, 643, 2379, 3464, 2039, 4721, 3562, 651, 3595, 2938, 694, 4850, 5421, 7351, 1205, 6959,
+	2035, 396, 1385, 4882, 2498, 4004, 4738, 1186, 4022, 1678, 69, 4363, 5435, 6751, 3526, 6737,
+	2426, 684, 1241, 6176, 1835, 3410, 2642, 6024, 1261, 2314, 5767, 5692, 7249, 7499, 194, 4563,
+	5890, 2328, 4474, 7801, 3289, 227, 2122, 2050, 3965, 4359, 2504, 5251, 5633, 6060, 73, 0,
+	393, 4893, 3568, 4315, 4431, 2444, 7008, 689, 1887, 6890, 488, 3880, 474, 4668, 7447, 5094,
+	2585, 1093, 2957, 2790, 466, 7680, 4685, 3077, 3148, 5949, 6703, 6236, 4577, 3808, 5055, 6400,
+	5354, 22, 2402, 7685, 3411, 4681, 7838, 2369, 212, 3435, 2149, 1851, 3981, 7816, 7415, 637,
+	5269, 4311, 6620, 2540, 2576, 2725, 2652, 7616, 2560, 6901, 5828, 801, 5015, 2167, 2231, 6917,
+	3545, 17, 231, 3275, 4453, 434, 2400, 465, 1619, 7257, 5516, 6960, 5996, 491, 3043, 3321,
+	7701, 7331, 6989, 1652, 1877, 6302, 3522, 1419, 1957, 1970, 1196, 4787, 3475, 3084, 7886, 3570,
+	4571, 922, 5753, 1577, 3624, 2327, 2623, 964, 5291, 1433, 2358, 4980, 5275, 5319, 2108, 5049,
+	292, 2397, 3413, 6866, 3246, 3247, 5131, 2474, 5396, 5115, 7594, 2060, 7774, 5518, 18, 6578,
+	6609, 259, 2617, 931, 1693, 7540, 1719, 1691, 2311, 4353, 3200, 7724, 6894, 2283, 2898, 6402,
+	4466, 407, 4761, 1096, 3161, 3787, 62, 4079, 6345, 3197, 7664, 1318, 4085, 5016, 3702, 7344,
+	4423, 1198, 4789, 3699, 5576, 2496, 1495, 1702, 2295, 3953, 6963, 4482, 388, 7784, 2020, 4706,
+	1867, 3301, 4495, 4918, 3232, 5703, 3438, 5075, 4665, 3725, 658, 5866, 5543, 2017, 5426, 7156,
+	1942, 2545, 4745, 5689, 1187, 4067, 5303, 5175, 1259, 4418, 2468, 3853, 1277, 2964, 2855, 3646,
+	3094, 3939, 6683, 219, 1211, 845, 2633, 566, 7101, 2470, 3297, 6870, 743, 7434, 6036, 4349,
+	4448, 5376, 2821, 497, 218, 46, 438, 1232, 4039, 2078, 2678, 4047, 5313, 6465, 1549, 6925,
+	2430, 106, 3505, 6625, 333, 2668, 175, 302, 3137, 5178, 1960, 6829, 711, 3492, 3574, 594,
+	4696, 6022, 4119, 1791, 4105, 1671, 2497, 4120, 4422, 7464, 5916, 941, 856, 659, 2300, 1389,
+	6967, 6148, 2962, 7252, 4683, 5459, 6409, 6795, 1587, 4367, 4488, 7635, 7668, 1421, 3993, 6097,
+	6384, 6158, 6784, 7651, 6095, 1952, 1883, 2063, 3842, 7039, 691, 3306, 6808, 5380, 4775, 4828,
+	4176, 385, 6675, 3532, 4319, 5029, 6826, 977, 2709, 4764, 4659, 2458, 3964, 7565, 2436, 4620,
+	6581, 2435, 201, 462, 881, 6416, 6276, 5436, 6320, 1759, 3706, 57, 4230, 4198, 498, 648,
+	5682, 6720, 5248, 3066, 7619, 1157, 3741, 225, 534, 7265, 151, 1644, 5530, 4656, 2537, 4368,
+	4071, 2107, 5946, 7851, 6090, 4300, 5072, 7442, 5894, 6, 7481, 2360, 7040, 1137, 4874, 5073,
+	3319, 59, 5802, 309, 2905, 5235, 2253, 7054, 2868, 1982, 4677, 4292, 1306, 2970, 188, 6380,
+	6201, 3971, 330, 4398, 2582, 3011, 5739, 1779, 2682, 7828, 6521, 3081, 4842, 2443, 1447, 3504,
+	3812, 2139, 4561, 7490, 6437, 3046, 4030, 6695, 2535, 3695, 2233, 4520, 2847, 6618, 4144, 5783,
+	7440, 7790, 5164, 4452, 4629, 3678, 7301, 4926, 4766, 6028, 4246, 4123, 5177, 2963, 236, 6366,
+	4008, 1681, 6792, 4257, 6527, 6723, 5983, 3718, 7204, 1602, 576, 4156, 5712, 831, 6491, 1097,
+	349, 2632, 1800, 551, 5707, 291, 5899, 1947, 4355, 2773, 3150, 4531, 1997, 7734, 5472, 835,
+	4127, 5948, 6426, 4078, 268, 1264, 3419, 6246, 6698, 125, 1801, 3951, 7569, 61, 6847, 3604,
+	58, 5573, 5967, 6232, 7287, 4815, 5379, 4854, 3735, 2338, 7655, 5862, 6779, 4364, 280, 7024,
+	544, 2848, 6015, 2511, 6655, 5512, 5277, 5619, 3919, 4179, 1058, 5658, 3623, 7410, 4132, 2428,
+	5986, 5539, 4925, 4175, 4647, 839, 4415, 7294, 3856, 6818, 2491, 4109, 5701, 7830, 4623, 476,
+	999, 4270, 5137, 7289, 1441, 441, 1188, 5222, 1917, 7390, 5586, 4650, 969, 3757, 7612, 673,
+	7777, 4605, 4281, 6597, 5369, 5132, 5477, 4648, 6759, 7311, 4632, 6728, 4200, 2947, 3542, 2121,
+	2056, 2836, 1574, 395, 2562, 1482, 362, 180, 7396, 6454, 5213, 4637, 7457, 1284, 3588, 6401,
+	4624, 7740, 541, 2932, 2387, 6184, 4975, 4304, 7261, 6641, 2482, 2324, 791, 3352, 5415, 1894,
+	6902, 383, 5207, 4651, 5327, 2103, 6628, 4599, 4125, 7858, 2381, 5456, 6393, 3473, 4430, 4827,
+	5364, 1431, 3461, 7891, 6627, 6281, 1114, 2255, 7778, 4383, 6999, 25, 6282, 3858, 2626, 3272,
+	7393, 3340, 613, 6594, 2777, 7761, 6711, 5913, 3648, 7343, 2419, 1298, 5618, 5984, 5026, 6339,
+	2191, 310, 3530, 4645, 4010, 5561, 5912, 1391, 5501, 4343, 1556, 7528, 7670, 1502, 3401, 1555,
+	5413, 4551, 7309, 1051, 1931, 2706, 6063, 2218, 6783, 2368, 2896, 954, 1809, 3343, 7274, 3292,
+	3208, 2978, 703, 3546, 4759, 4965, 1150, 844, 3132, 7264, 4327, 4997, 1395, 4758, 6605, 145,
+	4399, 3160, 247, 1944, 785, 2351, 1473, 5372, 1105, 4552, 4673, 1630, 3045, 7119, 3189, 3660,
+	1380, 2702, 3379, 540, 3349, 4458, 3342, 748, 716, 6832, 5455, 2613, 1620, 1787, 556, 6298,
+	6115, 1326, 6745, 2064, 3290, 7397, 3730, 2726, 2141, 2631, 4940, 4203, 6789, 2091, 5018, 5909,
+	1263, 3786, 5766, 7303, 3513, 7269, 6447, 925, 2342, 6435, 6708, 6152, 3878, 2935, 4250, 3452,
+	98, 6523, 4457, 243, 3966, 2532, 6378, 2594, 33, 1773, 5034, 1921, 7072, 7110, 4049, 2647,
+	6541, 2956, 6101, 712, 836, 3986, 7277, 7345, 747, 3633, 3125, 6977, 1446, 4699, 3485, 5454,
+	6951, 7056, 7329, 4388, 7173, 858, 5636, 6727, 4666, 454, 2536, 2377, 1478, 6248, 983, 7772,
+	2239, 5886, 4232, 1348, 2168, 3978, 575, 1406, 4740, 5045, 7692, 2357, 1027, 7757, 1054, 3960,
+	6094, 3434, 3828, 4238, 870, 5165, 5339, 7291, 6113, 3058, 7225, 3726, 1806, 2553, 3187, 570,
+	2975, 4149, 3313, 7000, 2883, 6470, 4813, 1738, 2740, 5223, 7485, 4618, 2891, 1394, 7019, 1179,
+	4436, 549, 7243, 403, 3762, 809, 2183, 3170, 4779, 4106, 1613, 5950, 5558, 3388, 5288, 4290,
+	4308, 4697, 4284, 7811, 903, 173, 4050, 5905, 6240, 6505, 5830, 2690, 5832, 10, 4530, 1744,
+	880, 6514, 6225, 7529, 7414, 906, 182, 3455, 4025, 759, 4316, 3584, 736, 1165, 4441, 7253,
+	5307, 6993, 1427, 2854, 5509, 4251, 5355, 5521, 2069, 2332, 4858, 3344, 2764, 169, 4786, 7743,
+	787, 5324, 4993, 5656, 3742, 5145, 6855, 6996, 6794, 6861, 867, 3717, 5752, 6262, 1623, 254,
+	3550, 3030, 6278, 2492, 5023, 3433, 5478, 5747, 1488, 2762, 3330, 4917, 485, 2371, 3218, 1253,
+	6120, 2780, 1935, 1937, 5111, 888, 6038, 5603, 3846, 1067, 5332, 3437, 6342, 5869, 6546, 4909,
+	3970, 4769, 2249, 686, 6086, 938, 2057, 6753, 728, 1725, 1496, 2799, 5308, 2996, 26, 1378,
+	5615, 7557, 3685, 5925, 815, 6048, 7518, 2756, 4215, 3408, 6260, 7696, 7034, 3670, 2884, 2890,
+	2412, 4354, 4752, 2134, 4736, 51, 5340, 7376, 7689, 5079, 1070, 4904, 1888, 1337, 585, 2175,
+	2197, 4310, 7187, 6153, 3091, 6084, 6543, 1007, 3903, 4733, 16, 3489, 5873, 402, 7412, 6661,
+	2587, 1810, 2161, 622, 5729, 2579, 7653, 7571, 6144, 701, 6889, 1200, 2275, 6844, 6012, 5247,
+	6456, 7823, 1126, 6522, 1562, 1047, 1599, 3023, 4357, 713, 2280, 6700, 6852, 1164, 2864, 4608,
+	5258, 3987, 6127, 3482, 3938, 6604, 5078, 4064, 1929, 2282, 2924, 5808, 2635, 7192, 1966, 6971,
+	2322, 6081, 513, 4253, 5537, 5461, 117, 5917, 415, 4800, 2228, 6906, 1718, 1109, 2058, 832,
+	6147, 1953, 1761, 1739, 2554, 7078, 2813, 6364, 668, 4725, 6297, 1472, 4082, 6280, 7810, 3707,
+	3398, 2795, 2015, 6944, 5097, 2261, 24, 2120, 1936, 7237, 5572, 2606, 7270, 762, 3651, 7407,
+	3698, 1192, 2337, 1551, 7857, 749, 7870, 304, 5991, 4151, 235, 5840, 4091, 5669, 7744, 6284,
+	7633, 6346, 6778, 3166, 1508, 7152, 823, 5782, 4152, 2005, 6327, 3527, 4688, 5940, 4245, 6704,
+	7005, 3072, 2753, 5298, 1790, 7608, 5003, 6209, 7539, 5259, 2421, 1913, 5243, 5519, 6146, 3124,
+	5735, 1134, 179, 3280, 4970, 646, 955, 7322, 1847, 7560, 5646, 6325, 2871, 1928, 3415, 4875,
+	6187, 3995, 7183, 4607, 6264, 101, 115, 915, 1028, 4134, 2907, 1854, 4490, 877, 3829, 3025,
+	1499, 4720, 6630, 3923, 3816, 258, 1397, 6326, 2598, 6884, 5721, 4622, 5268, 6904, 1539, 147,
+	6067, 5789, 4226, 5781, 6163, 5498, 4028, 5737, 1855, 6563, 739, 1490, 1995, 7763, 7644, 4365,
+	6443, 4528, 2893, 4737, 4640, 5857, 355, 1816, 5867, 421, 3743, 7802, 3235, 7874, 7550, 7712,
+	2968, 7058, 7663, 6687, 6554, 6551, 1221, 157, 4409, 6623, 163, 3151, 1919, 3440, 2279, 1827,
+	4265, 44, 4182, 1945, 4344, 6318, 5006, 4750, 5469, 2349, 2354, 4582, 6080, 3836, 2808, 698,
+	2392, 3459, 7519, 2054, 4480, 7877, 4459, 1850, 6921, 3626, 2990, 401, 3264, 871, 6565, 478,
+	5733, 7498, 2093, 1417, 3004, 1689, 1233, 555, 4567, 882, 3213, 5271, 6242, 900, 3181, 1060,
+	1342, 3577, 3985, 5439, 4443, 7047, 6930, 4816, 7118, 3553, 3506, 2875, 2181, 1267, 631, 5212,
+	7638, 7835, 559, 39, 6860, 6567, 6374, 6876, 7506, 1621, 6357, 3096, 1006, 7865, 1315, 6293,
+	5590, 3758, 4450, 1517, 5342, 3910, 1563, 1998, 1934, 744, 7553, 7197, 7611, 5210, 1856, 3817,
+	1673, 5807, 1052, 734, 1891, 4680, 2418, 1680, 5349, 986, 3775, 3772, 5361, 4024, 4879, 5066,
+	3478, 5409, 7220, 6986, 7210, 2830, 3533, 4961, 4562, 213, 6903, 6688, 2755, 5972, 7556, 2687,
+	7807, 7053, 996, 4924, 5475, 5224, 1898, 3977, 2411, 508, 2671, 6626, 704, 6945, 5549, 5588,
+	1755, 1861, 4957, 5514, 7683, 2769, 6534, 1882, 1015, 3512, 7478, 1008, 2548, 5604, 3904, 5686,
+	3543, 5071, 5921, 1510, 3900, 3195, 614, 6438, 2776, 3481, 6955, 2569, 5821, 7281, 4576, 4760,
+	3120, 461, 229, 4147, 2188, 6188, 2903, 4393, 7131, 5338, 1492, 312, 4866, 3589, 1908, 3392,
+	5330, 1503, 5713, 1742, 1369, 1651, 1278, 2243, 4065, 6787, 4540, 357, 891, 4535, 2897, 682,
+	7688, 5702, 4077, 990, 4185, 7003, 4811, 6468, 13, 6633, 6418, 5907, 1768, 4747, 7431, 3612,
+	1349, 2213, 5639, 2974, 6252,
+}
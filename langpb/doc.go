@@ -0,0 +1,6 @@
+// Package langpb holds Protocol Buffers message types for language data written by the generator with -proto flag.
+//
+// language.proto is written by the generator with -proto-schema flag and must not be edited by hand
+package langpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative language.proto
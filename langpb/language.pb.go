@@ -0,0 +1,290 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: language.proto
+
+package langpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LanguageList holds all ISO 639-3 languages, as written by the generator with -proto flag
+type LanguageList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Languages []*Language `protobuf:"bytes,1,rep,name=languages,proto3" json:"languages,omitempty"`
+}
+
+func (x *LanguageList) Reset() {
+	*x = LanguageList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_language_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LanguageList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LanguageList) ProtoMessage() {}
+
+func (x *LanguageList) ProtoReflect() protoreflect.Message {
+	mi := &file_language_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LanguageList.ProtoReflect.Descriptor instead.
+func (*LanguageList) Descriptor() ([]byte, []int) {
+	return file_language_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LanguageList) GetLanguages() []*Language {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+// Language holds language info - all ISO 639 codes along with name and some additional info
+type Language struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Part3  string `protobuf:"bytes,1,opt,name=part3,proto3" json:"part3,omitempty"`
+	Part2B string `protobuf:"bytes,2,opt,name=part2b,proto3" json:"part2b,omitempty"`
+	Part2T string `protobuf:"bytes,3,opt,name=part2t,proto3" json:"part2t,omitempty"`
+	Part1  string `protobuf:"bytes,4,opt,name=part1,proto3" json:"part1,omitempty"`
+	// one of: I, M, S
+	Scope string `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	// one of: L, H, A, E, C, S
+	LanguageType      string `protobuf:"bytes,6,opt,name=language_type,json=languageType,proto3" json:"language_type,omitempty"`
+	Name              string `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Comment           string `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`
+	MacrolanguageCode string `protobuf:"bytes,9,opt,name=macrolanguage_code,json=macrolanguageCode,proto3" json:"macrolanguage_code,omitempty"`
+}
+
+func (x *Language) Reset() {
+	*x = Language{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_language_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Language) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Language) ProtoMessage() {}
+
+func (x *Language) ProtoReflect() protoreflect.Message {
+	mi := &file_language_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Language.ProtoReflect.Descriptor instead.
+func (*Language) Descriptor() ([]byte, []int) {
+	return file_language_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Language) GetPart3() string {
+	if x != nil {
+		return x.Part3
+	}
+	return ""
+}
+
+func (x *Language) GetPart2B() string {
+	if x != nil {
+		return x.Part2B
+	}
+	return ""
+}
+
+func (x *Language) GetPart2T() string {
+	if x != nil {
+		return x.Part2T
+	}
+	return ""
+}
+
+func (x *Language) GetPart1() string {
+	if x != nil {
+		return x.Part1
+	}
+	return ""
+}
+
+func (x *Language) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *Language) GetLanguageType() string {
+	if x != nil {
+		return x.LanguageType
+	}
+	return ""
+}
+
+func (x *Language) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Language) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Language) GetMacrolanguageCode() string {
+	if x != nil {
+		return x.MacrolanguageCode
+	}
+	return ""
+}
+
+var File_language_proto protoreflect.FileDescriptor
+
+var file_language_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x08, 0x69, 0x73, 0x6f, 0x36, 0x33, 0x39, 0x5f, 0x33, 0x22, 0x40, 0x0a, 0x0c, 0x4c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x69, 0x73, 0x6f, 0x36, 0x33, 0x39, 0x5f, 0x33, 0x2e, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67,
+	0x65, 0x52, 0x09, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x22, 0xfe, 0x01, 0x0a,
+	0x08, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72,
+	0x74, 0x33, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x74, 0x33, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x32, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x61, 0x72, 0x74, 0x32, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x74, 0x32,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x74, 0x32, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x6d, 0x61, 0x63, 0x72, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x72,
+	0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x26, 0x5a,
+	0x24, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x61, 0x72, 0x62,
+	0x61, 0x73, 0x68, 0x6f, 0x76, 0x2f, 0x69, 0x73, 0x6f, 0x36, 0x33, 0x39, 0x2d, 0x33, 0x2f, 0x6c,
+	0x61, 0x6e, 0x67, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_language_proto_rawDescOnce sync.Once
+	file_language_proto_rawDescData = file_language_proto_rawDesc
+)
+
+func file_language_proto_rawDescGZIP() []byte {
+	file_language_proto_rawDescOnce.Do(func() {
+		file_language_proto_rawDescData = protoimpl.X.CompressGZIP(file_language_proto_rawDescData)
+	})
+	return file_language_proto_rawDescData
+}
+
+var file_language_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_language_proto_goTypes = []interface{}{
+	(*LanguageList)(nil), // 0: iso639_3.LanguageList
+	(*Language)(nil),     // 1: iso639_3.Language
+}
+var file_language_proto_depIdxs = []int32{
+	1, // 0: iso639_3.LanguageList.languages:type_name -> iso639_3.Language
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_language_proto_init() }
+func file_language_proto_init() {
+	if File_language_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_language_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LanguageList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_language_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Language); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_language_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_language_proto_goTypes,
+		DependencyIndexes: file_language_proto_depIdxs,
+		MessageInfos:      file_language_proto_msgTypes,
+	}.Build()
+	File_language_proto = out.File
+	file_language_proto_rawDesc = nil
+	file_language_proto_goTypes = nil
+	file_language_proto_depIdxs = nil
+}
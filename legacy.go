@@ -0,0 +1,64 @@
+package iso639_3
+
+import "strings"
+
+// part1Aliases maps withdrawn ISO 639-1 codes to their current replacements,
+// as published in ISO 639-2 Registration Authority (Library of Congress) change history
+var part1Aliases = map[string]string{
+	"in": "id", // Indonesian, changed in 1989
+	"iw": "he", // Hebrew, changed in 1989
+	"ji": "yi", // Yiddish, changed in 1989
+	"jw": "jv", // Javanese, changed in 2001
+	"mo": "ro", // Moldavian, deprecated in favor of Romanian in 2008
+}
+
+// Part1Aliases returns mapping of withdrawn ISO 639-1 codes to current ones, e.g. "iw" to "he".
+// The returned map is a copy and may be modified by caller
+func Part1Aliases() map[string]string {
+	ret := make(map[string]string, len(part1Aliases))
+	for legacy, current := range part1Aliases {
+		ret[legacy] = current
+	}
+	return ret
+}
+
+// deprecatedCodes maps codes deprecated by ISO 639, but still present in the dataset, to ISO 639-3 codes of languages they resolve to.
+// Serbo-Croatian ISO 639-1 code "sh" was deprecated in 2000, as Bosnian, Croatian and Serbian got their own codes,
+// yet ISO 639-3 still assigns it to "hbs" macrolanguage
+var deprecatedCodes = map[string]string{
+	"sh": "hbs",
+}
+
+// IsDeprecatedCode reports whether given code is withdrawn, retired or deprecated by ISO 639, e.g. "iw" for Hebrew,
+// "mo" for Moldavian or "sh" for Serbo-Croatian. Retired ISO 639-3 codes are known only if the lookup tables
+// were generated with retirements file, see FromRetiredCode.
+// Such codes should be replaced with current ones when stored, see FromLegacyCode
+func IsDeprecatedCode(code string) bool {
+	if _, ok := deprecatedCodes[code]; ok {
+		return true
+	}
+	if _, ok := retiredCodes[code]; ok {
+		return true
+	}
+	_, ok := part1Aliases[code]
+	return ok
+}
+
+// FromLegacyCode looks up language for given code like FromAnyCode, additionally resolving withdrawn ISO 639-1 codes
+// to their replacements (see Part1Aliases), e.g. "iw" to Hebrew or "mo" to Romanian, and retired ISO 639-3 codes
+// having single replacement if the lookup tables were generated with retirements file (see FromRetiredCode).
+// Deprecated codes still present in the dataset resolve as usual, e.g. "sh" to Serbo-Croatian macrolanguage.
+// Leading and trailing whitespace is ignored. Returns nil if not found
+func FromLegacyCode(code string) *Language {
+	if l := FromAnyCode(code); l != nil {
+		return l
+	}
+	subtag := languageSubtag(strings.TrimSpace(code))
+	if current, ok := part1Aliases[subtag]; ok {
+		return FromPart1Code(current)
+	}
+	if r := FromRetiredCode(subtag); r != nil {
+		return r.Replacement()
+	}
+	return nil
+}
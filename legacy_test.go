@@ -0,0 +1,118 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestPart1Aliases(t *testing.T) {
+	tests := []struct {
+		legacy   string
+		expected string
+	}{
+		{"iw", "he"},
+		{"in", "id"},
+		{"ji", "yi"},
+		{"jw", "jv"},
+		{"mo", "ro"},
+	}
+	aliases := Part1Aliases()
+	for _, tt := range tests {
+		t.Run(tt.legacy, func(t *testing.T) {
+			actual := aliases[tt.legacy]
+
+			if actual != tt.expected {
+				t.Errorf("Part1Aliases()[%v] = %v, expected %v", tt.legacy, actual, tt.expected)
+			}
+			if FromPart1Code(actual) == nil {
+				t.Errorf("Part1Aliases()[%v] = %v, which is not a known ISO 639-1 code", tt.legacy, actual)
+			}
+		})
+	}
+
+	aliases["iw"] = "xx"
+	if Part1Aliases()["iw"] != "he" {
+		t.Errorf("Part1Aliases() returned shared map")
+	}
+}
+
+func TestFromLegacyCode(t *testing.T) {
+	useTestRetiredCodes(t)
+
+	tests := []struct {
+		code     string
+		expected string
+	}{
+		{"sh", "hbs"},
+		{"sh-RS", "hbs"},
+		{"hbs", "hbs"},
+		{"iw", "heb"},
+		{"mo", "ron"},
+		{"in-ID", "ind"},
+		{"mol", "ron"}, // retired
+		{"mol-MD", "ron"},
+		{" iw", "heb"},
+		{"mol\n", "ron"},
+		{"daf", ""}, // retired without single replacement
+		{"de", "deu"},
+		{"xx", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := ""
+			if l := FromLegacyCode(tt.code); l != nil {
+				actual = l.Part3
+			}
+
+			if actual != tt.expected {
+				t.Errorf("FromLegacyCode(%v) = %v, expected %v", tt.code, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsDeprecatedCode(t *testing.T) {
+	useTestRetiredCodes(t)
+
+	tests := map[string]bool{
+		"sh":  true,
+		"iw":  true,
+		"mol": true,
+		"ron": false,
+		"hbs": false,
+		"he":  false,
+		"sr":  false,
+	}
+	for code, expected := range tests {
+		if actual := IsDeprecatedCode(code); actual != expected {
+			t.Errorf("IsDeprecatedCode(%v) = %v, expected %v", code, actual, expected)
+		}
+	}
+
+	if l := FromAnyCode("sh"); l == nil || l.Scope != ScopeMacrolanguage {
+		t.Errorf("FromAnyCode(sh) = %v, expected Serbo-Croatian macrolanguage", l)
+	}
+}
+
+// TestLegacyCodesShipped checks documented examples against shipped lookup tables, without substituting retirements
+func TestLegacyCodesShipped(t *testing.T) {
+	for _, code := range []string{"iw", "mo", "sh"} {
+		if !IsDeprecatedCode(code) {
+			t.Errorf("IsDeprecatedCode(%v) = false, expected true", code)
+		}
+	}
+
+	tests := map[string]string{
+		"iw": "heb",
+		"mo": "ron",
+		"sh": "hbs",
+	}
+	for code, expected := range tests {
+		if l := FromLegacyCode(code); l == nil || l.Part3 != expected {
+			t.Errorf("FromLegacyCode(%v) = %v, expected Language with Part3 %v", code, l, expected)
+		}
+	}
+
+	if len(retiredCodes) == 0 && (IsDeprecatedCode("mol") || FromLegacyCode("mol") != nil) {
+		t.Errorf("retired mol is known without retirements file")
+	}
+}
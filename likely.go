@@ -0,0 +1,36 @@
+package iso639_3
+
+import "strings"
+
+// LikelyLanguage resolves language of BCP 47 tag with undetermined language subtag, like "und-CN" or "und-Cyrl",
+// to the language most likely meant, according to Unicode CLDR likely subtags: Chinese for "und-CN", Russian for "und-Cyrl".
+// Region subtag takes precedence over script subtag; combinations of both aren't taken into account.
+// Tags with determined language are resolved as usual, see FromAnyCode.
+// The result is a heuristic guess. Returns nil if tag can't be resolved, including bare "und"
+func LikelyLanguage(tag string) *Language {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 {
+		return nil
+	}
+	if primary := strings.ToLower(subtags[0]); primary != "und" {
+		return FromAnyCode(primary)
+	}
+
+	var script, region string
+	for _, subtag := range subtags[1:] {
+		switch len(subtag) {
+		case 4:
+			script = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case 2:
+			region = strings.ToUpper(subtag)
+		}
+	}
+
+	if code, ok := likelyLanguageByRegion[region]; ok {
+		return FromPart3Code(code)
+	}
+	if code, ok := likelyLanguageByScript[script]; ok {
+		return FromPart3Code(code)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestLikelyLanguage(t *testing.T) {
+	tests := []struct {
+		tag           string
+		expectedPart3 string
+	}{
+		{"und-CN", "zho"},
+		{"und-RU", "rus"},
+		{"und_de", "deu"},
+		{"und-BR", "por"},
+		{"und-Cyrl", "rus"},
+		{"und-Latn", "eng"},
+		{"und-Hans", "zho"},
+		{"und-Cyrl-DE", "deu"},
+		{"fr-CA", "fra"},
+		{"und", ""},
+		{"und-AQ", ""},
+		{"und-XX", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			actual := ""
+			if l := LikelyLanguage(tt.tag); l != nil {
+				actual = l.Part3
+			}
+
+			if actual != tt.expectedPart3 {
+				t.Errorf("LikelyLanguage(%v) = %v, expected %v", tt.tag, actual, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestLikelyLanguageTables(t *testing.T) {
+	for _, lookup := range []map[string]string{likelyLanguageByRegion, likelyLanguageByScript} {
+		for key, code := range lookup {
+			if _, ok := db().part3[code]; !ok {
+				t.Errorf("likely language of %v is unknown code %v", key, code)
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package iso639_3
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrMalformedLocale is returned by ValidateLocale for locales which are not made of language, script and region subtags
+	ErrMalformedLocale = errors.New("iso639_3: malformed locale")
+	// ErrInvalidLocaleLanguage is returned by ValidateLocale when language subtag is not an ISO 639 code
+	ErrInvalidLocaleLanguage = errors.New("iso639_3: unknown locale language")
+	// ErrInvalidLocaleScript is returned by ValidateLocale when script subtag is not an ISO 15924 code
+	ErrInvalidLocaleScript = errors.New("iso639_3: unknown locale script")
+	// ErrInvalidLocaleRegion is returned by ValidateLocale when region subtag is neither an ISO 3166-1 alpha-2 code
+	// nor a UN M.49 area code
+	ErrInvalidLocaleRegion = errors.New("iso639_3: unknown locale region")
+	// ErrUnsupportedLocaleSubtag is returned by ValidateLocale for well-formed BCP 47 variant subtags, e.g. "rozaj",
+	// which it doesn't validate
+	ErrUnsupportedLocaleSubtag = errors.New("iso639_3: unsupported locale subtag")
+)
+
+// ValidateLocale checks locale like "en", "en-US", "es-419", "zh-Hant" or "sr_Latn_RS": language subtag must be a known
+// ISO 639 code (see FromAnyCode), optional script subtag must be an ISO 15924 code and optional region subtag must be
+// an ISO 3166-1 alpha-2 code or a UN M.49 area code registered for BCP 47.
+// Subtags are case-insensitive and separated by "-" or "_".
+// Variant subtags, e.g. "rozaj" in "sl-rozaj", are reported as unsupported rather than invalid.
+// Returns nil for valid locale, otherwise error wrapping one of ErrMalformedLocale, ErrInvalidLocaleLanguage,
+// ErrInvalidLocaleScript, ErrInvalidLocaleRegion or ErrUnsupportedLocaleSubtag
+func ValidateLocale(tag string) error {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 || len(subtags) > 3 || strings.Count(tag, "-")+strings.Count(tag, "_") != len(subtags)-1 {
+		return fmt.Errorf("%w: %q", ErrMalformedLocale, tag)
+	}
+
+	language := strings.ToLower(subtags[0])
+	if n := len(language); n != 2 && n != 3 || FromAnyCode(language) == nil {
+		return fmt.Errorf("%w: %q in %q", ErrInvalidLocaleLanguage, subtags[0], tag)
+	}
+	subtags = subtags[1:]
+
+	if len(subtags) > 0 && len(subtags[0]) == 4 && isAlpha(subtags[0][0]) {
+		script := strings.ToUpper(subtags[0][:1]) + strings.ToLower(subtags[0][1:])
+		if !isISO15924Script(script) {
+			return fmt.Errorf("%w: %q in %q", ErrInvalidLocaleScript, subtags[0], tag)
+		}
+		subtags = subtags[1:]
+	}
+
+	if len(subtags) > 0 && isRegionSubtag(subtags[0]) {
+		if !iso3166Regions[strings.ToUpper(subtags[0])] && !unM49Regions[subtags[0]] {
+			return fmt.Errorf("%w: %q in %q", ErrInvalidLocaleRegion, subtags[0], tag)
+		}
+		subtags = subtags[1:]
+	}
+
+	if len(subtags) > 0 {
+		if isVariantSubtag(subtags[0]) {
+			return fmt.Errorf("%w: variant %q in %q", ErrUnsupportedLocaleSubtag, subtags[0], tag)
+		}
+		return fmt.Errorf("%w: unexpected subtag %q in %q", ErrMalformedLocale, subtags[0], tag)
+	}
+	return nil
+}
+
+// isRegionSubtag reports whether given subtag is shaped as BCP 47 region: two letters or three digits
+func isRegionSubtag(subtag string) bool {
+	switch len(subtag) {
+	case 2:
+		return isAlpha(subtag[0]) && isAlpha(subtag[1])
+	case 3:
+		return isDigit(subtag[0]) && isDigit(subtag[1]) && isDigit(subtag[2])
+	}
+	return false
+}
+
+// isVariantSubtag reports whether given subtag is shaped as BCP 47 variant: five to eight letters or digits,
+// or four starting with a digit
+func isVariantSubtag(subtag string) bool {
+	if len(subtag) < 4 || len(subtag) > 8 || len(subtag) == 4 && !isDigit(subtag[0]) {
+		return false
+	}
+	for i := 0; i < len(subtag); i++ {
+		if !isAlpha(subtag[i]) && !isDigit(subtag[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func isAlpha(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,72 @@
+package iso639_3
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateLocale(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected error
+	}{
+		{"en", nil},
+		{"en-US", nil},
+		{"eng_us", nil},
+		{"zh-Hant", nil},
+		{"zh-hant-tw", nil},
+		{"sr_Latn_RS", nil},
+		{"de-Qaab", nil},
+		{"", ErrMalformedLocale},
+		{"en--US", ErrMalformedLocale},
+		{"en-US-", ErrMalformedLocale},
+		{"en-Latn-US-x", ErrMalformedLocale},
+		{"en-US-Latn", ErrMalformedLocale},
+		{"es-419", nil},
+		{"en_001", nil},
+		{"zh-Hans-030", nil},
+		{"en-posix", ErrUnsupportedLocaleSubtag},
+		{"sl-rozaj", ErrUnsupportedLocaleSubtag},
+		{"de-DE-1996", ErrUnsupportedLocaleSubtag},
+		{"de-1996", ErrUnsupportedLocaleSubtag},
+		{"en-US-abc", ErrMalformedLocale},
+		{"en-U$", ErrMalformedLocale},
+		{"xx-US", ErrInvalidLocaleLanguage},
+		{"english", ErrInvalidLocaleLanguage},
+		{"en-Abcd", ErrInvalidLocaleScript},
+		{"en-Qabz", ErrInvalidLocaleScript},
+		{"en-XX", ErrInvalidLocaleRegion},
+		{"en-UK", ErrInvalidLocaleRegion},
+		{"en-840", ErrInvalidLocaleRegion},
+		{"en-999", ErrInvalidLocaleRegion},
+		{"zh-Hans-ZZ", ErrInvalidLocaleRegion},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			actual := ValidateLocale(tt.tag)
+			if tt.expected == nil && actual != nil || !errors.Is(actual, tt.expected) {
+				t.Errorf("ValidateLocale(%q) = %v, expected %v", tt.tag, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocaleTables(t *testing.T) {
+	if len(iso3166Regions) != 249 {
+		t.Errorf("iso3166Regions has %d codes, expected 249", len(iso3166Regions))
+	}
+	if len(unM49Regions) != 31 {
+		t.Errorf("unM49Regions has %d codes, expected 31", len(unM49Regions))
+	}
+	for region := range regionDefaultLanguages {
+		if !iso3166Regions[region] {
+			t.Errorf("regionDefaultLanguages has %q, which is not an ISO 3166-1 code", region)
+		}
+	}
+	for _, script := range []string{"Latn", "Cyrl", "Hans", "Hant", "Arab", "Zyyy"} {
+		if !isISO15924Script(script) {
+			t.Errorf("isISO15924Script(%q) = false, expected true", script)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+//go:build go1.21
+// +build go1.21
+
+package iso639_3
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer, so structured logs carry only ISO639-3 code and reference name of the language
+// instead of all its details, e.g. {"code":"rus","name":"Russian"}
+func (l Language) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("code", l.Part3), slog.String("name", l.Name))
+}
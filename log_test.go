@@ -0,0 +1,40 @@
+//go:build go1.21
+// +build go1.21
+
+package iso639_3
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLanguage_LogValue(t *testing.T) {
+	buf := bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("request", "language", *FromPart3Code("rus"))
+
+	expected := `{"level":"INFO","msg":"request","language":{"code":"rus","name":"Russian"}}`
+	if actual := strings.TrimSpace(buf.String()); actual != expected {
+		t.Errorf("logged %s, expected %s", actual, expected)
+	}
+}
+
+func TestLanguage_LogValueAllocs(t *testing.T) {
+	l := *FromPart3Code("rus")
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = l.LogValue()
+	})
+	if allocs > 1 {
+		t.Errorf("LogValue() allocs = %v, expected at most 1", allocs)
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !iso639_mph
+// +build !iso639_mph
+
+package iso639_3
+
+// lookupPart3 looks up language by ISO 639-3 code in index built from LanguagesPart3 map.
+// Build with iso639_mph tag to use generated minimal perfect hash instead.
+// Returns nil if not found
+func lookupPart3(code string) *Language {
+	return db().part3Index[code]
+}
@@ -0,0 +1,97 @@
+package iso639_3
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// MacroIndex holds macrolanguage mappings loaded with LoadMacrolanguages, independently of the embedded dataset
+type MacroIndex struct {
+	members         map[string][]string
+	macrolanguageOf map[string]string
+}
+
+// newTSVReader returns reader of tab-separated file in iso639-3.sil.org format
+func newTSVReader(r io.Reader) *csv.Reader {
+	tr := csv.NewReader(r)
+	tr.Comma = '\t'
+	tr.LazyQuotes = true
+	return tr
+}
+
+// headerColumns returns indices of given column names in header, reporting whether all of them are found
+func headerColumns(header []string, names ...string) ([]int, bool) {
+	ret := make([]int, len(names))
+	for i, name := range names {
+		ret[i] = -1
+		for j, column := range header {
+			if column == name {
+				ret[i] = j
+			}
+		}
+		if ret[i] < 0 {
+			return nil, false
+		}
+	}
+	return ret, true
+}
+
+// LoadMacrolanguages reads macrolanguage mappings file in tab-separated iso639-3.sil.org format
+// (iso-639-3-macrolanguages.tab, with "M_Id", "I_Id" and "I_Status" columns). Retired members are skipped
+func LoadMacrolanguages(r io.Reader) (*MacroIndex, error) {
+	tr := newTSVReader(r)
+
+	header, err := tr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("iso639_3: can't read macrolanguages header: %w", err)
+	}
+	cols, ok := headerColumns(header, "M_Id", "I_Id", "I_Status")
+	if !ok {
+		return nil, fmt.Errorf("iso639_3: macrolanguages header %v lacks M_Id, I_Id or I_Status column", header)
+	}
+	macroCol, memberCol, statusCol := cols[0], cols[1], cols[2]
+
+	idx := &MacroIndex{members: map[string][]string{}, macrolanguageOf: map[string]string{}}
+	for {
+		record, err := tr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("iso639_3: can't read macrolanguages: %w", err)
+		}
+		if record[statusCol] != "A" {
+			continue
+		}
+
+		macro, member := record[macroCol], record[memberCol]
+		idx.members[macro] = append(idx.members[macro], member)
+		idx.macrolanguageOf[member] = macro
+	}
+
+	for _, members := range idx.members {
+		sort.Strings(members)
+	}
+	return idx, nil
+}
+
+// Members returns sorted ISO 639-3 codes of members of given macrolanguage.
+// Returns nil if the code is not a macrolanguage with members
+func (idx *MacroIndex) Members(macro string) []string {
+	members, ok := idx.members[macro]
+	if !ok {
+		return nil
+	}
+
+	ret := make([]string, len(members))
+	copy(ret, members)
+	return ret
+}
+
+// Macrolanguage returns ISO 639-3 code of macrolanguage given language is a member of.
+// Returns empty string if it's not a member of any macrolanguage
+func (idx *MacroIndex) Macrolanguage(code string) string {
+	return idx.macrolanguageOf[code]
+}
@@ -0,0 +1,67 @@
+package iso639_3
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const macrolanguagesFixture = "M_Id\tI_Id\tI_Status\r\n" +
+	"aka\tfat\tA\r\n" +
+	"aka\ttwi\tA\r\n" +
+	"est\tvro\tA\r\n" +
+	"est\tekk\tA\r\n" +
+	"zho\tcmn\tA\r\n" +
+	"zho\tcdo\tA\r\n" +
+	"zho\tcjy\tR\r\n"
+
+func TestLoadMacrolanguages(t *testing.T) {
+	idx, err := LoadMacrolanguages(strings.NewReader(macrolanguagesFixture))
+	if err != nil {
+		t.Fatalf("LoadMacrolanguages() error = %v", err)
+	}
+
+	members := []struct {
+		macro    string
+		expected []string
+	}{
+		{"aka", []string{"fat", "twi"}},
+		{"est", []string{"ekk", "vro"}},
+		{"zho", []string{"cdo", "cmn"}},
+		{"eng", nil},
+	}
+	for _, tt := range members {
+		t.Run(tt.macro, func(t *testing.T) {
+			if actual := idx.Members(tt.macro); !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("Members(%q) = %v, expected %v", tt.macro, actual, tt.expected)
+			}
+		})
+	}
+
+	macros := map[string]string{
+		"twi": "aka",
+		"cmn": "zho",
+		"cjy": "", // retired
+		"eng": "",
+	}
+	for code, expected := range macros {
+		if actual := idx.Macrolanguage(code); actual != expected {
+			t.Errorf("Macrolanguage(%q) = %q, expected %q", code, actual, expected)
+		}
+	}
+}
+
+func TestLoadMacrolanguagesErrors(t *testing.T) {
+	tests := map[string]string{
+		"empty":          "",
+		"missing column": "M_Id\tI_Id\r\naka\ttwi\r\n",
+		"short record":   "M_Id\tI_Id\tI_Status\r\naka\ttwi\r\n",
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := LoadMacrolanguages(strings.NewReader(input)); err == nil {
+				t.Errorf("LoadMacrolanguages() error = nil, expected error")
+			}
+		})
+	}
+}
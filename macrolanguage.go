@@ -0,0 +1,101 @@
+package iso639_3
+
+import (
+	"encoding/csv"
+	"io"
+	"sort"
+)
+
+// MacrolanguageCodes returns sorted ISO 639-3 codes of all macrolanguages having members
+func MacrolanguageCodes() []string {
+	ret := make([]string, 0, len(macrolanguageMembers))
+	for macro := range macrolanguageMembers {
+		ret = append(ret, macro)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Members returns individual languages under macrolanguage with given ISO 639-3 code, sorted by ISO 639-3 code,
+// e.g. Mandarin Chinese (cmn) and Yue Chinese (yue) among others for Chinese (zho).
+// Returns empty non-nil slice for codes which are not macrolanguages
+func Members(macro string) []Language {
+	codes := macrolanguageMembers[macro]
+	ret := make([]Language, 0, len(codes))
+	for _, code := range codes {
+		if l, ok := db().part3[code]; ok {
+			ret = append(ret, l)
+		}
+	}
+	return ret
+}
+
+// Macrolanguage looks up macrolanguage the individual language with given ISO 639-3 code is a member of,
+// e.g. Chinese (zho) for Mandarin Chinese (cmn). Returned language is shared by all callers and must not be modified.
+// Returns nil if the code is unknown or the language is not a member of any macrolanguage
+func Macrolanguage(code string) *Language {
+	l := FromPart3Code(code)
+	if l == nil {
+		return nil
+	}
+	return l.Macrolanguage()
+}
+
+// Macrolanguage returns macrolanguage the language is a member of, see MacrolanguageCode.
+// Returned language is shared by all callers and must not be modified.
+// Returns nil if the language is not a member of any macrolanguage
+func (l Language) Macrolanguage() *Language {
+	if l.MacrolanguageCode == "" {
+		return nil
+	}
+	return FromPart3Code(l.MacrolanguageCode)
+}
+
+// MemberIndex returns position of the language among members of its macrolanguage sorted by ISO 639-3 code,
+// or -1 if the language is not a member of any macrolanguage
+func (l Language) MemberIndex() int {
+	for i, member := range macrolanguageMembers[l.MacrolanguageCode] {
+		if member == l.Part3 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Parent returns the language one level up in hierarchy for breadcrumb-like display.
+// Currently it's the macrolanguage the language is a member of, as language family (ISO 639-5) data is not available.
+// Should family data be added, macrolanguage will still take precedence over family.
+// Returns nil if there's no parent
+func (l Language) Parent() *Language {
+	return l.Macrolanguage()
+}
+
+// WriteMacrolanguagesCSV writes macrolanguage mappings as CSV with "macrolanguage,member" header
+// followed by one row of ISO 639-3 codes per member. Rows are sorted by macrolanguage, then by member
+func WriteMacrolanguagesCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	err := cw.Write([]string{"macrolanguage", "member"})
+	if err != nil {
+		return err
+	}
+
+	for _, macro := range MacrolanguageCodes() {
+		for _, member := range macrolanguageMembers[macro] {
+			err = cw.Write([]string{macro, member})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// IsSoleMember checks whether the language is the only member of its macrolanguage.
+// Returns false for languages which are not members of any macrolanguage.
+// Current dataset has no single-member macrolanguages
+func (l Language) IsSoleMember() bool {
+	return l.MacrolanguageCode != "" && len(macrolanguageMembers[l.MacrolanguageCode]) == 1
+}
@@ -0,0 +1,221 @@
+package iso639_3
+
+import (
+	"bytes"
+	"encoding/csv"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMacrolanguageCodes(t *testing.T) {
+	actual := MacrolanguageCodes()
+
+	if !sort.StringsAreSorted(actual) {
+		t.Errorf("MacrolanguageCodes() is not sorted: %v", actual)
+	}
+	for _, code := range []string{"zho", "ara"} {
+		i := sort.SearchStrings(actual, code)
+		if i == len(actual) || actual[i] != code {
+			t.Errorf("MacrolanguageCodes() = %v, expected to contain %v", actual, code)
+		}
+	}
+
+	actual[0] = "xxx"
+	if MacrolanguageCodes()[0] == "xxx" {
+		t.Errorf("MacrolanguageCodes() returned shared slice")
+	}
+}
+
+func TestMacrolanguageData(t *testing.T) {
+	for macro, members := range macrolanguageMembers {
+		if l := FromPart3Code(macro); l == nil || l.Scope != ScopeMacrolanguage {
+			t.Errorf("macrolanguageMembers key %v is not a macrolanguage: %v", macro, l)
+		}
+		for _, member := range members {
+			if l := FromPart3Code(member); l == nil || l.Scope != ScopeIndividual {
+				t.Errorf("macrolanguageMembers[%v] contains %v which is not an individual language: %v", macro, member, l)
+			}
+		}
+	}
+}
+
+func TestMembers(t *testing.T) {
+	members := Members("zho")
+	if len(members) != len(macrolanguageMembers["zho"]) {
+		t.Fatalf("Members() returned %d languages, expected %d", len(members), len(macrolanguageMembers["zho"]))
+	}
+	found := map[string]bool{}
+	for i, l := range members {
+		found[l.Part3] = true
+		if l.MacrolanguageCode != "zho" {
+			t.Errorf("Members() = %v, expected members of zho only", members)
+		}
+		if i > 0 && members[i-1].Part3 >= l.Part3 {
+			t.Errorf("Members() = %v, expected to be sorted by ISO 639-3 code", members)
+		}
+	}
+	if !found["cmn"] || !found["yue"] {
+		t.Errorf("Members() = %v, expected to contain cmn and yue", members)
+	}
+
+	for _, code := range []string{"cmn", "rus", "zh", "xxx", ""} {
+		if actual := Members(code); actual == nil || len(actual) != 0 {
+			t.Errorf("Members(%q) = %#v, expected empty non-nil slice", code, actual)
+		}
+	}
+}
+
+func TestMacrolanguage(t *testing.T) {
+	tests := []struct {
+		code          string
+		expectedPart3 string
+	}{
+		{"cmn", "zho"},
+		{"yue", "zho"},
+		{"arz", "ara"},
+		{"zho", ""},
+		{"rus", ""},
+		{"xxx", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := Macrolanguage(tt.code)
+
+			if tt.expectedPart3 == "" {
+				if actual != nil {
+					t.Errorf("Macrolanguage() = %v, expected nil", actual)
+				}
+			} else if actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("Macrolanguage() = %v, expected Language with Part3 %v", actual, tt.expectedPart3)
+			}
+		})
+	}
+
+	for _, macro := range MacrolanguageCodes() {
+		for _, l := range Members(macro) {
+			if actual := l.Macrolanguage(); actual == nil || actual.Part3 != macro {
+				t.Errorf("Macrolanguage() of %v = %v, expected Language with Part3 %v", l, actual, macro)
+			}
+		}
+	}
+}
+
+func TestLanguage_MemberIndex(t *testing.T) {
+	tests := []struct {
+		part3    string
+		expected int
+	}{
+		{"cdo", 0},
+		{"cmn", 2},
+		{"yue", 15},
+		{"zho", -1}, // macrolanguage itself
+		{"rus", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			if actual := FromPart3Code(tt.part3).MemberIndex(); actual != tt.expected {
+				t.Errorf("MemberIndex() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLanguage_Parent(t *testing.T) {
+	tests := []struct {
+		part3         string
+		expectedPart3 string
+	}{
+		{"cmn", "zho"},
+		{"arz", "ara"},
+		{"zho", ""},
+		{"rus", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			actual := FromPart3Code(tt.part3).Parent()
+
+			if tt.expectedPart3 == "" {
+				if actual != nil {
+					t.Errorf("Parent() = %v, expected nil", actual)
+				}
+			} else if actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("Parent() = %v, expected Language with Part3 %v", actual, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestWriteMacrolanguagesCSV(t *testing.T) {
+	buf := bytes.Buffer{}
+	if err := WriteMacrolanguagesCSV(&buf); err != nil {
+		t.Fatalf("WriteMacrolanguagesCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("WriteMacrolanguagesCSV() produced invalid CSV: %v", err)
+	}
+
+	total := 0
+	for _, members := range macrolanguageMembers {
+		total += len(members)
+	}
+	if len(records) != total+1 {
+		t.Errorf("WriteMacrolanguagesCSV() produced %v records, expected %v", len(records), total+1)
+	}
+	if !reflect.DeepEqual(records[:3], [][]string{{"macrolanguage", "member"}, {"aka", "fat"}, {"aka", "twi"}}) {
+		t.Errorf("WriteMacrolanguagesCSV() starts with %v", records[:3])
+	}
+	for i := 2; i < len(records); i++ {
+		if records[i-1][0] > records[i][0] || records[i-1][0] == records[i][0] && records[i-1][1] >= records[i][1] {
+			t.Errorf("WriteMacrolanguagesCSV() is not sorted at %v", records[i])
+		}
+	}
+}
+
+func TestLanguage_IsSoleMember(t *testing.T) {
+	tests := []struct {
+		part3    string
+		expected bool
+	}{
+		{"cmn", false}, // multi-member
+		{"zho", false}, // macrolanguage itself
+		{"rus", false}, // not a member
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			if actual := FromPart3Code(tt.part3).IsSoleMember(); actual != tt.expected {
+				t.Errorf("IsSoleMember() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+
+	// no single-member macrolanguages in data, so fake one
+	defer func(members map[string][]string) {
+		macrolanguageMembers = members
+	}(macrolanguageMembers)
+	macrolanguageMembers = map[string][]string{"zho": {"cmn"}}
+
+	if !FromPart3Code("cmn").IsSoleMember() {
+		t.Errorf("IsSoleMember() = false for the only member")
+	}
+}
+
+func TestLanguage_MacrolanguageCode(t *testing.T) {
+	if actual := FromPart3Code("cmn").MacrolanguageCode; actual != "zho" {
+		t.Errorf("MacrolanguageCode = %q, expected %q", actual, "zho")
+	}
+	if actual := FromPart1Code("zh").MacrolanguageCode; actual != "" {
+		t.Errorf("MacrolanguageCode of macrolanguage = %q, expected empty", actual)
+	}
+
+	for macro, members := range macrolanguageMembers {
+		for _, member := range members {
+			if actual := db().part3[member].MacrolanguageCode; actual != macro {
+				t.Errorf("MacrolanguageCode of %v = %q, expected %q", member, actual, macro)
+			}
+		}
+	}
+}
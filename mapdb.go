@@ -0,0 +1,13 @@
+//go:build !iso639_blob
+// +build !iso639_blob
+
+package iso639_3
+
+// mapTables holds tables built from lookup tables generated as map literals
+var mapTables = newTables(sortLanguages(LanguagesPart3), LanguagesPart3, LanguagesPart2, LanguagesPart1)
+
+// db returns lookup tables of the embedded dataset.
+// Build with iso639_blob tag to load them from packed binary data on first use instead
+func db() *tables {
+	return mapTables
+}
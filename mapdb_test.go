@@ -0,0 +1,17 @@
+//go:build !iso639_blob
+// +build !iso639_blob
+
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMapLookupTables(t *testing.T) {
+	part3, part2, part1 := LanguageTables()
+
+	if !reflect.DeepEqual(part3, LanguagesPart3) || !reflect.DeepEqual(part2, LanguagesPart2) || !reflect.DeepEqual(part1, LanguagesPart1) {
+		t.Errorf("LanguageTables() differ from LanguagesPart3, LanguagesPart2 and LanguagesPart1")
+	}
+}
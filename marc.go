@@ -0,0 +1,56 @@
+package iso639_3
+
+// marcCodes is the set of current codes from MARC Code List for Languages maintained by the Library of Congress
+// (https://www.loc.gov/marc/languages/). MARC codes mostly match ISO 639-2 bibliographic codes, but MARC includes
+// ISO 639-2 collective codes, doesn't include terminology codes and the "qaa".."qtz" local use range,
+// and keeps discontinued codes (e.g. "scc" for Serbian) which are not considered current here
+var marcCodes = map[string]bool{
+	"aar": true, "abk": true, "ace": true, "ach": true, "ada": true, "ady": true, "afa": true, "afh": true, "afr": true, "ain": true, "aka": true, "akk": true,
+	"alb": true, "ale": true, "alg": true, "alt": true, "amh": true, "ang": true, "anp": true, "apa": true, "ara": true, "arc": true, "arg": true, "arm": true,
+	"arn": true, "arp": true, "art": true, "arw": true, "asm": true, "ast": true, "ath": true, "aus": true, "ava": true, "ave": true, "awa": true, "aym": true,
+	"aze": true, "bad": true, "bai": true, "bak": true, "bal": true, "bam": true, "ban": true, "baq": true, "bas": true, "bat": true, "bej": true, "bel": true,
+	"bem": true, "ben": true, "ber": true, "bho": true, "bik": true, "bin": true, "bis": true, "bla": true, "bnt": true, "bos": true, "bra": true, "bre": true,
+	"btk": true, "bua": true, "bug": true, "bul": true, "bur": true, "byn": true, "cad": true, "cai": true, "car": true, "cat": true, "cau": true, "ceb": true,
+	"cel": true, "cha": true, "chb": true, "che": true, "chg": true, "chi": true, "chk": true, "chm": true, "chn": true, "cho": true, "chp": true, "chr": true,
+	"chu": true, "chv": true, "chy": true, "cmc": true, "cnr": true, "cop": true, "cor": true, "cos": true, "cpe": true, "cpf": true, "cpp": true, "cre": true,
+	"crh": true, "crp": true, "csb": true, "cus": true, "cze": true, "dak": true, "dan": true, "dar": true, "day": true, "del": true, "den": true, "dgr": true,
+	"din": true, "div": true, "doi": true, "dra": true, "dsb": true, "dua": true, "dum": true, "dut": true, "dyu": true, "dzo": true, "efi": true, "egy": true,
+	"eka": true, "elx": true, "eng": true, "enm": true, "epo": true, "est": true, "ewe": true, "ewo": true, "fan": true, "fao": true, "fat": true, "fij": true,
+	"fil": true, "fin": true, "fiu": true, "fon": true, "fre": true, "frm": true, "fro": true, "frr": true, "frs": true, "fry": true, "ful": true, "fur": true,
+	"gaa": true, "gay": true, "gba": true, "gem": true, "geo": true, "ger": true, "gez": true, "gil": true, "gla": true, "gle": true, "glg": true, "glv": true,
+	"gmh": true, "goh": true, "gon": true, "gor": true, "got": true, "grb": true, "grc": true, "gre": true, "grn": true, "gsw": true, "guj": true, "gwi": true,
+	"hai": true, "hat": true, "hau": true, "haw": true, "heb": true, "her": true, "hil": true, "him": true, "hin": true, "hit": true, "hmn": true, "hmo": true,
+	"hrv": true, "hsb": true, "hun": true, "hup": true, "iba": true, "ibo": true, "ice": true, "ido": true, "iii": true, "ijo": true, "iku": true, "ile": true,
+	"ilo": true, "ina": true, "inc": true, "ind": true, "ine": true, "inh": true, "ipk": true, "ira": true, "iro": true, "ita": true, "jav": true, "jbo": true,
+	"jpn": true, "jpr": true, "jrb": true, "kaa": true, "kab": true, "kac": true, "kal": true, "kam": true, "kan": true, "kar": true, "kas": true, "kau": true,
+	"kaw": true, "kaz": true, "kbd": true, "kha": true, "khi": true, "khm": true, "kho": true, "kik": true, "kin": true, "kir": true, "kmb": true, "kok": true,
+	"kom": true, "kon": true, "kor": true, "kos": true, "kpe": true, "krc": true, "krl": true, "kro": true, "kru": true, "kua": true, "kum": true, "kur": true,
+	"kut": true, "lad": true, "lah": true, "lam": true, "lao": true, "lat": true, "lav": true, "lez": true, "lim": true, "lin": true, "lit": true, "lol": true,
+	"loz": true, "ltz": true, "lua": true, "lub": true, "lug": true, "lui": true, "lun": true, "luo": true, "lus": true, "mac": true, "mad": true, "mag": true,
+	"mah": true, "mai": true, "mak": true, "mal": true, "man": true, "mao": true, "map": true, "mar": true, "mas": true, "may": true, "mdf": true, "mdr": true,
+	"men": true, "mga": true, "mic": true, "min": true, "mis": true, "mkh": true, "mlg": true, "mlt": true, "mnc": true, "mni": true, "mno": true, "moh": true,
+	"mon": true, "mos": true, "mul": true, "mun": true, "mus": true, "mwl": true, "mwr": true, "myn": true, "myv": true, "nah": true, "nai": true, "nap": true,
+	"nau": true, "nav": true, "nbl": true, "nde": true, "ndo": true, "nds": true, "nep": true, "new": true, "nia": true, "nic": true, "niu": true, "nno": true,
+	"nob": true, "nog": true, "non": true, "nor": true, "nqo": true, "nso": true, "nub": true, "nwc": true, "nya": true, "nym": true, "nyn": true, "nyo": true,
+	"nzi": true, "oci": true, "oji": true, "ori": true, "orm": true, "osa": true, "oss": true, "ota": true, "oto": true, "paa": true, "pag": true, "pal": true,
+	"pam": true, "pan": true, "pap": true, "pau": true, "peo": true, "per": true, "phi": true, "phn": true, "pli": true, "pol": true, "pon": true, "por": true,
+	"pra": true, "pro": true, "pus": true, "que": true, "raj": true, "rap": true, "rar": true, "roa": true, "roh": true, "rom": true, "rum": true, "run": true,
+	"rup": true, "rus": true, "sad": true, "sag": true, "sah": true, "sai": true, "sal": true, "sam": true, "san": true, "sas": true, "sat": true, "scn": true,
+	"sco": true, "sel": true, "sem": true, "sga": true, "sgn": true, "shn": true, "sid": true, "sin": true, "sio": true, "sit": true, "sla": true, "slo": true,
+	"slv": true, "sma": true, "sme": true, "smi": true, "smj": true, "smn": true, "smo": true, "sms": true, "sna": true, "snd": true, "snk": true, "sog": true,
+	"som": true, "son": true, "sot": true, "spa": true, "srd": true, "srn": true, "srp": true, "srr": true, "ssa": true, "ssw": true, "suk": true, "sun": true,
+	"sus": true, "sux": true, "swa": true, "swe": true, "syc": true, "syr": true, "tah": true, "tai": true, "tam": true, "tat": true, "tel": true, "tem": true,
+	"ter": true, "tet": true, "tgk": true, "tgl": true, "tha": true, "tib": true, "tig": true, "tir": true, "tiv": true, "tkl": true, "tlh": true, "tli": true,
+	"tmh": true, "tog": true, "ton": true, "tpi": true, "tsi": true, "tsn": true, "tso": true, "tuk": true, "tum": true, "tup": true, "tur": true, "tut": true,
+	"tvl": true, "twi": true, "tyv": true, "udm": true, "uga": true, "uig": true, "ukr": true, "umb": true, "und": true, "urd": true, "uzb": true, "vai": true,
+	"ven": true, "vie": true, "vol": true, "vot": true, "wak": true, "wal": true, "war": true, "was": true, "wel": true, "wen": true, "wln": true, "wol": true,
+	"xal": true, "xho": true, "yao": true, "yap": true, "yid": true, "yor": true, "ypk": true, "zap": true, "zbl": true, "zen": true, "zgh": true, "zha": true,
+	"znd": true, "zul": true, "zun": true, "zxx": true, "zza": true,
+}
+
+// IsStrictMARCCode checks whether given code is a current code in MARC Code List for Languages.
+// Unlike checking against ISO 639-2, terminology codes like "deu" and local use codes like "qaa" are rejected,
+// while collective codes like "sla" are accepted
+func IsStrictMARCCode(code string) bool {
+	return marcCodes[code]
+}
@@ -0,0 +1,28 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestIsStrictMARCCode(t *testing.T) {
+	tests := []struct {
+		code     string
+		expected bool
+	}{
+		{"ger", true},
+		{"rus", true},
+		{"sla", true},  // collective, not in ISO 639-3
+		{"deu", false}, // ISO 639-2 terminology code
+		{"qaa", false}, // ISO 639-2 local use
+		{"scc", false}, // discontinued
+		{"de", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if actual := IsStrictMARCCode(tt.code); actual != tt.expected {
+				t.Errorf("IsStrictMARCCode() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+//go:build iso639_mph
+// +build iso639_mph
+
+package iso639_3
+
+// lookupPart3 looks up language by ISO 639-3 code using generated minimal perfect hash instead of LanguagesPart3 map.
+// Returns nil if not found
+func lookupPart3(code string) *Language {
+	b := mphHash(code, 0) % uint32(len(part3MPHSeeds))
+	slot := mphHash(code, part3MPHSeeds[b]) % uint32(len(part3MPHIndices))
+
+	l := &db().byPart3[part3MPHIndices[slot]]
+	if l.Part3 != code {
+		return nil
+	}
+	return l
+}
+
+// mphHash is 32-bit FNV-1a hash mixed with seed. Must be kept in sync with its copy in generator
+func mphHash(s string, seed uint32) uint32 {
+	h := uint32(2166136261) ^ seed
+	for i := 0; i < len(s); i++ {
+		h ^= uint32(s[i])
+		h *= 16777619
+	}
+	return h
+}
@@ -0,0 +1,26 @@
+//go:build iso639_mph
+// +build iso639_mph
+
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestLookupPart3MPH(t *testing.T) {
+	if len(part3MPHIndices) != len(db().part3) {
+		t.Fatalf("minimal perfect hash has %v slots, expected %v", len(part3MPHIndices), len(db().part3))
+	}
+
+	for code, expected := range db().part3 {
+		if actual := lookupPart3(code); actual == nil || *actual != expected {
+			t.Errorf("lookupPart3(%v) = %v, expected %v", code, actual, expected)
+		}
+	}
+
+	for _, code := range []string{"", "123", "xxx", "RUS", "rus ", "russian"} {
+		if actual := lookupPart3(code); actual != nil {
+			t.Errorf("lookupPart3(%q) = %v, expected not found", code, actual)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package iso639_3
+
+import "fmt"
+
+// MustFromPart3Code is like FromPart3Code but panics if the code is unknown.
+// It simplifies initialization of variables and test fixtures holding codes known to be valid
+func MustFromPart3Code(code string) Language {
+	return mustFind(FromPart3Code(code), "part3 code", code)
+}
+
+// MustFromPart2Code is like FromPart2Code but panics if the code is unknown
+func MustFromPart2Code(code string) Language {
+	return mustFind(FromPart2Code(code), "part2 code", code)
+}
+
+// MustFromPart1Code is like FromPart1Code but panics if the code is unknown
+func MustFromPart1Code(code string) Language {
+	return mustFind(FromPart1Code(code), "part1 code", code)
+}
+
+// MustFromAnyCode is like FromAnyCode but panics if the code is unknown
+func MustFromAnyCode(code string) Language {
+	return mustFind(FromAnyCode(code), "code", code)
+}
+
+func mustFind(l *Language, kind, code string) Language {
+	if l == nil {
+		panic(fmt.Sprintf("iso639_3: unknown %s %q", kind, code))
+	}
+	return *l
+}
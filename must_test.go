@@ -0,0 +1,38 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestMust(t *testing.T) {
+	tests := []struct {
+		name          string
+		must          func(string) Language
+		code          string
+		expectedPart3 string
+		expectedPanic string
+	}{
+		{"MustFromPart3Code", MustFromPart3Code, "rus", "rus", ""},
+		{"MustFromPart3Code", MustFromPart3Code, "xxx", "", `iso639_3: unknown part3 code "xxx"`},
+		{"MustFromPart2Code", MustFromPart2Code, "ger", "deu", ""},
+		{"MustFromPart2Code", MustFromPart2Code, "cmn", "", `iso639_3: unknown part2 code "cmn"`},
+		{"MustFromPart1Code", MustFromPart1Code, "de", "deu", ""},
+		{"MustFromPart1Code", MustFromPart1Code, "qq", "", `iso639_3: unknown part1 code "qq"`},
+		{"MustFromAnyCode", MustFromAnyCode, "en-US", "eng", ""},
+		{"MustFromAnyCode", MustFromAnyCode, "", "", `iso639_3: unknown code ""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"/"+tt.code, func(t *testing.T) {
+			defer func() {
+				actual, _ := recover().(string)
+				if actual != tt.expectedPanic {
+					t.Errorf("%s(%q) panicked with %q, expected %q", tt.name, tt.code, actual, tt.expectedPanic)
+				}
+			}()
+
+			if actual := tt.must(tt.code); actual.Part3 != tt.expectedPart3 {
+				t.Errorf("%s(%q) = %v, expected %v", tt.name, tt.code, actual.Part3, tt.expectedPart3)
+			}
+		})
+	}
+}
@@ -0,0 +1,292 @@
+package iso639_3
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/cases"
+)
+
+var (
+	foldedNamesOnce sync.Once
+	foldedNames     []string // folded reference names of languages sorted by ISO 639-3 code
+
+	namesIndexOnce sync.Once
+	namesIndex     map[string]*Language // reference names and their alternatives mapped to preferred languages, see languagesByName
+
+	qualifiedNamesOnce sync.Once
+	qualifiedNames     []qualifiedName // reference names of languages sorted by ISO 639-3 code, prepared for SearchNameQualified
+)
+
+// qualifiedName holds reference name of a language folded for SearchNameQualified: full one without trailing remark,
+// and base one without qualifiers, see stripNameQualifiers
+type qualifiedName struct {
+	full, base string
+	historical bool
+}
+
+// nameSeparator separates alternatives packed into a single reference name, e.g. "Dutch; Flemish"
+const nameSeparator = ";"
+
+// nameAlternatives splits reference name packing several alternatives separated by nameSeparator into them,
+// with whitespace normalized (see normalizeSpace). Returns nil for names without alternatives
+func nameAlternatives(name string) []string {
+	if !strings.Contains(name, nameSeparator) {
+		return nil
+	}
+
+	var ret []string
+	for _, alt := range strings.Split(name, nameSeparator) {
+		if alt = normalizeSpace(alt); alt != "" {
+			ret = append(ret, alt)
+		}
+	}
+	return ret
+}
+
+// foldName normalizes whitespace (see normalizeSpace) and folds case of a language name for case-insensitive matching.
+// Full Unicode case folding is used instead of strings.ToLower, with no locale-specific rules:
+// e.g. "ß" matches "ss", while Turkish dotless "ı" doesn't match "i"
+func foldName(name string) string {
+	return cases.Fold().String(normalizeSpace(name))
+}
+
+// normalizeSpace trims surrounding whitespace of a language name and collapses internal runs of whitespace,
+// including non-breaking spaces, into single regular space, e.g. "Old\u00a0 English " becomes "Old English".
+// Names which are already normalized are returned as is, without allocation
+func normalizeSpace(name string) string {
+	prevSpace := true
+	for _, r := range name {
+		space := unicode.IsSpace(r)
+		if space && (r != ' ' || prevSpace) {
+			return strings.Join(strings.Fields(name), " ")
+		}
+		prevSpace = space
+	}
+	if prevSpace && name != "" {
+		return strings.Join(strings.Fields(name), " ")
+	}
+	return name
+}
+
+// foldedNamesByPart3 returns folded reference names of languages sorted by ISO 639-3 code, computed once on first use
+func foldedNamesByPart3() []string {
+	foldedNamesOnce.Do(func() {
+		foldedNames = make([]string, len(db().byPart3))
+		for i, l := range db().byPart3 {
+			foldedNames[i] = foldName(l.Name)
+		}
+	})
+	return foldedNames
+}
+
+// qualifiedNamesByPart3 returns reference names of languages sorted by ISO 639-3 code, prepared for SearchNameQualified,
+// computed once on first use
+func qualifiedNamesByPart3() []qualifiedName {
+	qualifiedNamesOnce.Do(func() {
+		qualifiedNames = make([]qualifiedName, len(db().byPart3))
+		for i, l := range db().byPart3 {
+			full := stripNameRemark(l.Name)
+			base, historical := stripNameQualifiers(full)
+			qualifiedNames[i] = qualifiedName{full: foldName(full), base: foldName(base), historical: historical}
+		}
+	})
+	return qualifiedNames
+}
+
+// languagesByName returns index of languages of the embedded dataset by name (see indexNames), built once on first use
+func languagesByName() map[string]*Language {
+	namesIndexOnce.Do(func() {
+		namesIndex = indexNames(db().byPart3, languageAltNames)
+	})
+	return namesIndex
+}
+
+// indexNames maps exact reference names of given languages to them. Alternatives of names packing several ones
+// (see nameAlternatives) are indexed too, unless another language has such reference name.
+// Finally alternative names from name index, keyed by ISO 639-3 code, are indexed unless they are already.
+// Of languages sharing the name the one preferred by preferredByName is indexed
+func indexNames(langs []Language, altNames map[string][]string) map[string]*Language {
+	ret := make(map[string]*Language, len(langs))
+	for i := range langs {
+		l := &langs[i]
+		if prev, ok := ret[l.Name]; !ok || preferredByName(*l, *prev) {
+			ret[l.Name] = l
+		}
+	}
+	for i := range langs {
+		l := &langs[i]
+		for _, alt := range nameAlternatives(l.Name) {
+			if prev, ok := ret[alt]; !ok || prev.Name != alt && preferredByName(*l, *prev) {
+				ret[alt] = l
+			}
+		}
+	}
+
+	fromAltNames := map[string]bool{}
+	for i := range langs {
+		l := &langs[i]
+		for _, name := range altNames[l.Part3] {
+			prev, ok := ret[name]
+			if !ok || fromAltNames[name] && preferredByName(*l, *prev) {
+				ret[name] = l
+				fromAltNames[name] = true
+			}
+		}
+	}
+	return ret
+}
+
+// AltNames returns alternative names of the language from ISO 639-3 name index, e.g. inverted "Chinese, Mandarin"
+// for Mandarin Chinese, sorted and without the reference name. The name index is read by the generator only with -n flag,
+// so with shipped lookup tables it always returns nil unless they are regenerated with it. Returns nil if there are none
+func (l Language) AltNames() []string {
+	names, ok := languageAltNames[l.Part3]
+	if !ok {
+		return nil
+	}
+
+	ret := make([]string, len(names))
+	copy(ret, names)
+	return ret
+}
+
+// preferredByName reports whether language a should be preferred over b when both match looked up name.
+// Macrolanguages win over other languages, since users typing a broad name expect the broad entry,
+// then living languages win over historical, extinct and special ones, then the one with lowest ISO639-3 code
+func preferredByName(a, b Language) bool {
+	aMacro, bMacro := a.IsMacrolanguage(), b.IsMacrolanguage()
+	if aMacro != bMacro {
+		return aMacro
+	}
+	aLiving, bLiving := a.LanguageType == TypeLiving, b.LanguageType == TypeLiving
+	if aLiving != bLiving {
+		return aLiving
+	}
+	return a.Part3 < b.Part3
+}
+
+// DuplicateNames returns names (reference or alternative ones) shared by more than one language,
+// mapped to those languages sorted by ISO 639-3 code. Names are compared exactly.
+// Such names make lookup by name ambiguous. Reference names of current dataset are unique,
+// so duplicates may only come from alternative names
+func DuplicateNames() map[string][]Language {
+	byName := map[string][]Language{}
+	for _, l := range db().byPart3 {
+		byName[l.Name] = append(byName[l.Name], l)
+		for _, name := range languageAltNames[l.Part3] {
+			byName[name] = append(byName[name], l)
+		}
+	}
+
+	ret := map[string][]Language{}
+	for name, langs := range byName {
+		if len(langs) > 1 {
+			ret[name] = langs // already sorted, since db().byPart3 is
+		}
+	}
+	return ret
+}
+
+// historicalQualifiers are leading words of reference names denoting historical stage of a language,
+// e.g. "Old English" or "Classical Syriac"
+var historicalQualifiers = []string{"Old", "Middle", "Classical", "Ancient", "Early", "Late"}
+
+// NameSearchOptions configures SearchNameQualified
+type NameSearchOptions struct {
+	// IncludeHistorical makes historical variants match name of the base language, e.g. "Old English" and
+	// "Middle English" match "English". Such variants are still returned after the base language
+	IncludeHistorical bool
+}
+
+// SearchNameQualified looks up languages by reference name, case-insensitively and ignoring trailing parenthesized remarks,
+// so "old english" matches "Old English (ca. 450-1100)". Whitespace is normalized as in FromName.
+// Languages named with "Modern" qualifier also match the base name, e.g. "Greek" matches "Modern Greek (1453-)".
+// Languages named with historical qualifier (any of "Old", "Middle", "Classical", "Ancient", "Early" and "Late")
+// match the base name only if opts.IncludeHistorical is set.
+// Exact matches go first, then living languages, then others; ties are broken by ISO 639-3 code.
+// Unlike SearchName, misspelled names match nothing. Returns nil if nothing matches
+func SearchNameQualified(name string, opts NameSearchOptions) []Language {
+	query := foldName(name)
+	if query == "" {
+		return nil
+	}
+
+	type candidate struct {
+		lang       Language
+		exact      bool
+		historical bool
+	}
+	var candidates []candidate
+	for i, n := range qualifiedNamesByPart3() {
+		l := db().byPart3[i]
+		if n.full == query {
+			candidates = append(candidates, candidate{lang: l, exact: true})
+			continue
+		}
+		if n.base == query && (!n.historical || opts.IncludeHistorical) {
+			candidates = append(candidates, candidate{lang: l, historical: n.historical})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.exact != b.exact {
+			return a.exact
+		}
+		if a.historical != b.historical {
+			return !a.historical
+		}
+		if aLiving, bLiving := a.lang.LanguageType == TypeLiving, b.lang.LanguageType == TypeLiving; aLiving != bLiving {
+			return aLiving
+		}
+		return false // candidates are already sorted by ISO 639-3 code
+	})
+
+	var ret []Language
+	for _, c := range candidates {
+		ret = append(ret, c.lang)
+	}
+	return ret
+}
+
+// stripNameRemark removes trailing parenthesized remark from a reference name, e.g. "Old English (ca. 450-1100)"
+// becomes "Old English"
+func stripNameRemark(name string) string {
+	if i := strings.LastIndex(name, " ("); i > 0 && strings.HasSuffix(name, ")") {
+		return name[:i]
+	}
+	return name
+}
+
+// stripNameQualifiers removes leading "Modern" and historical qualifiers from a reference name,
+// reporting whether any historical qualifier was found, e.g. "Late Middle Chinese" becomes "Chinese"
+func stripNameQualifiers(name string) (string, bool) {
+	historical := false
+	for {
+		i := strings.IndexByte(name, ' ')
+		if i < 0 {
+			return name, historical
+		}
+		word, rest := name[:i], name[i+1:]
+		switch {
+		case word == "Modern":
+		case containsString(historicalQualifiers, word):
+			historical = true
+		default:
+			return name, historical
+		}
+		name = rest
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,343 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLanguage_AltNames(t *testing.T) {
+	defer func(names map[string][]string) { languageAltNames = names }(languageAltNames)
+	languageAltNames = map[string][]string{"cmn": {"Chinese, Mandarin"}, "nld": {"Dutch, Flemish", "Flemish"}}
+
+	tests := []struct {
+		part3    string
+		expected []string
+	}{
+		{"cmn", []string{"Chinese, Mandarin"}},
+		{"nld", []string{"Dutch, Flemish", "Flemish"}},
+		{"rus", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.part3, func(t *testing.T) {
+			actual := FromPart3Code(tt.part3).AltNames()
+
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("AltNames() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+
+	FromPart3Code("nld").AltNames()[0] = "Dutch"
+	if languageAltNames["nld"][0] != "Dutch, Flemish" {
+		t.Errorf("AltNames() returned shared slice")
+	}
+}
+
+func TestAltNamesData(t *testing.T) {
+	for code, names := range languageAltNames {
+		l := FromPart3Code(code)
+		if l == nil {
+			t.Errorf("languageAltNames has unknown code %v", code)
+			continue
+		}
+		for i := 1; i < len(names); i++ {
+			if names[i-1] >= names[i] {
+				t.Errorf("languageAltNames[%v] = %q, expected to be sorted without duplicates", code, names)
+			}
+		}
+		for _, name := range names {
+			if name == "" || name == l.Name {
+				t.Errorf("languageAltNames[%v] = %q, expected no empty or reference names", code, names)
+			}
+		}
+	}
+
+	// shipped tables are generated without name index, see AltNames
+	if len(languageAltNames) == 0 {
+		if actual := FromPart3Code("cmn").AltNames(); actual != nil {
+			t.Errorf("AltNames() = %q, expected nil without name index", actual)
+		}
+		return
+	}
+	if actual := FromName("Chinese, Mandarin"); actual == nil || actual.Part3 != "cmn" {
+		t.Errorf("FromName() = %v, expected Mandarin Chinese by inverted name", actual)
+	}
+}
+
+func TestFromNameMacrolanguage(t *testing.T) {
+	tests := []struct {
+		name          string
+		expectedPart3 string
+	}{
+		{"Chinese", "zho"},
+		{"Arabic", "ara"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := FromName(tt.name)
+
+			if actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("FromName() = %v, expected Language with Part3 %v", actual, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestPreferredByName(t *testing.T) {
+	macro := Language{Part3: "zzz", Scope: ScopeMacrolanguage, Name: "Same"}
+	member := Language{Part3: "aaa", Scope: ScopeIndividual, Name: "Same"}
+	other := Language{Part3: "bbb", Scope: ScopeIndividual, Name: "Same"}
+	living := Language{Part3: "ccc", Scope: ScopeIndividual, LanguageType: TypeLiving, Name: "Same"}
+	extinct := Language{Part3: "aab", Scope: ScopeIndividual, LanguageType: TypeExtinct, Name: "Same"}
+
+	if !preferredByName(macro, member) || preferredByName(member, macro) {
+		t.Errorf("preferredByName() doesn't prefer macrolanguage")
+	}
+	if !preferredByName(member, other) || preferredByName(other, member) {
+		t.Errorf("preferredByName() doesn't prefer lower code")
+	}
+	if !preferredByName(living, extinct) || preferredByName(extinct, living) {
+		t.Errorf("preferredByName() doesn't prefer living language")
+	}
+}
+
+func TestFromNameStable(t *testing.T) {
+	for _, name := range []string{"Chinese", "Russian", "Arabic", "Old English (ca. 450-1100)"} {
+		expected := FromName(name)
+		for i := 0; i < 100; i++ {
+			if actual := FromName(name); actual != expected {
+				t.Fatalf("FromName(%q) = %v, expected %v as in first call", name, actual, expected)
+			}
+		}
+	}
+}
+
+func TestLanguagesByName(t *testing.T) {
+	for _, l := range db().byPart3 {
+		actual := FromName(l.Name)
+		if actual == nil || actual.Name != l.Name || actual.Part3 != l.Part3 && !preferredByName(*actual, l) {
+			t.Errorf("FromName(%q) = %v, expected %v or preferred language of the same name", l.Name, actual, l)
+		}
+	}
+}
+
+func TestIndexNames(t *testing.T) {
+	langs := []Language{
+		{Part3: "aaa", Scope: ScopeIndividual, LanguageType: TypeLiving, Name: "Flemish"},
+		{Part3: "bbb", Scope: ScopeIndividual, LanguageType: TypeLiving, Name: "Alpha; Flemish"},
+		{Part3: "ccc", Scope: ScopeIndividual, LanguageType: TypeLiving, Name: "Dutch;  Brabantian ;"},
+		{Part3: "ddd", Scope: ScopeIndividual, LanguageType: TypeExtinct, Name: "Beta; Alpha"},
+	}
+	tests := map[string]string{
+		"Flemish":              "aaa", // full reference name wins over alternative
+		"Alpha; Flemish":       "bbb",
+		"Alpha":                "bbb", // living language wins
+		"Dutch":                "ccc",
+		"Brabantian":           "ccc",
+		"Dutch;  Brabantian ;": "ccc",
+		"Beta":                 "ddd",
+		"Gamma":                "aaa",
+		"Delta":                "ddd",
+		"":                     "",
+		"Dutch;  Brabantian":   "",
+		"Brabantian ;":         "",
+		"Beta; Alpha; Flemish": "",
+		"flemish":              "",
+	}
+
+	index := indexNames(langs, map[string][]string{"aaa": {"Gamma", "Dutch"}, "ccc": {"Gamma"}, "ddd": {"Delta"}})
+	for name, expected := range tests {
+		actual, ok := index[name]
+		if expected == "" {
+			if ok {
+				t.Errorf("indexNames()[%q] = %v, expected none", name, actual)
+			}
+		} else if !ok || actual.Part3 != expected {
+			t.Errorf("indexNames()[%q] = %v, expected Language with Part3 %v", name, actual, expected)
+		} else if actual != &langs[expected[0]-'a'] {
+			t.Errorf("indexNames()[%q] doesn't point to given language", name)
+		}
+	}
+}
+
+func TestIndexNamesInverted(t *testing.T) {
+	langs := []Language{
+		{Part3: "aaa", Scope: ScopeIndividual, LanguageType: TypeExtinct, Name: "Synthetic"},
+		{Part3: "cmn", Scope: ScopeIndividual, LanguageType: TypeLiving, Name: "Mandarin Chinese", MacrolanguageCode: "zho"},
+		{Part3: "ltc", Scope: ScopeIndividual, LanguageType: TypeHistorical, Name: "Late Middle Chinese"},
+		{Part3: "och", Scope: ScopeIndividual, LanguageType: TypeAncient, Name: "Old Chinese"},
+		{Part3: "zho", Scope: ScopeMacrolanguage, LanguageType: TypeLiving, Name: "Chinese"},
+	}
+	altNames := map[string][]string{
+		"aaa": {"Chinese, Mandarin", "Mandarin Chinese"},
+		"cmn": {"Chinese, Mandarin", "Chinese, Standard"},
+		"ltc": {"Chinese, Late Middle", "Chinese, Old"},
+		"och": {"Chinese, Old"},
+		"zho": {"Chinese, Standard"},
+	}
+	tests := map[string]string{
+		"Chinese, Late Middle": "ltc",
+		"Chinese, Mandarin":    "cmn", // living language wins
+		"Chinese, Standard":    "zho", // macrolanguage wins
+		"Chinese, Old":         "ltc", // neither is living, lowest code wins
+		"Mandarin Chinese":     "cmn", // reference name wins over alternative one
+		"Chinese":              "zho",
+	}
+
+	index := indexNames(langs, altNames)
+	for name, expected := range tests {
+		if actual, ok := index[name]; !ok || actual.Part3 != expected {
+			t.Errorf("indexNames()[%q] = %v, expected Language with Part3 %v", name, actual, expected)
+		}
+	}
+	if len(index) != len(tests)+len(langs)-2 {
+		t.Errorf("indexNames() = %v, expected only reference and alternative names", index)
+	}
+}
+
+func TestNameAlternatives(t *testing.T) {
+	tests := map[string][]string{
+		"Dutch; Flemish":     {"Dutch", "Flemish"},
+		" Dutch ;;Flemish; ": {"Dutch", "Flemish"},
+		"Dutch":              nil,
+		";":                  nil,
+	}
+	for name, expected := range tests {
+		if actual := nameAlternatives(name); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("nameAlternatives(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+}
+
+func TestFoldName(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"Russian", "RUSSIAN", true},
+		{"Arbëreshë Albanian", "ARBËRESHË ALBANIAN", true},
+		{"Straße", "STRASSE", true},
+		{"ΟΔΟΣ", "οδος", true},
+		{"İzmir", "izmir", false}, // dotted capital I folds to i with combining dot
+		{"ısı", "isi", false},     // dotless i is a distinct letter
+	}
+	for _, tt := range tests {
+		t.Run(tt.a, func(t *testing.T) {
+			if actual := foldName(tt.a) == foldName(tt.b); actual != tt.expected {
+				t.Errorf("foldName(%q) == foldName(%q) is %v, expected %v", tt.a, tt.b, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDuplicateNames(t *testing.T) {
+	actual := DuplicateNames()
+
+	for name, langs := range actual {
+		alt := false
+		for _, l := range langs {
+			alt = alt || l.Name != name
+		}
+		if !alt {
+			t.Errorf("DuplicateNames()[%q] = %v, expected reference names to be unique", name, langs)
+		}
+	}
+
+	defer func(names map[string][]string) { languageAltNames = names }(languageAltNames)
+	languageAltNames = map[string][]string{"rus": {"German"}}
+
+	actual = DuplicateNames()
+	if langs := actual["German"]; len(actual) != 1 || len(langs) != 2 || langs[0].Part3 != "deu" || langs[1].Part3 != "rus" {
+		t.Errorf("DuplicateNames() = %v, expected German shared by deu and rus", actual)
+	}
+}
+
+func TestSearchNameQualified(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     NameSearchOptions
+		expected []string
+	}{
+		{"English", NameSearchOptions{}, []string{"eng"}},
+		{"English", NameSearchOptions{IncludeHistorical: true}, []string{"eng", "ang", "enm"}},
+		{"old english", NameSearchOptions{}, []string{"ang"}},
+		{" old  english ", NameSearchOptions{}, []string{"ang"}},
+		{"Old\u00a0English", NameSearchOptions{}, []string{"ang"}},
+		{"Old English", NameSearchOptions{IncludeHistorical: true}, []string{"ang"}},
+		{"Greek", NameSearchOptions{}, []string{"ell"}},
+		{"Greek", NameSearchOptions{IncludeHistorical: true}, []string{"ell", "grc"}},
+		{"Chinese", NameSearchOptions{IncludeHistorical: true}, []string{"zho", "ltc", "och"}},
+		{"Syriac", NameSearchOptions{}, []string{"syr"}},
+		{"Klingonese", NameSearchOptions{}, nil},
+		{"", NameSearchOptions{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []string
+			for _, l := range SearchNameQualified(tt.name, tt.opts) {
+				actual = append(actual, l.Part3)
+			}
+			if !reflect.DeepEqual(actual, tt.expected) {
+				t.Errorf("SearchNameQualified(%q, %+v) = %v, expected %v", tt.name, tt.opts, actual, tt.expected)
+			}
+		})
+	}
+}
+
+func BenchmarkSearchNameQualified(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if len(SearchNameQualified("greek", NameSearchOptions{IncludeHistorical: true})) == 0 {
+			b.Fatal("SearchNameQualified() = nil")
+		}
+	}
+}
+
+func TestNormalizeSpace(t *testing.T) {
+	tests := map[string]string{
+		"Old English":         "Old English",
+		"  Old   English\n":   "Old English",
+		"Old\u00a0English":    "Old English",
+		"Old \u00a0\tEnglish": "Old English",
+		"\u00a0":              "",
+		"":                    "",
+		"Abu' Arapesh":        "Abu' Arapesh",
+	}
+	for name, expected := range tests {
+		if actual := normalizeSpace(name); actual != expected {
+			t.Errorf("normalizeSpace(%q) = %q, expected %q", name, actual, expected)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		normalizeSpace("Old English (ca. 450-1100)")
+	})
+	if allocs != 0 {
+		t.Errorf("normalizeSpace() of normalized name allocates %v times, expected 0", allocs)
+	}
+}
+
+func TestLanguageByFoldedName(t *testing.T) {
+	tests := map[string]string{
+		"Russian":              "rus",
+		"RUSSIAN":              "rus",
+		"mandarin CHINESE":     "cmn",
+		"Chinese":              "zho",
+		"Modern Greek (1453-)": "ell",
+	}
+	for name, expected := range tests {
+		if actual := languageByFoldedName[foldName(name)]; actual != expected {
+			t.Errorf("languageByFoldedName[%q] = %q, expected %q", name, actual, expected)
+		}
+	}
+
+	for _, l := range db().byPart3 {
+		code, ok := languageByFoldedName[foldName(l.Name)]
+		if !ok {
+			t.Errorf("languageByFoldedName has no %q", l.Name)
+		} else if code != l.Part3 && !preferredByName(db().part3[code], l) {
+			t.Errorf("languageByFoldedName[%q] = %q, expected %q to be preferred", l.Name, code, l.Part3)
+		}
+	}
+}
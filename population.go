@@ -0,0 +1,132 @@
+package iso639_3
+
+import "sort"
+
+// languageSpeakers holds approximate total number of speakers (first and second language), in millions,
+// of about a hundred most spoken languages, keyed by ISO 639-3 code. Figures are rounded and based on Ethnologue (2023)
+// estimates, so they're only good for ordering, not for statistics
+var languageSpeakers = map[string]int{
+	"eng": 1456, // English
+	"cmn": 1138, // Mandarin Chinese
+	"hin": 610,  // Hindi
+	"spa": 559,  // Spanish
+	"fra": 310,  // French
+	"arb": 274,  // Standard Arabic
+	"ben": 273,  // Bengali
+	"por": 264,  // Portuguese
+	"rus": 255,  // Russian
+	"urd": 232,  // Urdu
+	"ind": 199,  // Indonesian
+	"deu": 133,  // German
+	"jpn": 123,  // Japanese
+	"pcm": 121,  // Nigerian Pidgin
+	"mar": 99,   // Marathi
+	"tel": 96,   // Telugu
+	"tur": 90,   // Turkish
+	"tam": 86,   // Tamil
+	"yue": 86,   // Yue Chinese
+	"vie": 85,   // Vietnamese
+	"tgl": 83,   // Tagalog
+	"wuu": 83,   // Wu Chinese
+	"kor": 82,   // Korean
+	"hau": 79,   // Hausa
+	"pes": 79,   // Iranian Persian
+	"arz": 78,   // Egyptian Arabic
+	"swh": 72,   // Swahili
+	"ita": 68,   // Italian
+	"jav": 68,   // Javanese
+	"pnb": 67,   // Western Panjabi
+	"guj": 62,   // Gujarati
+	"tha": 61,   // Thai
+	"kan": 59,   // Kannada
+	"amh": 57,   // Amharic
+	"bho": 52,   // Bhojpuri
+	"pan": 52,   // Panjabi
+	"nan": 49,   // Min Nan Chinese
+	"hak": 47,   // Hakka Chinese
+	"yor": 46,   // Yoruba
+	"mya": 43,   // Burmese
+	"apd": 42,   // Sudanese Arabic
+	"pol": 41,   // Polish
+	"lin": 40,   // Lingala
+	"ory": 39,   // Odia
+	"ukr": 39,   // Ukrainian
+	"awa": 38,   // Awadhi
+	"hsn": 38,   // Xiang Chinese
+	"mal": 38,   // Malayalam
+	"gaz": 37,   // West Central Oromo
+	"arq": 36,   // Algerian Arabic
+	"mai": 34,   // Maithili
+	"uzn": 33,   // Northern Uzbek
+	"npi": 32,   // Nepali
+	"snd": 32,   // Sindhi
+	"sun": 32,   // Sundanese
+	"ibo": 31,   // Igbo
+	"ary": 30,   // Moroccan Arabic
+	"ceb": 28,   // Cebuano
+	"zul": 28,   // Zulu
+	"skr": 26,   // Saraiki
+	"nld": 25,   // Dutch
+	"azj": 24,   // North Azerbaijani
+	"ron": 24,   // Romanian
+	"asm": 23,   // Assamese
+	"som": 22,   // Somali
+	"wol": 21,   // Wolof
+	"xho": 19,   // Xhosa
+	"zsm": 19,   // Standard Malay
+	"nya": 18,   // Nyanja
+	"plt": 18,   // Plateau Malagasy
+	"afr": 17,   // Afrikaans
+	"khm": 17,   // Khmer
+	"sin": 17,   // Sinhala
+	"hne": 16,   // Chhattisgarhi
+	"fuv": 15,   // Nigerian Fulfulde
+	"kin": 15,   // Kinyarwanda
+	"nso": 15,   // Pedi
+	"sna": 15,   // Shona
+	"azb": 14,   // South Azerbaijani
+	"bam": 14,   // Bambara
+	"tsn": 14,   // Tswana
+	"ell": 13,   // Modern Greek
+	"hun": 13,   // Hungarian
+	"kaz": 13,   // Kazakh
+	"mag": 13,   // Magahi
+	"sot": 13,   // Southern Sotho
+	"swe": 13,   // Swedish
+	"ctg": 13,   // Chittagonian
+	"srp": 12,   // Serbian
+	"ces": 11,   // Czech
+	"uig": 11,   // Uighur
+	"cat": 10,   // Catalan
+	"ilo": 10,   // Iloko
+	"heb": 9,    // Hebrew
+	"hil": 9,    // Hiligaynon
+	"tir": 9,    // Tigrinya
+	"bul": 8,    // Bulgarian
+	"hrv": 7,    // Croatian
+	"hye": 7,    // Armenian
+	"kik": 7,    // Kikuyu
+	"slk": 7,    // Slovak
+	"dan": 6,    // Danish
+	"fin": 6,    // Finnish
+	"bel": 5,    // Belarusian
+	"khk": 5,    // Halh Mongolian
+	"nob": 5,    // Norwegian Bokmål
+}
+
+// LanguagesByPopulation returns all distinct languages for a language picker: about a hundred most spoken languages first,
+// ordered by approximate number of speakers descending, followed by the rest sorted by name.
+// Speaker counts come from a hand-curated table and are approximate
+func LanguagesByPopulation() []Language {
+	ret := make([]Language, len(db().byPart3))
+	copy(ret, db().byPart3)
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		a, b := languageSpeakers[ret[i].Part3], languageSpeakers[ret[j].Part3]
+		if a != b {
+			return a > b
+		}
+		return a == 0 && ret[i].Name < ret[j].Name
+	})
+	return ret
+}
@@ -0,0 +1,37 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLanguagesByPopulation(t *testing.T) {
+	actual := LanguagesByPopulation()
+
+	if len(actual) != len(db().byPart3) {
+		t.Fatalf("LanguagesByPopulation() returned %d languages, expected %d", len(actual), len(db().byPart3))
+	}
+
+	top := make([]string, 5)
+	for i := range top {
+		top[i] = actual[i].Part3
+	}
+	expected := []string{"eng", "cmn", "hin", "spa", "fra"}
+	if !reflect.DeepEqual(top, expected) {
+		t.Errorf("LanguagesByPopulation() starts with %v, expected %v", top, expected)
+	}
+
+	tail := actual[len(languageSpeakers):]
+	for i := 1; i < len(tail); i++ {
+		if tail[i-1].Name > tail[i].Name {
+			t.Errorf("LanguagesByPopulation() tail is not sorted by name: %v before %v", tail[i-1].Name, tail[i].Name)
+			break
+		}
+	}
+
+	for code := range languageSpeakers {
+		if _, ok := db().part3[code]; !ok {
+			t.Errorf("languageSpeakers has unknown code %v", code)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package iso639_3
+
+import "strings"
+
+// regionDefaultLanguages maps ISO 3166-1 alpha-2 region codes to ISO 639-3 codes of their most common official language.
+// The table is curated by hand and opinionated: multilingual regions are mapped to a single language
+var regionDefaultLanguages = map[string]string{
+	"AR": "spa",
+	"AT": "deu",
+	"AU": "eng",
+	"BE": "nld",
+	"BR": "por",
+	"CA": "eng",
+	"CH": "deu",
+	"CL": "spa",
+	"CN": "zho",
+	"CO": "spa",
+	"CZ": "ces",
+	"DE": "deu",
+	"DK": "dan",
+	"EG": "ara",
+	"ES": "spa",
+	"FI": "fin",
+	"FR": "fra",
+	"GB": "eng",
+	"GR": "ell",
+	"HU": "hun",
+	"ID": "ind",
+	"IE": "eng",
+	"IL": "heb",
+	"IN": "hin",
+	"IR": "fas",
+	"IT": "ita",
+	"JP": "jpn",
+	"KR": "kor",
+	"MX": "spa",
+	"NL": "nld",
+	"NO": "nor",
+	"NZ": "eng",
+	"PE": "spa",
+	"PH": "fil",
+	"PK": "urd",
+	"PL": "pol",
+	"PT": "por",
+	"RO": "ron",
+	"RU": "rus",
+	"SA": "ara",
+	"SE": "swe",
+	"TH": "tha",
+	"TR": "tur",
+	"TW": "zho",
+	"UA": "ukr",
+	"US": "eng",
+	"VN": "vie",
+}
+
+// DefaultLanguageForRegion looks up the most common official language for given ISO 3166-1 alpha-2 region code, case-insensitive,
+// e.g. French for "FR". The mapping is curated and covers only major regions.
+// Returns nil if region is unknown
+func DefaultLanguageForRegion(region string) *Language {
+	if code, ok := regionDefaultLanguages[strings.ToUpper(region)]; ok {
+		return FromPart3Code(code)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestDefaultLanguageForRegion(t *testing.T) {
+	tests := []struct {
+		region        string
+		expectedPart3 string
+	}{
+		{"FR", "fra"},
+		{"JP", "jpn"},
+		{"de", "deu"},
+		{"BR", "por"},
+		{"CN", "zho"},
+		{"XX", ""}, // doesn't exist
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.region, func(t *testing.T) {
+			actual := DefaultLanguageForRegion(tt.region)
+
+			if tt.expectedPart3 == "" {
+				if actual != nil {
+					t.Errorf("DefaultLanguageForRegion() = %v, expected nil", actual)
+				}
+			} else if actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("DefaultLanguageForRegion() = %v, expected Language with Part3 %v", actual, tt.expectedPart3)
+			}
+		})
+	}
+}
+
+func TestRegionDefaultLanguagesData(t *testing.T) {
+	for region, code := range regionDefaultLanguages {
+		if FromPart3Code(code) == nil {
+			t.Errorf("regionDefaultLanguages[%v] = %v, which is not a known ISO 639-3 code", region, code)
+		}
+	}
+}
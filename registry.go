@@ -0,0 +1,53 @@
+package iso639_3
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrEmptyAlias is returned when registering blank name alias
+var ErrEmptyAlias = errors.New("iso639_3: alias must not be empty")
+
+// Registry looks up languages by name extended with custom aliases, e.g. internal project names, without regenerating data.
+// Aliases are additive: reference names keep resolving as before, aliases only add names for lookup.
+// The zero value is ready to use. Registry is safe for concurrent use
+type Registry struct {
+	mu      sync.RWMutex
+	aliases map[string]*Language // keyed by folded alias
+}
+
+// AddNameAlias registers alias resolving to language with given ISO639-1, ISO639-2 or ISO639-3 code (see FromAnyCode).
+// Aliases are case-folded and whitespace-normalized as names in FindByName are. Registering the same alias again
+// makes it resolve to the new language. Returns wrapped ErrLanguageNotFound if code is unknown, ErrEmptyAlias for blank alias
+func (r *Registry) AddNameAlias(alias string, code string) error {
+	key := foldName(alias)
+	if key == "" {
+		return ErrEmptyAlias
+	}
+	l := FromAnyCode(code)
+	if l == nil {
+		return notFound(code)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.aliases == nil {
+		r.aliases = map[string]*Language{}
+	}
+	r.aliases[key] = l
+	return nil
+}
+
+// FindByName looks up language by reference name case-insensitively, then by registered aliases.
+// Returned language is shared by all callers and must not be modified.
+// Returns nil if not found
+func (r *Registry) FindByName(name string) *Language {
+	if l := FromNameFold(name); l != nil {
+		return l
+	}
+	key := foldName(name)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.aliases[key]
+}
@@ -0,0 +1,42 @@
+package iso639_3
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRegistry(t *testing.T) {
+	var r Registry
+
+	if err := r.AddNameAlias("Project Falcon", "de"); err != nil {
+		t.Fatalf("AddNameAlias() error = %v", err)
+	}
+	if err := r.AddNameAlias("Russian", "eng"); err != nil {
+		t.Fatalf("AddNameAlias() error = %v", err)
+	}
+	if err := r.AddNameAlias("Elvish", "xxx"); !errors.Is(err, ErrLanguageNotFound) {
+		t.Errorf("AddNameAlias() with unknown code error = %v, expected %v", err, ErrLanguageNotFound)
+	}
+	if err := r.AddNameAlias(" ", "deu"); err != ErrEmptyAlias {
+		t.Errorf("AddNameAlias() with blank alias error = %v, expected %v", err, ErrEmptyAlias)
+	}
+
+	tests := map[string]string{
+		"Project Falcon":    "deu",
+		" project  FALCON ": "deu",
+		"german":            "deu",
+		"Russian":           "rus", // aliases don't override reference names
+		"Elvish":            "",
+		"":                  "",
+	}
+	for name, expected := range tests {
+		actual := r.FindByName(name)
+		if expected == "" && actual != nil || expected != "" && (actual == nil || actual.Part3 != expected) {
+			t.Errorf("FindByName(%q) = %v, expected %q", name, actual, expected)
+		}
+	}
+
+	if actual := (&Registry{}).FindByName("Project Falcon"); actual != nil {
+		t.Errorf("FindByName() of another registry = %v, expected nil", actual)
+	}
+}
@@ -0,0 +1,63 @@
+package iso639_3
+
+import "fmt"
+
+// RetirementReason represents reason of ISO 639-3 code retirement as defined in the retirements file
+type RetirementReason rune
+
+const (
+	RetirementChange      RetirementReason = 'C'
+	RetirementDuplicate   RetirementReason = 'D'
+	RetirementNonExistent RetirementReason = 'N'
+	RetirementSplit       RetirementReason = 'S'
+	RetirementMerge       RetirementReason = 'M'
+)
+
+// String returns name of the reason, e.g. "Merge" or "Split", or RetirementReason('X') for unknown ones
+func (r RetirementReason) String() string {
+	switch r {
+	case RetirementChange:
+		return "Change"
+	case RetirementDuplicate:
+		return "Duplicate"
+	case RetirementNonExistent:
+		return "Non-existent"
+	case RetirementSplit:
+		return "Split"
+	case RetirementMerge:
+		return "Merge"
+	}
+	return fmt.Sprintf("RetirementReason(%q)", rune(r))
+}
+
+// RetiredCode describes ISO 639-3 code retired by the Registration Authority
+type RetiredCode struct {
+	Code      string           // retired ISO 639-3 code
+	Name      string           // reference name of the language at the time of retirement
+	Reason    RetirementReason // why the code was retired
+	ChangeTo  string           // ISO 639-3 code to use instead, empty if there's no single replacement, e.g. for splits
+	Remedy    string           // instructions for codes without single replacement, e.g. which codes it was split into
+	Effective string           // date the retirement took effect, formatted as YYYY-MM-DD
+}
+
+// FromRetiredCode looks up retirement of given ISO 639-3 code, e.g. merge of Moldavian (mol) into Romanian (ron).
+// Retirements come from SIL retirements file, which is read by the generator only with -r flag:
+// shipped lookup tables know no retired codes, so it always returns nil unless regenerated with it.
+// Returns nil if the code was never retired
+func FromRetiredCode(code string) *RetiredCode {
+	r, ok := retiredCodes[code]
+	if !ok {
+		return nil
+	}
+	return &r
+}
+
+// Replacement returns language to use instead of retired code, see ChangeTo.
+// Returned language is shared by all callers and must not be modified.
+// Returns nil if there's no single replacement
+func (r RetiredCode) Replacement() *Language {
+	if r.ChangeTo == "" {
+		return nil
+	}
+	return FromPart3Code(r.ChangeTo)
+}
@@ -0,0 +1,90 @@
+package iso639_3
+
+import (
+	"fmt"
+	"testing"
+)
+
+// useTestRetiredCodes replaces retiredCodes with a few real retirements for the duration of the test,
+// so tests don't depend on whether the lookup tables were generated with the retirements file
+func useTestRetiredCodes(t *testing.T) {
+	codes := retiredCodes
+	t.Cleanup(func() { retiredCodes = codes })
+	retiredCodes = map[string]RetiredCode{
+		"daf": {Code: "daf", Name: "Dan", Reason: RetirementSplit, Remedy: "Split into Dan [dnj] and Kla-Dan [lda]", Effective: "2013-01-30"},
+		"mol": {Code: "mol", Name: "Moldavian", Reason: RetirementMerge, ChangeTo: "ron", Effective: "2008-11-03"},
+	}
+}
+
+func TestFromRetiredCode(t *testing.T) {
+	useTestRetiredCodes(t)
+
+	tests := []struct {
+		code             string
+		expectedReason   RetirementReason
+		expectedChangeTo string
+	}{
+		{"mol", RetirementMerge, "ron"},
+		{"daf", RetirementSplit, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			actual := FromRetiredCode(tt.code)
+
+			if actual == nil || actual.Code != tt.code || actual.Reason != tt.expectedReason || actual.ChangeTo != tt.expectedChangeTo {
+				t.Fatalf("FromRetiredCode() = %v, expected %v retired with reason %v in favor of %q",
+					actual, tt.code, tt.expectedReason, tt.expectedChangeTo)
+			}
+			if l := actual.Replacement(); tt.expectedChangeTo == "" && l != nil || tt.expectedChangeTo != "" && (l == nil || l.Part3 != tt.expectedChangeTo) {
+				t.Errorf("Replacement() = %v, expected Language with Part3 %q", l, tt.expectedChangeTo)
+			}
+		})
+	}
+
+	for _, code := range []string{"rus", "xxx", ""} {
+		if actual := FromRetiredCode(code); actual != nil {
+			t.Errorf("FromRetiredCode(%q) = %v, expected nil", code, actual)
+		}
+	}
+}
+
+func TestRetiredCodesData(t *testing.T) {
+	for code, r := range retiredCodes {
+		if r.Code != code || r.Reason.String() == fmt.Sprintf("RetirementReason(%q)", rune(r.Reason)) {
+			t.Errorf("retiredCodes[%v] = %v is malformed", code, r)
+		}
+		if FromPart3Code(code) != nil {
+			t.Errorf("retiredCodes[%v] is still in the dataset", code)
+		}
+		if r.ChangeTo != "" && r.Replacement() == nil {
+			t.Errorf("retiredCodes[%v] = %v is replaced with unknown code", code, r)
+		}
+	}
+
+	// shipped tables are generated without retirements file, see FromRetiredCode
+	if len(retiredCodes) == 0 {
+		if actual := FromRetiredCode("mol"); actual != nil {
+			t.Errorf("FromRetiredCode() = %v, expected nil without retirements file", actual)
+		}
+		return
+	}
+	if actual := FromRetiredCode("mol"); actual == nil || actual.Reason != RetirementMerge || actual.ChangeTo != "ron" {
+		t.Errorf("FromRetiredCode() = %v, expected mol merged into ron", actual)
+	}
+}
+
+func TestRetirementReason_String(t *testing.T) {
+	tests := map[RetirementReason]string{
+		RetirementChange:      "Change",
+		RetirementDuplicate:   "Duplicate",
+		RetirementNonExistent: "Non-existent",
+		RetirementSplit:       "Split",
+		RetirementMerge:       "Merge",
+		'X':                   "RetirementReason('X')",
+	}
+	for reason, expected := range tests {
+		if actual := fmt.Sprintf("%v", reason); actual != expected {
+			t.Errorf("String() = %v, expected %v", actual, expected)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package iso639_3
+
+// languageDefaultScripts maps ISO 639-3 codes to ISO 15924 codes of scripts the languages are usually written in.
+// The table is curated by hand and covers only languages with a well-established default script:
+// languages written in several scripts are mapped to the most common one, e.g. Serbian to Cyrillic, others are left out
+var languageDefaultScripts = map[string]string{
+	"afr": "Latn",
+	"amh": "Ethi",
+	"ara": "Arab",
+	"asm": "Beng",
+	"aze": "Latn",
+	"bak": "Cyrl",
+	"bel": "Cyrl",
+	"ben": "Beng",
+	"bho": "Deva",
+	"bod": "Tibt",
+	"bos": "Latn",
+	"bre": "Latn",
+	"bul": "Cyrl",
+	"cat": "Latn",
+	"ceb": "Latn",
+	"ces": "Latn",
+	"che": "Cyrl",
+	"chr": "Cher",
+	"chv": "Cyrl",
+	"ckb": "Arab",
+	"cmn": "Hans",
+	"cop": "Copt",
+	"cor": "Latn",
+	"cym": "Latn",
+	"dan": "Latn",
+	"deu": "Latn",
+	"div": "Thaa",
+	"dzo": "Tibt",
+	"ell": "Grek",
+	"eng": "Latn",
+	"epo": "Latn",
+	"est": "Latn",
+	"eus": "Latn",
+	"ewe": "Latn",
+	"fao": "Latn",
+	"fas": "Arab",
+	"fij": "Latn",
+	"fil": "Latn",
+	"fin": "Latn",
+	"fra": "Latn",
+	"fry": "Latn",
+	"ful": "Latn",
+	"gla": "Latn",
+	"gle": "Latn",
+	"glg": "Latn",
+	"glv": "Latn",
+	"got": "Goth",
+	"grc": "Grek",
+	"grn": "Latn",
+	"guj": "Gujr",
+	"hat": "Latn",
+	"hau": "Latn",
+	"haw": "Latn",
+	"heb": "Hebr",
+	"hin": "Deva",
+	"hrv": "Latn",
+	"hun": "Latn",
+	"hye": "Armn",
+	"ibo": "Latn",
+	"iii": "Yiii",
+	"iku": "Cans",
+	"ind": "Latn",
+	"isl": "Latn",
+	"ita": "Latn",
+	"jav": "Latn",
+	"jpn": "Jpan",
+	"kal": "Latn",
+	"kan": "Knda",
+	"kat": "Geor",
+	"kaz": "Cyrl",
+	"khm": "Khmr",
+	"kin": "Latn",
+	"kir": "Cyrl",
+	"kmr": "Latn",
+	"kom": "Cyrl",
+	"kor": "Kore",
+	"lao": "Laoo",
+	"lat": "Latn",
+	"lav": "Latn",
+	"lin": "Latn",
+	"lit": "Latn",
+	"ltz": "Latn",
+	"lug": "Latn",
+	"mai": "Deva",
+	"mal": "Mlym",
+	"mar": "Deva",
+	"mkd": "Cyrl",
+	"mlg": "Latn",
+	"mlt": "Latn",
+	"mon": "Cyrl",
+	"mri": "Latn",
+	"msa": "Latn",
+	"mya": "Mymr",
+	"nav": "Latn",
+	"nep": "Deva",
+	"nld": "Latn",
+	"nno": "Latn",
+	"nob": "Latn",
+	"nor": "Latn",
+	"nqo": "Nkoo",
+	"nya": "Latn",
+	"ori": "Orya",
+	"orm": "Latn",
+	"ory": "Orya",
+	"oss": "Cyrl",
+	"pan": "Guru",
+	"pes": "Arab",
+	"pol": "Latn",
+	"por": "Latn",
+	"pus": "Arab",
+	"que": "Latn",
+	"ron": "Latn",
+	"run": "Latn",
+	"rus": "Cyrl",
+	"sah": "Cyrl",
+	"san": "Deva",
+	"sat": "Olck",
+	"sin": "Sinh",
+	"slk": "Latn",
+	"slv": "Latn",
+	"smo": "Latn",
+	"sna": "Latn",
+	"snd": "Arab",
+	"som": "Latn",
+	"sot": "Latn",
+	"spa": "Latn",
+	"sqi": "Latn",
+	"srp": "Cyrl",
+	"sun": "Latn",
+	"swa": "Latn",
+	"swe": "Latn",
+	"syr": "Syrc",
+	"tam": "Taml",
+	"tat": "Cyrl",
+	"tel": "Telu",
+	"tet": "Latn",
+	"tgk": "Cyrl",
+	"tgl": "Latn",
+	"tha": "Thai",
+	"tir": "Ethi",
+	"ton": "Latn",
+	"tsn": "Latn",
+	"tso": "Latn",
+	"tuk": "Latn",
+	"tur": "Latn",
+	"udm": "Cyrl",
+	"uig": "Arab",
+	"ukr": "Cyrl",
+	"urd": "Arab",
+	"uzb": "Latn",
+	"vai": "Vaii",
+	"ven": "Latn",
+	"vie": "Latn",
+	"wol": "Latn",
+	"xho": "Latn",
+	"yid": "Hebr",
+	"yor": "Latn",
+	"yue": "Hant",
+	"zgh": "Tfng",
+	"zho": "Hans",
+	"zsm": "Latn",
+	"zul": "Latn",
+}
+
+// DefaultScript returns ISO 15924 code of the script the language is usually written in, e.g. "Latn" for English,
+// "Cyrl" for Russian or "Hans" for Chinese. It's a default, not a list of all scripts used for the language.
+// Returns empty string if the default script is not known
+func (l Language) DefaultScript() string {
+	return languageDefaultScripts[l.Part3]
+}
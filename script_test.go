@@ -0,0 +1,34 @@
+package iso639_3
+
+import "testing"
+
+func TestLanguage_DefaultScript(t *testing.T) {
+	tests := map[string]string{
+		"eng": "Latn",
+		"rus": "Cyrl",
+		"zho": "Hans",
+		"ara": "Arab",
+		"hin": "Deva",
+		"jpn": "Jpan",
+		"kor": "Kore",
+		"ell": "Grek",
+		"heb": "Hebr",
+		"aaa": "",
+	}
+	for code, expected := range tests {
+		if actual := FromPart3Code(code).DefaultScript(); actual != expected {
+			t.Errorf("DefaultScript() of %v = %q, expected %q", code, actual, expected)
+		}
+	}
+}
+
+func TestLanguageDefaultScripts(t *testing.T) {
+	for code, script := range languageDefaultScripts {
+		if FromPart3Code(code) == nil {
+			t.Errorf("languageDefaultScripts has unknown language %v", code)
+		}
+		if !isISO15924Script(script) {
+			t.Errorf("languageDefaultScripts has unknown script %v for %v", script, code)
+		}
+	}
+}
@@ -0,0 +1,309 @@
+package iso639_3
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+var (
+	namesSortedOnce sync.Once
+	namesSorted     []int // indices of languages in db().byPart3 sorted by folded reference name, then ISO 639-3 code
+)
+
+// Suggest looks up languages matching partially typed or misspelled input, e.g. for search bar.
+// Candidates are ranked as follows:
+//  1. exact ISO 639 code match (see FromAnyCode), case-insensitive;
+//  2. languages which reference name starts with input, case-insensitive (using Unicode case folding), sorted by name;
+//  3. languages which reference name is close to input by edit distance, closest first.
+//
+// Ties are broken by ISO 639-3 code. Returns at most limit languages, nil if input is empty or limit is not positive
+func Suggest(input string, limit int) []Language {
+	query := foldName(input)
+	if query == "" || limit <= 0 {
+		return nil
+	}
+
+	var ret []Language
+	seen := map[string]bool{}
+	add := func(l Language) bool {
+		if !seen[l.Part3] {
+			seen[l.Part3] = true
+			ret = append(ret, l)
+		}
+		return len(ret) < limit
+	}
+
+	if l := FromAnyCode(query); l != nil && !add(*l) {
+		return ret
+	}
+
+	folded := foldedNamesByPart3()
+
+	var prefixed []Language
+	for i, l := range db().byPart3 {
+		if strings.HasPrefix(folded[i], query) {
+			prefixed = append(prefixed, l)
+		}
+	}
+	sort.SliceStable(prefixed, func(i, j int) bool { return prefixed[i].Name < prefixed[j].Name })
+	for _, l := range prefixed {
+		if !add(l) {
+			return ret
+		}
+	}
+
+	type candidate struct {
+		lang     Language
+		distance int
+	}
+	var fuzzy []candidate
+	maxDistance := maxFuzzyDistance(query)
+	for i, l := range db().byPart3 {
+		if d := levenshtein(query, folded[i]); d <= maxDistance {
+			fuzzy = append(fuzzy, candidate{l, d})
+		}
+	}
+	sort.SliceStable(fuzzy, func(i, j int) bool { return fuzzy[i].distance < fuzzy[j].distance })
+	for _, c := range fuzzy {
+		if !add(c.lang) {
+			return ret
+		}
+	}
+
+	return ret
+}
+
+// SearchName ranks all languages by edit distance between their reference names and query, compared
+// case-insensitively (using Unicode case folding), and returns at most limit closest ones, best first.
+// Exact match (see FromName) always goes first, ties are broken by ISO 639-3 code.
+// Unlike Suggest, no distance threshold is applied, so there are always limit results for non-empty query
+// unless limit exceeds the number of languages. See SearchNameQualified for matching names regardless of qualifiers.
+// Returns nil if query is empty or limit is not positive
+func SearchName(query string, limit int) []Language {
+	folded := foldName(query)
+	if folded == "" || limit <= 0 {
+		return nil
+	}
+	var exact string
+	if l := FromName(query); l != nil {
+		exact = l.Part3
+	}
+
+	names := foldedNamesByPart3()
+	distances := make([]int, len(names))
+	order := make([]int, len(names))
+	for i, name := range names {
+		distances[i] = levenshtein(folded, name)
+		if db().byPart3[i].Part3 == exact {
+			distances[i] = -1
+		}
+		order[i] = i
+	}
+	// languages are sorted by ISO 639-3 code, so stable sort breaks ties by it
+	sort.SliceStable(order, func(i, j int) bool { return distances[order[i]] < distances[order[j]] })
+
+	if len(order) > limit {
+		order = order[:limit]
+	}
+	ret := make([]Language, len(order))
+	for i, j := range order {
+		ret[i] = db().byPart3[j]
+	}
+	return ret
+}
+
+// SearchNamePrefix returns languages which reference name starts with prefix, compared case-insensitively
+// (using Unicode case folding, with whitespace normalized as in FromName), sorted alphabetically by folded name,
+// then by ISO 639-3 code. Returns at most limit languages, nil if prefix is empty or limit is not positive
+func SearchNamePrefix(prefix string, limit int) []Language {
+	prefix = foldName(prefix)
+	if prefix == "" || limit <= 0 {
+		return nil
+	}
+
+	names := foldedNamesByPart3()
+	sorted := languagesSortedByName()
+	i := sort.Search(len(sorted), func(i int) bool { return names[sorted[i]] >= prefix })
+
+	var ret []Language
+	for ; i < len(sorted) && len(ret) < limit && strings.HasPrefix(names[sorted[i]], prefix); i++ {
+		ret = append(ret, db().byPart3[sorted[i]])
+	}
+	return ret
+}
+
+// languagesSortedByName returns indices of languages in db().byPart3 sorted by folded reference name,
+// computed once on first use
+func languagesSortedByName() []int {
+	namesSortedOnce.Do(func() {
+		names := foldedNamesByPart3()
+		namesSorted = make([]int, len(names))
+		for i := range namesSorted {
+			namesSorted[i] = i
+		}
+		// languages are sorted by ISO 639-3 code, so stable sort breaks ties by it
+		sort.SliceStable(namesSorted, func(i, j int) bool { return names[namesSorted[i]] < names[namesSorted[j]] })
+	})
+	return namesSorted
+}
+
+// maxFuzzyDistance returns maximum edit distance for a name to be considered similar to the query
+func maxFuzzyDistance(query string) int {
+	return utf8.RuneCountInString(query)/3 + 1
+}
+
+// levenshtein computes edit distance between two strings counting runes
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// osaDistance computes optimal string alignment (restricted Damerau-Levenshtein) distance between two strings counting runes,
+// i.e. edit distance where transposition of two adjacent runes, e.g. "rsu" for "rus", counts as a single edit
+func osaDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] && prev2[j-2]+1 < cur[j] {
+				cur[j] = prev2[j-2] + 1
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// didYouMeanLimit is the maximum number of candidates returned by DidYouMean
+const didYouMeanLimit = 5
+
+// DidYouMean returns a handful of languages user might have meant by unrecognized input, which may be either a code or a name.
+// Codes of the same length and reference names are compared with input case-insensitively by edit distance,
+// counting transposition of adjacent letters as a single edit (e.g. "rsu" suggests Russian), closest first.
+// On ties languages with ISO 639-1 code are preferred, then those with ISO 639-2 code,
+// then codes over names, then lower ISO 639-3 code
+func DidYouMean(input string) []Language {
+	query := foldName(input)
+	if query == "" {
+		return nil
+	}
+
+	type candidate struct {
+		lang     Language
+		distance int
+		byName   bool
+	}
+	best := map[string]candidate{}
+	consider := func(c candidate) {
+		if prev, ok := best[c.lang.Part3]; !ok || c.distance < prev.distance {
+			best[c.lang.Part3] = c
+		}
+	}
+
+	switch len(query) {
+	case 2:
+		for code, l := range db().part1 {
+			if d := osaDistance(query, code); d <= 1 {
+				consider(candidate{l, d, false})
+			}
+		}
+	case 3:
+		for _, lookup := range []map[string]Language{db().part3, db().part2} {
+			for code, l := range lookup {
+				if d := osaDistance(query, code); d <= 1 {
+					consider(candidate{l, d, false})
+				}
+			}
+		}
+	}
+
+	maxDistance := maxFuzzyDistance(query)
+	for i, name := range foldedNamesByPart3() {
+		if d := osaDistance(query, name); d <= maxDistance {
+			consider(candidate{db().byPart3[i], d, true})
+		}
+	}
+
+	candidates := make([]candidate, 0, len(best))
+	for _, c := range best {
+		candidates = append(candidates, c)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.distance != b.distance {
+			return a.distance < b.distance
+		}
+		if ap, bp := prominence(a.lang), prominence(b.lang); ap != bp {
+			return ap > bp
+		}
+		if a.byName != b.byName {
+			return !a.byName
+		}
+		return a.lang.Part3 < b.lang.Part3
+	})
+
+	if len(candidates) > didYouMeanLimit {
+		candidates = candidates[:didYouMeanLimit]
+	}
+	ret := make([]Language, len(candidates))
+	for i, c := range candidates {
+		ret[i] = c.lang
+	}
+	return ret
+}
+
+// prominence estimates how widely known a language is by the standards it's coded in
+func prominence(l Language) int {
+	switch {
+	case l.Part1 != "":
+		return 2
+	case l.Part2T != "":
+		return 1
+	}
+	return 0
+}
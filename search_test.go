@@ -0,0 +1,228 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		input         string
+		limit         int
+		expectedFirst string
+		expectedLen   int
+	}{
+		{"deu", 5, "deu", 5},     // exact code
+		{"RU", 1, "rus", 1},      // exact code, case-insensitive
+		{"russ", 3, "bxr", 3},    // name prefix: Russia Buriat, Russian, ...
+		{"Rusian", 1, "rus", 1},  // fuzzy
+		{"Chinise", 1, "zho", 1}, // fuzzy
+		{"", 5, "", 0},
+		{"rus", 0, "", 0},
+		{"qwxzqwxzqwxz", 5, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			actual := Suggest(tt.input, tt.limit)
+
+			if len(actual) != tt.expectedLen {
+				t.Errorf("Suggest() = %v, expected %v results", actual, tt.expectedLen)
+			} else if tt.expectedLen > 0 && actual[0].Part3 != tt.expectedFirst {
+				t.Errorf("Suggest() = %v, expected %v first", actual, tt.expectedFirst)
+			}
+		})
+	}
+}
+
+func TestSuggestTiers(t *testing.T) {
+	actual := Suggest("ger", 10)
+	if len(actual) < 2 || actual[0].Part3 != "deu" {
+		t.Fatalf("Suggest() = %v, expected German first by code", actual)
+	}
+	for i, l := range actual[1:] {
+		if l.Part3 == "deu" {
+			t.Errorf("Suggest() = %v, German is duplicated at %v", actual, i+1)
+		}
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"rusian", "russian", 1},
+		{"kitten", "sitting", 3},
+		{"ë", "e", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if actual := levenshtein(tt.a, tt.b); actual != tt.expected {
+				t.Errorf("levenshtein() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOSADistance(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"rsu", "rus", 1},
+		{"germna", "german", 1},
+		{"ca", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"ëe", "eë", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if actual := osaDistance(tt.a, tt.b); actual != tt.expected {
+				t.Errorf("osaDistance() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSuggestUnicode(t *testing.T) {
+	actual := Suggest("ARBËRESHË", 1)
+
+	if len(actual) != 1 || actual[0].Part3 != "aae" {
+		t.Errorf("Suggest() = %v, expected Arbëreshë Albanian", actual)
+	}
+}
+
+func TestDidYouMean(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		first    bool // expected to be the best candidate, not just present
+	}{
+		{"rux", "rus", false},    // misspelled code
+		{"dw", "deu", false},     // misspelled two-letter code
+		{"rsu", "rus", false},    // swapped letters in code
+		{"ed", "deu", false},     // swapped letters in two-letter code
+		{"Rusian", "rus", true},  // misspelled name
+		{"Germna", "deu", true},  // misspelled name
+		{"russian", "rus", true}, // exact name
+		{"", "", false},
+		{"qwxzqwxzqwxz", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			actual := DidYouMean(tt.input)
+
+			if len(actual) > didYouMeanLimit {
+				t.Errorf("DidYouMean() = %v, expected at most %v results", actual, didYouMeanLimit)
+			}
+			if tt.expected == "" {
+				if len(actual) != 0 {
+					t.Errorf("DidYouMean() = %v, expected nothing", actual)
+				}
+				return
+			}
+
+			found := false
+			for i, l := range actual {
+				if l.Part3 == tt.expected && (i == 0 || !tt.first) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("DidYouMean() = %v, expected %v (first: %v)", actual, tt.expected, tt.first)
+			}
+		})
+	}
+}
+
+func TestSearchName(t *testing.T) {
+	tests := []struct {
+		query    string
+		limit    int
+		expected []string
+	}{
+		{"Rusian", 1, []string{"rus"}},
+		{"Chinise", 1, []string{"zho"}},
+		{"russian", 1, []string{"rus"}},
+		{"Chinese", 1, []string{"zho"}},
+		{"", 5, nil},
+		{"Russian", 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			actual := SearchName(tt.query, tt.limit)
+
+			var codes []string
+			for _, l := range actual {
+				codes = append(codes, l.Part3)
+			}
+			if !reflect.DeepEqual(codes, tt.expected) {
+				t.Errorf("SearchName() = %v, expected %v", codes, tt.expected)
+			}
+		})
+	}
+
+	actual := SearchName("Rusian", 10)
+	if len(actual) != 10 {
+		t.Fatalf("SearchName() returned %d languages, expected 10", len(actual))
+	}
+	prev := -1
+	for i, l := range actual {
+		d := levenshtein(foldName("Rusian"), foldName(l.Name))
+		if d < prev || d == prev && l.Part3 < actual[i-1].Part3 {
+			t.Errorf("SearchName() = %v, expected to be sorted by distance, then by code", actual)
+		}
+		prev = d
+	}
+}
+
+func TestSearchNamePrefix(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		limit    int
+		expected []string
+	}{
+		{"russ", 10, []string{"bxr", "rus", "rsl"}}, // Russia Buriat, Russian, Russian Sign Language
+		{"RUSSIAN", 1, []string{"rus"}},
+		{" old  eng", 10, []string{"ang"}},
+		{"xyzzy", 10, nil},
+		{"", 10, nil},
+		{"russ", 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.prefix, func(t *testing.T) {
+			actual := SearchNamePrefix(tt.prefix, tt.limit)
+
+			var codes []string
+			for _, l := range actual {
+				codes = append(codes, l.Part3)
+			}
+			if !reflect.DeepEqual(codes, tt.expected) {
+				t.Errorf("SearchNamePrefix() = %v, expected %v", codes, tt.expected)
+			}
+		})
+	}
+
+	actual := SearchNamePrefix("a", 100)
+	if len(actual) != 100 {
+		t.Fatalf("SearchNamePrefix() returned %d languages, expected 100", len(actual))
+	}
+	for i := 1; i < len(actual); i++ {
+		if prev, cur := foldName(actual[i-1].Name), foldName(actual[i].Name); prev > cur || prev == cur && actual[i-1].Part3 > actual[i].Part3 {
+			t.Errorf("SearchNamePrefix() returned %v before %v, expected to be sorted by name", actual[i-1], actual[i])
+		}
+	}
+}
+
+func BenchmarkSearchNamePrefix(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if len(SearchNamePrefix("chin", 10)) == 0 {
+			b.Fatal("SearchNamePrefix() = nil")
+		}
+	}
+}
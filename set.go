@@ -0,0 +1,92 @@
+package iso639_3
+
+import "sort"
+
+// LanguageSet is a set of languages identified by ISO639-3 code.
+// Zero value is an empty set ready to use; nil *LanguageSet is treated as an empty set by read-only methods
+type LanguageSet struct {
+	langs map[string]Language
+}
+
+// NewLanguageSet returns a set holding given languages
+func NewLanguageSet(langs ...Language) *LanguageSet {
+	s := &LanguageSet{}
+	for _, l := range langs {
+		s.Add(l)
+	}
+	return s
+}
+
+// Add puts language into the set. Adding a language already in the set is a no-op
+func (s *LanguageSet) Add(l Language) {
+	if s.langs == nil {
+		s.langs = map[string]Language{}
+	}
+	s.langs[l.Part3] = l
+}
+
+// Contains reports whether the set holds given language
+func (s *LanguageSet) Contains(l Language) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.langs[l.Part3]
+	return ok
+}
+
+// Len returns number of languages in the set
+func (s *LanguageSet) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.langs)
+}
+
+// Languages returns languages of the set sorted by ISO639-3 code
+func (s *LanguageSet) Languages() []Language {
+	ret := make([]Language, 0, s.Len())
+	if s != nil {
+		for _, l := range s.langs {
+			ret = append(ret, l)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Part3 < ret[j].Part3 })
+	return ret
+}
+
+// Intersect returns a new set of languages present in both s and other, e.g. languages supported by both services.
+// Neither set is modified
+func (s *LanguageSet) Intersect(other *LanguageSet) *LanguageSet {
+	ret := &LanguageSet{}
+	for _, l := range s.Languages() {
+		if other.Contains(l) {
+			ret.Add(l)
+		}
+	}
+	return ret
+}
+
+// Union returns a new set of languages present in s, other or both.
+// Neither set is modified
+func (s *LanguageSet) Union(other *LanguageSet) *LanguageSet {
+	ret := &LanguageSet{}
+	for _, l := range s.Languages() {
+		ret.Add(l)
+	}
+	for _, l := range other.Languages() {
+		ret.Add(l)
+	}
+	return ret
+}
+
+// Difference returns a new set of languages present in s but not in other.
+// Neither set is modified
+func (s *LanguageSet) Difference(other *LanguageSet) *LanguageSet {
+	ret := &LanguageSet{}
+	for _, l := range s.Languages() {
+		if !other.Contains(l) {
+			ret.Add(l)
+		}
+	}
+	return ret
+}
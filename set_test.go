@@ -0,0 +1,66 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLanguageSet(t *testing.T) {
+	s := NewLanguageSet(LanguagesForCodes([]string{"rus", "en", "deu", "rus"})...)
+
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d, expected 3", s.Len())
+	}
+	if !s.Contains(db().part3["eng"]) {
+		t.Errorf("Contains(eng) = false, expected true")
+	}
+	if s.Contains(db().part3["fra"]) {
+		t.Errorf("Contains(fra) = true, expected false")
+	}
+
+	expected := LanguagesForCodes([]string{"deu", "eng", "rus"})
+	if actual := s.Languages(); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Languages() = %v, expected %v", actual, expected)
+	}
+
+	var empty LanguageSet
+	empty.Add(db().part3["fra"])
+	if empty.Len() != 1 {
+		t.Errorf("Len() of zero value after Add = %d, expected 1", empty.Len())
+	}
+}
+
+func TestLanguageSetAlgebra(t *testing.T) {
+	a := NewLanguageSet(LanguagesForCodes([]string{"eng", "rus", "deu", "fra"})...)
+	b := NewLanguageSet(LanguagesForCodes([]string{"fra", "spa", "rus", "zho"})...)
+
+	tests := []struct {
+		name     string
+		actual   *LanguageSet
+		expected []string
+	}{
+		{"intersect", a.Intersect(b), []string{"fra", "rus"}},
+		{"union", a.Union(b), []string{"deu", "eng", "fra", "rus", "spa", "zho"}},
+		{"difference", a.Difference(b), []string{"deu", "eng"}},
+		{"reverse difference", b.Difference(a), []string{"spa", "zho"}},
+		{"intersect nil", a.Intersect(nil), []string{}},
+		{"union nil", a.Union(nil), []string{"deu", "eng", "fra", "rus"}},
+		{"nil difference", (*LanguageSet)(nil).Difference(a), []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := make([]Language, 0, len(tt.expected))
+			for _, code := range tt.expected {
+				expected = append(expected, db().part3[code])
+			}
+			if actual := tt.actual.Languages(); !reflect.DeepEqual(actual, expected) {
+				t.Errorf("Languages() = %v, expected %v", actual, expected)
+			}
+		})
+	}
+
+	if a.Len() != 4 || b.Len() != 4 {
+		t.Errorf("operands were modified: a.Len() = %d, b.Len() = %d", a.Len(), b.Len())
+	}
+}
@@ -0,0 +1,33 @@
+package iso639_3
+
+import (
+	"net/url"
+	"strings"
+)
+
+const (
+	silHost     = "iso639-3.sil.org"
+	silCodePath = "/code/"
+)
+
+// SILURL returns URL of the language page on the site of ISO 639-3 Registration Authority,
+// e.g. "https://iso639-3.sil.org/code/rus"
+func (l Language) SILURL() string {
+	return "https://" + silHost + silCodePath + l.Part3
+}
+
+// FromSILURL looks up language for given URL of its page on the site of ISO 639-3 Registration Authority,
+// e.g. "https://iso639-3.sil.org/code/rus". Both http and https schemes are accepted, trailing slash is ignored.
+// Returns nil if URL doesn't point to a code page or the code is unknown
+func FromSILURL(rawURL string) *Language {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host != silHost {
+		return nil
+	}
+
+	path := strings.TrimSuffix(u.Path, "/")
+	if !strings.HasPrefix(path, silCodePath) {
+		return nil
+	}
+	return FromPart3Code(strings.TrimPrefix(path, silCodePath))
+}
@@ -0,0 +1,47 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestLanguage_SILURL(t *testing.T) {
+	actual := FromPart3Code("rus").SILURL()
+
+	if actual != "https://iso639-3.sil.org/code/rus" {
+		t.Errorf("SILURL() = %v, expected https://iso639-3.sil.org/code/rus", actual)
+	}
+	if l := FromSILURL(actual); l == nil || l.Part3 != "rus" {
+		t.Errorf("FromSILURL(SILURL()) = %v, expected Russian", l)
+	}
+}
+
+func TestFromSILURL(t *testing.T) {
+	tests := []struct {
+		url           string
+		expectedPart3 string
+	}{
+		{"https://iso639-3.sil.org/code/rus", "rus"},
+		{"http://iso639-3.sil.org/code/deu/", "deu"},
+		{"https://iso639-3.sil.org/code/rus?tab=info", "rus"},
+		{"https://iso639-3.sil.org/code/123", ""}, // doesn't exist
+		{"https://iso639-3.sil.org/code/", ""},
+		{"https://iso639-3.sil.org/code/rus/extra", ""},
+		{"https://example.com/code/rus", ""},
+		{"ftp://iso639-3.sil.org/code/rus", ""},
+		{"iso639-3.sil.org/code/rus", ""},
+		{"://bad", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			actual := FromSILURL(tt.url)
+
+			if tt.expectedPart3 == "" {
+				if actual != nil {
+					t.Errorf("FromSILURL() = %v, expected nil", actual)
+				}
+			} else if actual == nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("FromSILURL() = %v, expected Language with Part3 %v", actual, tt.expectedPart3)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package iso639_3
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan implements sql.Scanner, restoring language by ISO 639-3 code stored as string or []byte.
+// NULL and empty codes scan to zero Language. Returns ErrLanguageNotFound for unknown codes
+func (l *Language) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*l = Language{}
+		return nil
+	case string:
+		return l.UnmarshalText([]byte(v))
+	case []byte:
+		return l.UnmarshalText(v)
+	}
+	return fmt.Errorf("iso639_3: can't scan %T into Language", src)
+}
+
+// Value implements driver.Valuer, storing language as its ISO 639-3 code.
+// Zero Language is stored as NULL, so it scans back unchanged
+func (l Language) Value() (driver.Value, error) {
+	if l.Part3 == "" {
+		return nil, nil
+	}
+	return l.Part3, nil
+}
@@ -0,0 +1,59 @@
+package iso639_3
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLanguage_Scan(t *testing.T) {
+	tests := []struct {
+		name          string
+		src           interface{}
+		expectedPart3 string
+		expectedErr   bool
+	}{
+		{"string", "rus", "rus", false},
+		{"bytes", []byte("deu"), "deu", false},
+		{"null", nil, "", false},
+		{"empty", "", "", false},
+		{"unknown", "xxx", "", true},
+		{"int", 42, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := *FromPart3Code("zho")
+			err := actual.Scan(tt.src)
+
+			if tt.expectedErr {
+				if err == nil {
+					t.Errorf("Scan() = %v, expected error", actual)
+				}
+			} else if err != nil || actual.Part3 != tt.expectedPart3 {
+				t.Errorf("Scan() = %v, %v, expected Language with Part3 %v", actual, err, tt.expectedPart3)
+			}
+		})
+	}
+
+	var l Language
+	if err := l.Scan("xxx"); !errors.Is(err, ErrLanguageNotFound) {
+		t.Errorf("Scan() = %v, expected ErrLanguageNotFound", err)
+	}
+}
+
+func TestLanguage_Value(t *testing.T) {
+	for _, l := range []Language{*FromPart3Code("rus"), {}} {
+		v, err := l.Value()
+		if err != nil {
+			t.Fatalf("Value() error = %v", err)
+		}
+
+		var restored Language
+		if err := restored.Scan(v); err != nil || restored != l {
+			t.Errorf("Scan(%v) = %v, %v, expected %v", v, restored, err, l)
+		}
+	}
+
+	if v, _ := FromPart3Code("rus").Value(); v != "rus" {
+		t.Errorf("Value() = %v, expected rus", v)
+	}
+}
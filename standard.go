@@ -0,0 +1,65 @@
+package iso639_3
+
+// Standard identifies which ISO 639 code of a language to use
+type Standard int
+
+const (
+	StandardPart3  Standard = iota // ISO639-3 code
+	StandardPart2B                 // ISO639-2 bibliographic code
+	StandardPart2T                 // ISO639-2 terminology code
+	StandardPart1                  // ISO639-1 code
+)
+
+// Code returns code of the language in given standard, e.g. "de" for German in StandardPart1.
+// Returns empty string if language has no code in the standard
+func (l Language) Code(std Standard) string {
+	switch std {
+	case StandardPart3:
+		return l.Part3
+	case StandardPart2B:
+		return l.Part2B
+	case StandardPart2T:
+		return l.Part2T
+	case StandardPart1:
+		return l.Part1
+	}
+	return ""
+}
+
+// Codes returns all codes of the language, from ISO639-1 to ISO639-3 code, without empty and duplicate ones,
+// e.g. "de", "ger", "deu" for German
+func (l Language) Codes() []string {
+	ret := make([]string, 0, 4)
+	for _, code := range []string{l.Part1, l.Part2B, l.Part2T, l.Part3} {
+		if code != "" && !containsString(ret, code) {
+			ret = append(ret, code)
+		}
+	}
+	return ret
+}
+
+// Label returns human-readable label of the language for UI, reference name followed by code, e.g. "English (en)".
+// The shortest code is used by default: ISO639-1 code if the language has one, ISO639-3 code otherwise.
+// Optional std selects code standard instead, ISO639-3 code is used if the language has no code in it
+func (l Language) Label(std ...Standard) string {
+	code := l.Part1
+	if len(std) > 0 {
+		code = l.Code(std[0])
+	}
+	if code == "" {
+		code = l.Part3
+	}
+	return l.Name + " (" + code + ")"
+}
+
+// CodeNameMap maps codes of given languages in given standard to reference names, e.g. "de" to "German" for StandardPart1.
+// Languages lacking code in the standard are skipped
+func CodeNameMap(langs []Language, std Standard) map[string]string {
+	ret := make(map[string]string, len(langs))
+	for _, l := range langs {
+		if code := l.Code(std); code != "" {
+			ret[code] = l.Name
+		}
+	}
+	return ret
+}
@@ -0,0 +1,71 @@
+package iso639_3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLanguage_Code(t *testing.T) {
+	german := *FromPart3Code("deu")
+
+	tests := []struct {
+		std      Standard
+		expected string
+	}{
+		{StandardPart3, "deu"},
+		{StandardPart2B, "ger"},
+		{StandardPart2T, "deu"},
+		{StandardPart1, "de"},
+		{Standard(42), ""},
+	}
+	for _, tt := range tests {
+		if actual := german.Code(tt.std); actual != tt.expected {
+			t.Errorf("Code(%v) = %v, expected %v", tt.std, actual, tt.expected)
+		}
+	}
+}
+
+func TestLanguage_Codes(t *testing.T) {
+	tests := map[string][]string{
+		"deu": {"de", "ger", "deu"},
+		"rus": {"ru", "rus"},
+		"cmn": {"cmn"},
+		"haw": {"haw"},
+	}
+	for code, expected := range tests {
+		if actual := FromPart3Code(code).Codes(); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("Codes() of %v = %v, expected %v", code, actual, expected)
+		}
+	}
+}
+
+func TestLanguage_Label(t *testing.T) {
+	tests := []struct {
+		code     string
+		std      []Standard
+		expected string
+	}{
+		{"eng", nil, "English (en)"},
+		{"cmn", nil, "Mandarin Chinese (cmn)"},
+		{"deu", []Standard{StandardPart2B}, "German (ger)"},
+		{"deu", []Standard{StandardPart3}, "German (deu)"},
+		{"cmn", []Standard{StandardPart1}, "Mandarin Chinese (cmn)"},
+		{"ang", []Standard{StandardPart2T}, "Old English (ca. 450-1100) (ang)"},
+	}
+	for _, tt := range tests {
+		if actual := FromPart3Code(tt.code).Label(tt.std...); actual != tt.expected {
+			t.Errorf("Label(%v) of %v = %q, expected %q", tt.std, tt.code, actual, tt.expected)
+		}
+	}
+}
+
+func TestCodeNameMap(t *testing.T) {
+	langs := LanguagesForCodes([]string{"deu", "rus", "cmn", "eng"})
+
+	actual := CodeNameMap(langs, StandardPart1)
+
+	expected := map[string]string{"de": "German", "ru": "Russian", "en": "English"} // cmn has no ISO 639-1 code
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("CodeNameMap() = %v, expected %v", actual, expected)
+	}
+}
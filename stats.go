@@ -0,0 +1,96 @@
+package iso639_3
+
+import (
+	"fmt"
+	"sort"
+)
+
+// NumLanguages returns number of distinct languages, i.e. of ISO639-3 codes
+func NumLanguages() int {
+	return len(db().byPart3)
+}
+
+// NumLanguagesWithPart1 returns number of distinct languages having ISO639-1 code
+func NumLanguagesWithPart1() int {
+	ret := 0
+	for _, l := range db().byPart3 {
+		if l.Part1 != "" {
+			ret++
+		}
+	}
+	return ret
+}
+
+// TypeCount holds number of languages of a type
+type TypeCount struct {
+	Type  LanguageType
+	Count int
+}
+
+// String returns a report line like "Living: 7000"
+func (c TypeCount) String() string {
+	return fmt.Sprintf("%v: %d", c.Type, c.Count)
+}
+
+// CountByType returns number of distinct languages of each type
+func CountByType() map[LanguageType]int {
+	ret := map[LanguageType]int{}
+	for _, l := range db().part3 {
+		ret[l.LanguageType]++
+	}
+	return ret
+}
+
+// TypeDistribution returns number of distinct languages of each type, sorted by count descending
+func TypeDistribution() []TypeCount {
+	counts := CountByType()
+
+	ret := make([]TypeCount, 0, len(counts))
+	for t, c := range counts {
+		ret = append(ret, TypeCount{t, c})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count != ret[j].Count {
+			return ret[i].Count > ret[j].Count
+		}
+		return ret[i].Type < ret[j].Type
+	})
+	return ret
+}
+
+// ScopeCount holds number of languages of a scope
+type ScopeCount struct {
+	Scope LanguageScope
+	Count int
+}
+
+// String returns a report line like "Individual: 7000"
+func (c ScopeCount) String() string {
+	return fmt.Sprintf("%v: %d", c.Scope, c.Count)
+}
+
+// CountByScope returns number of distinct languages of each scope
+func CountByScope() map[LanguageScope]int {
+	ret := map[LanguageScope]int{}
+	for _, l := range db().part3 {
+		ret[l.Scope]++
+	}
+	return ret
+}
+
+// ScopeDistribution returns number of distinct languages of each scope, sorted by count descending
+func ScopeDistribution() []ScopeCount {
+	counts := CountByScope()
+
+	ret := make([]ScopeCount, 0, len(counts))
+	for s, c := range counts {
+		ret = append(ret, ScopeCount{s, c})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Count != ret[j].Count {
+			return ret[i].Count > ret[j].Count
+		}
+		return ret[i].Scope < ret[j].Scope
+	})
+	return ret
+}
@@ -0,0 +1,76 @@
+package iso639_3
+
+import (
+	"testing"
+)
+
+func TestNumLanguages(t *testing.T) {
+	if actual := NumLanguages(); actual != len(db().part3) || actual < 7000 {
+		t.Errorf("NumLanguages() = %v, expected %v", actual, len(db().part3))
+	}
+
+	distinct := map[string]bool{}
+	for _, l := range db().part1 {
+		distinct[l.Part3] = true
+	}
+	if actual := NumLanguagesWithPart1(); actual != len(distinct) {
+		t.Errorf("NumLanguagesWithPart1() = %v, expected %v", actual, len(distinct))
+	}
+}
+
+func TestTypeDistribution(t *testing.T) {
+	actual := TypeDistribution()
+
+	total := 0
+	for i, c := range actual {
+		if i > 0 && actual[i-1].Count < c.Count {
+			t.Errorf("TypeDistribution() is not sorted: %v", actual)
+		}
+		total += c.Count
+	}
+	if total != len(db().part3) {
+		t.Errorf("TypeDistribution() = %v, expected %v languages in total", actual, len(db().part3))
+	}
+	if len(actual) == 0 || actual[0].Type != TypeLiving {
+		t.Errorf("TypeDistribution() = %v, expected living languages first", actual)
+	}
+}
+
+func TestTypeCount_String(t *testing.T) {
+	actual := TypeCount{TypeExtinct, 42}.String()
+
+	if actual != "Extinct: 42" {
+		t.Errorf("String() = %v, expected %v", actual, "Extinct: 42")
+	}
+}
+
+func TestScopeDistribution(t *testing.T) {
+	actual := ScopeDistribution()
+
+	total := 0
+	for i, c := range actual {
+		if i > 0 && actual[i-1].Count < c.Count {
+			t.Errorf("ScopeDistribution() is not sorted: %v", actual)
+		}
+		total += c.Count
+	}
+	if total != len(db().part3) {
+		t.Errorf("ScopeDistribution() = %v, expected %v languages in total", actual, len(db().part3))
+	}
+	if len(actual) == 0 || actual[0].Scope != ScopeIndividual {
+		t.Errorf("ScopeDistribution() = %v, expected individual languages first", actual)
+	}
+
+	// ISO 639-3 has about 60 macrolanguages
+	if macro := CountByScope()[ScopeMacrolanguage]; macro < 50 || macro > 80 {
+		t.Errorf("CountByScope() has %d macrolanguages, expected about 60", macro)
+	}
+}
+
+func TestScopeCount_String(t *testing.T) {
+	actual := ScopeCount{ScopeMacrolanguage, 62}.String()
+
+	if actual != "Macrolanguage: 62" {
+		t.Errorf("String() = %v, expected %v", actual, "Macrolanguage: 62")
+	}
+}
@@ -0,0 +1,165 @@
+package iso639_3
+
+import (
+	"errors"
+	"sort"
+)
+
+// tables holds lookup tables of the embedded dataset along with indices derived from them.
+// Use db to get tables of the embedded dataset
+type tables struct {
+	part3, part2, part1 map[string]Language // LanguagesPart3, LanguagesPart2 and LanguagesPart1
+	byPart3             []Language          // all distinct languages sorted by ISO639-3 code
+
+	// part3Index, part2Index and part1Index map keys of lookup tables to languages in byPart3,
+	// so lookups share storage and don't copy language info
+	part3Index, part2Index, part1Index map[string]*Language
+}
+
+// newTables builds tables from lookup tables and all distinct languages of them sorted by ISO639-3 code
+func newTables(byPart3 []Language, part3, part2, part1 map[string]Language) *tables {
+	t := &tables{part3: part3, part2: part2, part1: part1, byPart3: byPart3}
+	t.part3Index = t.index(part3)
+	t.part2Index = t.index(part2)
+	t.part1Index = t.index(part1)
+	return t
+}
+
+// LanguageTables returns ISO 639-3, ISO 639-2 and ISO 639-1 lookup tables, same as LanguagesPart3, LanguagesPart2 and LanguagesPart1.
+// Unlike these variables, which aren't declared with iso639_blob build tag, it works with the tag as well,
+// loading the tables on first call, and is safe for concurrent use.
+// Returned maps are shared and must not be modified
+func LanguageTables() (part3, part2, part1 map[string]Language) {
+	t := db()
+	return t.part3, t.part2, t.part1
+}
+
+// sortLanguages returns distinct languages of ISO639-3 lookup table sorted by code
+func sortLanguages(part3 map[string]Language) []Language {
+	ret := make([]Language, 0, len(part3))
+	for _, l := range part3 {
+		ret = append(ret, l)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Part3 < ret[j].Part3 })
+	return ret
+}
+
+// index maps keys of given lookup table to languages in byPart3. Languages missing from byPart3 get storage of their own
+func (t *tables) index(lookup map[string]Language) map[string]*Language {
+	ret := make(map[string]*Language, len(lookup))
+	for code, l := range lookup {
+		i := sort.Search(len(t.byPart3), func(i int) bool { return t.byPart3[i].Part3 >= l.Part3 })
+		if i < len(t.byPart3) && t.byPart3[i] == l {
+			ret[code] = &t.byPart3[i]
+			continue
+		}
+		l := l
+		ret[code] = &l
+	}
+	return ret
+}
+
+// blobMagic starts packed languages written by the generator with -blob flag
+const blobMagic = "L639"
+
+// blobFields is the number of fields of each language in packed languages, in order of Language struct fields
+const blobFields = 9
+
+// errMalformedBlob is returned by decodeLanguages for data not written by the generator
+var errMalformedBlob = errors.New("iso639_3: malformed packed languages")
+
+// decodeLanguages parses languages packed by the generator with -blob flag: blobMagic, number of fields
+// and number of languages as uvarints, then each field of each language as uvarint length followed by the bytes.
+// Languages must be sorted by ISO639-3 code. Strings of returned languages share memory with data, so nothing is copied
+func decodeLanguages(data string) ([]Language, error) {
+	if len(data) < len(blobMagic) || data[:len(blobMagic)] != blobMagic {
+		return nil, errMalformedBlob
+	}
+	pos := len(blobMagic)
+
+	next := func() (uint64, bool) {
+		var v uint64
+		for shift := uint(0); pos < len(data) && shift < 64; shift += 7 {
+			b := data[pos]
+			pos++
+			v |= uint64(b&0x7f) << shift
+			if b < 0x80 {
+				return v, true
+			}
+		}
+		return 0, false
+	}
+	nextString := func() (string, bool) {
+		n, ok := next()
+		if !ok || n > uint64(len(data)-pos) {
+			return "", false
+		}
+		pos += int(n)
+		return data[pos-int(n) : pos], true
+	}
+
+	fields, ok := next()
+	if !ok || fields != blobFields {
+		return nil, errMalformedBlob
+	}
+	count, ok := next()
+	if !ok || count > uint64(len(data)) {
+		return nil, errMalformedBlob
+	}
+
+	ret := make([]Language, count)
+	var values [blobFields]string
+	for i := range ret {
+		for j := range values {
+			if values[j], ok = nextString(); !ok {
+				return nil, errMalformedBlob
+			}
+		}
+		if len(values[4]) > 1 || len(values[5]) > 1 || i > 0 && values[0] <= ret[i-1].Part3 {
+			return nil, errMalformedBlob
+		}
+		ret[i] = Language{
+			Part3:             values[0],
+			Part2B:            values[1],
+			Part2T:            values[2],
+			Part1:             values[3],
+			Scope:             LanguageScope(firstByte(values[4])),
+			LanguageType:      LanguageType(firstByte(values[5])),
+			Name:              values[6],
+			Comment:           values[7],
+			MacrolanguageCode: values[8],
+		}
+	}
+	if pos != len(data) {
+		return nil, errMalformedBlob
+	}
+	return ret, nil
+}
+
+// firstByte returns the first byte of s, 0 if s is empty
+func firstByte(s string) byte {
+	if s == "" {
+		return 0
+	}
+	return s[0]
+}
+
+// lookupTables builds LanguagesPart3, LanguagesPart2 and LanguagesPart1 lookup tables from distinct languages
+func lookupTables(langs []Language) (part3, part2, part1 map[string]Language) {
+	part3 = make(map[string]Language, len(langs))
+	part2 = map[string]Language{}
+	part1 = map[string]Language{}
+	for _, l := range langs {
+		part3[l.Part3] = l
+		if l.Part2B != "" {
+			part2[l.Part2B] = l
+		}
+		if l.Part2T != "" {
+			part2[l.Part2T] = l
+		}
+		if l.Part1 != "" {
+			part1[l.Part1] = l
+		}
+	}
+	return part3, part2, part1
+}
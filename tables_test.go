@@ -0,0 +1,100 @@
+package iso639_3
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeLanguages(t *testing.T) {
+	data, err := os.ReadFile("lang-db.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	langs, err := decodeLanguages(string(data))
+	if err != nil {
+		t.Fatalf("decodeLanguages() error = %v", err)
+	}
+	if !reflect.DeepEqual(langs, db().byPart3) {
+		t.Errorf("decodeLanguages() = %v languages, expected the same as %v generated ones", len(langs), len(db().byPart3))
+	}
+
+	part3, part2, part1 := lookupTables(langs)
+	for name, tt := range map[string]struct{ actual, expected map[string]Language }{
+		"LanguagesPart3": {part3, db().part3},
+		"LanguagesPart2": {part2, db().part2},
+		"LanguagesPart1": {part1, db().part1},
+	} {
+		if !reflect.DeepEqual(tt.actual, tt.expected) {
+			t.Errorf("lookupTables() built %v of %v entries, expected %v", name, len(tt.actual), len(tt.expected))
+		}
+	}
+}
+
+func TestLanguageTables(t *testing.T) {
+	part3, part2, part1 := LanguageTables()
+
+	if len(part3) != len(db().byPart3) || part3["deu"].Part3 != "deu" {
+		t.Errorf("LanguageTables() have %v ISO 639-3 codes, expected %v", len(part3), len(db().byPart3))
+	}
+	if part2["ger"].Part3 != "deu" || part1["de"].Part3 != "deu" {
+		t.Errorf("LanguageTables() = %v, %v, expected German for ger and de", part2["ger"], part1["de"])
+	}
+}
+
+func TestDecodeLanguagesMalformed(t *testing.T) {
+	tests := map[string]string{
+		"empty":           "",
+		"bad magic":       "L630\x09\x00",
+		"fields count":    blobMagic + "\x08\x00",
+		"truncated":       blobMagic + "\x09\x01\x03rus",
+		"trailing bytes":  blobMagic + "\x09\x00\x00",
+		"long scope":      blobMagic + "\x09\x01\x03rus\x00\x00\x00\x02IM\x01L\x07Russian\x00\x00",
+		"unsorted":        blobMagic + "\x09\x02\x03rus\x00\x00\x00\x01I\x01L\x00\x00\x00\x03deu\x00\x00\x00\x01I\x01L\x00\x00\x00",
+		"length overflow": blobMagic + "\x09\x01\xff\xff\xff\xff\xff\xff\xff\xff\xff\x01",
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if langs, err := decodeLanguages(data); err != errMalformedBlob {
+				t.Errorf("decodeLanguages() = %v, %v, expected %v", langs, err, errMalformedBlob)
+			}
+		})
+	}
+
+	langs, err := decodeLanguages(blobMagic + "\x09\x01\x03rus\x00\x00\x02ru\x01I\x01L\x07Russian\x00\x00")
+	expected := []Language{{Part3: "rus", Part1: "ru", Scope: 'I', LanguageType: 'L', Name: "Russian"}}
+	if err != nil || !reflect.DeepEqual(langs, expected) {
+		t.Errorf("decodeLanguages() = %v, %v, expected %v", langs, err, expected)
+	}
+}
+
+// BenchmarkTablesFromMaps measures building tables from lookup tables generated as map literals,
+// which is done on package initialization by default, not counting initialization of the map literals themselves
+func BenchmarkTablesFromMaps(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		newTables(sortLanguages(db().part3), db().part3, db().part2, db().part1)
+	}
+}
+
+// BenchmarkTablesFromBlob measures building tables from packed languages, which is done on first lookup
+// with iso639_blob build tag instead of package initialization
+func BenchmarkTablesFromBlob(b *testing.B) {
+	data, err := os.ReadFile("lang-db.bin")
+	if err != nil {
+		b.Fatal(err)
+	}
+	blob := string(data)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		langs, err := decodeLanguages(blob)
+		if err != nil {
+			b.Fatal(err)
+		}
+		part3, part2, part1 := lookupTables(langs)
+		newTables(langs, part3, part2, part1)
+	}
+}
@@ -0,0 +1,110 @@
+package iso639_3
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// languageColumns are header names of iso-639-3.tab columns read by LoadLanguages, in order of Language struct fields
+var languageColumns = []string{"Id", "Part2B", "Part2T", "Part1", "Scope", "Language_Type", "Ref_Name", "Comment"}
+
+// LoadLanguages reads code tables file in tab-separated iso639-3.sil.org format (iso-639-3.tab), e.g. to compare
+// a newer release against the embedded dataset with DiffLanguages. MacrolanguageCode is not in the file, so it's left empty.
+// Languages are returned sorted by ISO639-3 code
+func LoadLanguages(r io.Reader) ([]Language, error) {
+	tr := newTSVReader(r)
+
+	header, err := tr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("iso639_3: can't read languages header: %w", err)
+	}
+	cols, ok := headerColumns(header, languageColumns...)
+	if !ok {
+		return nil, fmt.Errorf("iso639_3: languages header %v lacks some of %v columns", header, languageColumns)
+	}
+
+	var ret []Language
+	for {
+		record, err := tr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("iso639_3: can't read languages: %w", err)
+		}
+
+		var values [8]string
+		for i, col := range cols {
+			values[i] = record[col]
+		}
+		if len(values[4]) != 1 || len(values[5]) != 1 {
+			return nil, fmt.Errorf("iso639_3: malformed scope or type of language %q", values[0])
+		}
+		ret = append(ret, Language{
+			Part3:        values[0],
+			Part2B:       values[1],
+			Part2T:       values[2],
+			Part1:        values[3],
+			Scope:        LanguageScope(values[4][0]),
+			LanguageType: LanguageType(values[5][0]),
+			Name:         values[6],
+			Comment:      values[7],
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Part3 < ret[j].Part3 })
+	return ret, nil
+}
+
+// LanguageChange holds both versions of a language which info differs between datasets
+type LanguageChange struct {
+	Old, New Language
+}
+
+// DatasetDiff holds differences between two datasets, languages are matched by ISO639-3 code.
+// All lists are sorted by ISO639-3 code
+type DatasetDiff struct {
+	Added   []Language // languages only in the new dataset
+	Removed []Language // languages only in the old dataset
+	Changed []LanguageChange
+}
+
+// Empty reports whether datasets are the same
+func (d DatasetDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffLanguages compares old and new datasets, e.g. AllLanguages and a newer release read with LoadLanguages.
+// All fields of languages are compared, so MacrolanguageCode should be cleared in both or neither
+func DiffLanguages(old, new []Language) DatasetDiff {
+	oldByCode := make(map[string]Language, len(old))
+	for _, l := range old {
+		oldByCode[l.Part3] = l
+	}
+	newByCode := make(map[string]Language, len(new))
+	for _, l := range new {
+		newByCode[l.Part3] = l
+	}
+
+	var ret DatasetDiff
+	for _, l := range new {
+		prev, ok := oldByCode[l.Part3]
+		switch {
+		case !ok:
+			ret.Added = append(ret.Added, l)
+		case prev != l:
+			ret.Changed = append(ret.Changed, LanguageChange{prev, l})
+		}
+	}
+	for _, l := range old {
+		if _, ok := newByCode[l.Part3]; !ok {
+			ret.Removed = append(ret.Removed, l)
+		}
+	}
+
+	sort.Slice(ret.Added, func(i, j int) bool { return ret.Added[i].Part3 < ret.Added[j].Part3 })
+	sort.Slice(ret.Removed, func(i, j int) bool { return ret.Removed[i].Part3 < ret.Removed[j].Part3 })
+	sort.Slice(ret.Changed, func(i, j int) bool { return ret.Changed[i].New.Part3 < ret.Changed[j].New.Part3 })
+	return ret
+}
@@ -0,0 +1,66 @@
+package iso639_3
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const languagesFixture = "Id\tPart2B\tPart2T\tPart1\tScope\tLanguage_Type\tRef_Name\tComment\r\n" +
+	"rus\trus\trus\tru\tI\tL\tRussian\t\r\n" +
+	"deu\tger\tdeu\tde\tI\tL\tGerman\t\r\n"
+
+func TestLoadLanguages(t *testing.T) {
+	actual, err := LoadLanguages(strings.NewReader(languagesFixture))
+	if err != nil {
+		t.Fatalf("LoadLanguages() error = %v", err)
+	}
+
+	expected := []Language{
+		{Part3: "deu", Part2B: "ger", Part2T: "deu", Part1: "de", Scope: 'I', LanguageType: 'L', Name: "German"},
+		{Part3: "rus", Part2B: "rus", Part2T: "rus", Part1: "ru", Scope: 'I', LanguageType: 'L', Name: "Russian"},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("LoadLanguages() = %v, expected %v", actual, expected)
+	}
+
+	for name, input := range map[string]string{
+		"empty":          "",
+		"missing column": "Id\tPart2B\tPart2T\tPart1\tScope\tRef_Name\tComment\r\n",
+		"bad scope":      "Id\tPart2B\tPart2T\tPart1\tScope\tLanguage_Type\tRef_Name\tComment\r\nrus\t\t\t\tIM\tL\tRussian\t\r\n",
+	} {
+		if _, err := LoadLanguages(strings.NewReader(input)); err == nil {
+			t.Errorf("LoadLanguages() of %v input error = nil, expected error", name)
+		}
+	}
+}
+
+func TestDiffLanguages(t *testing.T) {
+	old := []Language{
+		{Part3: "aaa", Name: "A"},
+		{Part3: "bbb", Name: "B"},
+		{Part3: "ccc", Name: "C"},
+	}
+	new := []Language{
+		{Part3: "ddd", Name: "D"},
+		{Part3: "ccc", Name: "C"},
+		{Part3: "bbb", Name: "B2"},
+	}
+
+	actual := DiffLanguages(old, new)
+	expected := DatasetDiff{
+		Added:   []Language{{Part3: "ddd", Name: "D"}},
+		Removed: []Language{{Part3: "aaa", Name: "A"}},
+		Changed: []LanguageChange{{Old: Language{Part3: "bbb", Name: "B"}, New: Language{Part3: "bbb", Name: "B2"}}},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("DiffLanguages() = %+v, expected %+v", actual, expected)
+	}
+	if actual.Empty() {
+		t.Error("Empty() = true, expected false")
+	}
+
+	if diff := DiffLanguages(AllLanguages(), AllLanguages()); !diff.Empty() {
+		t.Errorf("DiffLanguages() of the same dataset = %+v, expected empty", diff)
+	}
+}
@@ -0,0 +1,42 @@
+package iso639_3
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidLanguage is returned by Language.Validate for inconsistent language info
+var ErrInvalidLanguage = errors.New("iso639_3: invalid language")
+
+// Validate checks language info for internal consistency, e.g. of Language values built by hand:
+// ISO639-3 code must be present and 3 symbols long, ISO639-2 codes must be 3 symbols long and ISO639-1 code 2 symbols long if present,
+// Scope and LanguageType must be ones defined by ISO 639-3.
+// Returns error wrapping ErrInvalidLanguage and describing the first problem found, nil if there's none
+func (l Language) Validate() error {
+	if len(l.Part3) != 3 {
+		return fmt.Errorf("%w: part3 code %q must be 3 symbols long", ErrInvalidLanguage, l.Part3)
+	}
+	if l.Part2B != "" && len(l.Part2B) != 3 {
+		return fmt.Errorf("%w %q: part2B code %q must be 3 symbols long", ErrInvalidLanguage, l.Part3, l.Part2B)
+	}
+	if l.Part2T != "" && len(l.Part2T) != 3 {
+		return fmt.Errorf("%w %q: part2T code %q must be 3 symbols long", ErrInvalidLanguage, l.Part3, l.Part2T)
+	}
+	if l.Part1 != "" && len(l.Part1) != 2 {
+		return fmt.Errorf("%w %q: part1 code %q must be 2 symbols long", ErrInvalidLanguage, l.Part3, l.Part1)
+	}
+
+	switch l.Scope {
+	case ScopeIndividual, ScopeMacrolanguage, ScopeSpecial:
+	default:
+		return fmt.Errorf("%w %q: unknown scope %q", ErrInvalidLanguage, l.Part3, rune(l.Scope))
+	}
+
+	switch l.LanguageType {
+	case TypeLiving, TypeHistorical, TypeAncient, TypeExtinct, TypeConstructed, TypeSpecial:
+	default:
+		return fmt.Errorf("%w %q: unknown language type %q", ErrInvalidLanguage, l.Part3, rune(l.LanguageType))
+	}
+
+	return nil
+}
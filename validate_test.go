@@ -0,0 +1,41 @@
+package iso639_3
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLanguage_Validate(t *testing.T) {
+	valid := *FromPart3Code("deu")
+
+	tests := []struct {
+		name   string
+		modify func(l *Language)
+	}{
+		{"empty part3", func(l *Language) { l.Part3 = "" }},
+		{"long part3", func(l *Language) { l.Part3 = "deut" }},
+		{"short part2B", func(l *Language) { l.Part2B = "ge" }},
+		{"long part2T", func(l *Language) { l.Part2T = "deut" }},
+		{"long part1", func(l *Language) { l.Part1 = "deu" }},
+		{"zero scope", func(l *Language) { l.Scope = 0 }},
+		{"unknown scope", func(l *Language) { l.Scope = 'X' }},
+		{"type as scope", func(l *Language) { l.Scope = LanguageScope(TypeLiving) }},
+		{"unknown type", func(l *Language) { l.LanguageType = 'X' }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := valid
+			tt.modify(&l)
+
+			if err := l.Validate(); !errors.Is(err, ErrInvalidLanguage) {
+				t.Errorf("Validate() = %v, expected ErrInvalidLanguage", err)
+			}
+		})
+	}
+
+	for _, l := range db().byPart3 {
+		if err := l.Validate(); err != nil {
+			t.Errorf("Validate() = %v, expected nil", err)
+		}
+	}
+}